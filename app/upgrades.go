@@ -32,6 +32,23 @@ var handlers = map[string]appUpgrade{
 		},
 	},
 
+	"v0.3.0": {
+		Handler: func(app *App, ctx sdk.Context, plan upgradetypes.Plan) {
+			app.MarkerKeeper.MigrateParams(ctx)
+			// Indexes added in this release are built from the existing state.
+			if err := app.NameKeeper.BuildChildIndex(ctx); err != nil {
+				panic(err)
+			}
+			if err := app.AttributeKeeper.BuildAttributeNameIndex(ctx); err != nil {
+				panic(err)
+			}
+			app.MarkerKeeper.BuildHolderIndex(ctx)
+			if err := app.MetadataKeeper.BuildDataAccessScopeIndex(ctx); err != nil {
+				panic(err)
+			}
+		},
+	},
+
 	// TODO - Add new upgrade definitions here.
 }
 
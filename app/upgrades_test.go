@@ -0,0 +1,42 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestUpgradeV030BuildsHolderIndex(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	holders := []sdk.AccAddress{
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+
+	// balances written by the bank keeper before the upgrade are not in the holder index.
+	app.MarkerKeeper.SetMarker(ctx, markertypes.NewEmptyMarkerAccount("upgradecoin", holders[0].String(), nil))
+	bankKeeper := app.BankKeeper.(markerkeeper.HolderIndexBankKeeper).Keeper
+	for _, holder := range holders {
+		require.NoError(t, bankKeeper.SetBalance(ctx, holder, sdk.NewInt64Coin("upgradecoin", 100)))
+		require.NoError(t, bankKeeper.SetBalance(ctx, holder, sdk.NewInt64Coin("othercoin", 100)))
+	}
+	require.Empty(t, app.MarkerKeeper.GetAllMarkerHolders(ctx, "upgradecoin"))
+
+	handlers["v0.3.0"].Handler(app, ctx, upgradetypes.Plan{Name: "v0.3.0"})
+
+	expected := make([]markertypes.Balance, len(holders))
+	for i, holder := range holders {
+		expected[i] = markertypes.Balance{Address: holder.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("upgradecoin", 100))}
+	}
+	require.ElementsMatch(t, expected, app.MarkerKeeper.GetAllMarkerHolders(ctx, "upgradecoin"))
+	require.Empty(t, app.MarkerKeeper.GetAllMarkerHolders(ctx, "othercoin"), "only marker denoms are indexed")
+}
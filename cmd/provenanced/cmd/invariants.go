@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/app"
+)
+
+// CheckInvariantsCmd returns a command that runs the registered invariants against the latest committed state of a
+// stopped node.
+func CheckInvariantsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-invariants [module-name] [invariant-route]",
+		Short: "Run the registered invariants against the node's stored state",
+		Long: `Run the registered invariants against the latest committed state in the node's data directory.
+The node must be stopped.  When a module name is given only that module's invariants are run,
+when an invariant route is given as well only that invariant is run.`,
+		Example: fmt.Sprintf(`$ %[1]s check-invariants
+$ %[1]s check-invariants metadata
+$ %[1]s check-invariants metadata scope-index`, appName),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			db, err := sdk.NewLevelDB("application", filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			a := app.New(appName, serverCtx.Logger, db, nil, true, map[int64]bool{}, homeDir,
+				cast.ToUint(serverCtx.Viper.Get(server.FlagInvCheckPeriod)), app.MakeEncodingConfig(), serverCtx.Viper)
+			ctx := a.NewContext(true, tmproto.Header{Height: a.LastBlockHeight()})
+
+			broken, run := 0, 0
+			for _, route := range a.CrisisKeeper.Routes() {
+				if len(args) > 0 && route.ModuleName != args[0] {
+					continue
+				}
+				if len(args) > 1 && route.Route != args[1] {
+					continue
+				}
+				run++
+				res, stop := route.Invar(ctx)
+				if stop {
+					broken++
+					cmd.Print(res)
+				}
+			}
+			if run == 0 {
+				return fmt.Errorf("no invariants registered for %v", args)
+			}
+			cmd.Printf("%d of %d invariants broken at height %d\n", broken, run, a.LastBlockHeight())
+			if broken > 0 {
+				return fmt.Errorf("%d invariants broken", broken)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
@@ -130,6 +130,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		debug.Cmd(),
 		AddMetaAddressParser(),
 		AddMetaAddressEncoder(),
+		CheckInvariantsCmd(app.DefaultNodeHome(appName)),
 	)
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome(appName), newApp, createSimappAndExport, addModuleInitFlags)
@@ -4,6 +4,7 @@ go 1.15
 
 require (
 	github.com/CosmWasm/wasmd v0.16.0-alpha1
+	github.com/CosmWasm/wasmvm v0.14.0-beta1
 	github.com/armon/go-metrics v0.3.6
 	github.com/btcsuite/btcd v0.21.0-beta
 	github.com/cosmos/cosmos-sdk v0.42.1
@@ -28,7 +29,6 @@ require (
 	google.golang.org/grpc v1.36.0
 	google.golang.org/protobuf v1.25.0
 	gopkg.in/yaml.v2 v2.4.0
-
 )
 
 replace google.golang.org/grpc => google.golang.org/grpc v1.33.2
@@ -0,0 +1,22 @@
+package attribute
+
+import (
+	"github.com/provenance-io/provenance/x/attribute/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The maximum number of expired attributes removed in a single block, any remaining are removed in following blocks.
+const expiredAttributeBatchSize = 100
+
+// EndBlocker removes expired account attributes from the store.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	count, err := k.DeleteExpiredAttributes(ctx, expiredAttributeBatchSize)
+	if err != nil {
+		k.Logger(ctx).Error("failed to delete expired attributes", "error", err)
+		return
+	}
+	if count > 0 {
+		k.Logger(ctx).Debug("deleted expired attributes", "count", count)
+	}
+}
@@ -116,7 +116,7 @@ func (s *IntegrationTestSuite) TestGetAccountAttributeCmd() {
 		{
 			"should get attribute by name with json output",
 			[]string{s.accountAddr.String(), "example.attribute", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s"}],"pagination":{"next_key":null,"total":"0"}}`, s.accountAddr.String(), s.accountAddr.String()),
+			fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s","expiration_date":null}],"pagination":{"next_key":null,"total":"0"}}`, s.accountAddr.String(), s.accountAddr.String()),
 		},
 		{
 			"should get attribute by name with text output",
@@ -125,6 +125,7 @@ func (s *IntegrationTestSuite) TestGetAccountAttributeCmd() {
 attributes:
 - address: %s
   attribute_type: ATTRIBUTE_TYPE_STRING
+  expiration_date: null
   name: example.attribute
   value: ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n
 pagination:
@@ -170,7 +171,7 @@ func (s *IntegrationTestSuite) TestScanAccountAttributesCmd() {
 		{
 			"should get attribute by suffix with json output",
 			[]string{s.accountAddr.String(), "attribute", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s"}],"pagination":{"next_key":null,"total":"0"}}`, s.accountAddr.String(), s.accountAddr.String()),
+			fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s","expiration_date":null}],"pagination":{"next_key":null,"total":"0"}}`, s.accountAddr.String(), s.accountAddr.String()),
 		},
 		{
 			"should get attribute by suffix with text output",
@@ -179,6 +180,7 @@ func (s *IntegrationTestSuite) TestScanAccountAttributesCmd() {
 attributes:
 - address: %s
   attribute_type: ATTRIBUTE_TYPE_STRING
+  expiration_date: null
   name: example.attribute
   value: ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n
 pagination:
@@ -224,7 +226,7 @@ func (s *IntegrationTestSuite) TestListAccountAttributesCmd() {
 		{
 			"should list all attributes for account with json output",
 			[]string{s.accountAddr.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute.count","value":"Mg==","attribute_type":"ATTRIBUTE_TYPE_INT","address":"cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h"},{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s"}],"pagination":{"next_key":null,"total":"0"}}`, s.accountAddr.String(), s.accountAddr.String()),
+			fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute.count","value":"Mg==","attribute_type":"ATTRIBUTE_TYPE_INT","address":"cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h","expiration_date":null},{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s","expiration_date":null}],"pagination":{"next_key":null,"total":"0"}}`, s.accountAddr.String(), s.accountAddr.String()),
 		},
 		{
 			"should list all attributes for account text output",
@@ -233,10 +235,12 @@ func (s *IntegrationTestSuite) TestListAccountAttributesCmd() {
 attributes:
 - address: %s
   attribute_type: ATTRIBUTE_TYPE_INT
+  expiration_date: null
   name: example.attribute.count
   value: Mg==
 - address: %s
   attribute_type: ATTRIBUTE_TYPE_STRING
+  expiration_date: null
   name: example.attribute
   value: ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n
 pagination:
@@ -259,6 +263,47 @@ pagination:
 	}
 }
 
+func (s *IntegrationTestSuite) TestListAccountsByAttributeCmd() {
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedOutput string
+	}{
+		{
+			"should list all accounts with attribute with json output",
+			[]string{"example.attribute.count", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			fmt.Sprintf(`{"accounts":["%s"],"pagination":{"next_key":null,"total":"0"}}`, s.accountAddr.String()),
+		},
+		{
+			"should list all accounts with attribute with text output",
+			[]string{"example.attribute.count", fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
+			fmt.Sprintf(`accounts:
+- %s
+pagination:
+  next_key: null
+  total: "0"`, s.accountAddr.String()),
+		},
+		{
+			"should list no accounts for unknown attribute",
+			[]string{"example.none", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			`{"accounts":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.ListAccountsByAttributeCmd()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetAttributeParamsCmd() {
 	testCases := []struct {
 		name           string
@@ -651,6 +696,84 @@ func (s *IntegrationTestSuite) TestDeleteAccountAttributeTxCommands() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestUpdateAndDeleteDistinctAccountAttributeTxCommands() {
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name         string
+		cmd          *cobra.Command
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"bind a new attribute name for update testing",
+			namecli.GetBindNameCmd(),
+			append([]string{"updatetest", s.testnet.Validators[0].Address.String(), "attribute"}, txFlags...),
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"add new attribute for update testing",
+			cli.NewAddAccountAttributeCmd(),
+			append([]string{"updatetest.attribute", s.testnet.Validators[0].Address.String(), "string", "test value"}, txFlags...),
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"update attribute, should update value and type of updatetest.attribute",
+			cli.NewUpdateAccountAttributeCmd(),
+			append([]string{"updatetest.attribute", s.testnet.Validators[0].Address.String(), "string", "test value", "int", "10"}, txFlags...),
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"update attribute, should fail to find original value",
+			cli.NewUpdateAccountAttributeCmd(),
+			append([]string{"updatetest.attribute", s.testnet.Validators[0].Address.String(), "string", "test value", "int", "10"}, txFlags...),
+			false, &sdk.TxResponse{}, 1,
+		},
+		{
+			"update attribute, should fail on invalid update type",
+			cli.NewUpdateAccountAttributeCmd(),
+			append([]string{"updatetest.attribute", s.testnet.Validators[0].Address.String(), "int", "10", "invalid", "10"}, txFlags...),
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"delete distinct attribute, should fail to find value",
+			cli.NewDeleteDistinctAccountAttributeCmd(),
+			append([]string{"updatetest.attribute", s.testnet.Validators[0].Address.String(), "string", "test value"}, txFlags...),
+			false, &sdk.TxResponse{}, 1,
+		},
+		{
+			"delete distinct attribute, should delete value of updatetest.attribute",
+			cli.NewDeleteDistinctAccountAttributeCmd(),
+			append([]string{"updatetest.attribute", s.testnet.Validators[0].Address.String(), "int", "10"}, txFlags...),
+			false, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			clientCtx := s.testnet.Validators[0].ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -29,6 +29,7 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountAttributeCmd(),
 		ListAccountAttributesCmd(),
 		ScanAccountAttributesCmd(),
+		ListAccountsByAttributeCmd(),
 	)
 
 	return queryCmd
@@ -208,3 +209,49 @@ $ %s query attribute scan pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk name.suffix
 
 	return cmd
 }
+
+// ListAccountsByAttributeCmd gets all accounts that have an attribute with a given name.
+func ListAccountsByAttributeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts [name]",
+		Short: "Get all accounts that have an attribute with a given name",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all accounts that have an attribute with a given name:
+
+Example:
+$ %s query attribute accounts attrib.name
+$ %s query attribute accounts attrib.name --page=2 --limit=100
+`,
+				version.AppName, version.AppName,
+			)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			name := strings.ToLower(strings.TrimSpace(args[0]))
+
+			var response *types.QueryAccountsByAttributeResponse
+			if response, err = queryClient.AccountsByAttribute(
+				context.Background(),
+				&types.QueryAccountsByAttributeRequest{Name: name, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query accounts for attribute \"%s\": %v\n", name, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "accounts")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
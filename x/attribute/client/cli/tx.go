@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -15,6 +16,9 @@ import (
 	"github.com/provenance-io/provenance/x/attribute/types"
 )
 
+// The flag for setting the expiration of an account attribute.
+const flagExpiration = "expiration"
+
 // NewTxCmd is the top-level command for attribute CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -28,6 +32,8 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewAddAccountAttributeCmd(),
 		NewDeleteAccountAttributeCmd(),
+		NewUpdateAccountAttributeCmd(),
+		NewDeleteDistinctAccountAttributeCmd(),
 	)
 	return txCmd
 }
@@ -53,15 +59,9 @@ func NewAddAccountAttributeCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("account attribute type is invalid: %w", err)
 			}
-			valueString := strings.TrimSpace(args[3])
-			var value []byte
-			if attributeType == types.AttributeType_Bytes {
-				var err error
-				if value, err = base64.StdEncoding.DecodeString(valueString); err != nil {
-					return err
-				}
-			} else {
-				value = []byte(valueString)
+			value, err := getAttributeValue(attributeType, args[3])
+			if err != nil {
+				return err
 			}
 
 			msg := types.NewMsgAddAttributeRequest(
@@ -71,10 +71,22 @@ func NewAddAccountAttributeCmd() *cobra.Command {
 				attributeType,
 				value,
 			)
+			expiration, err := cmd.Flags().GetString(flagExpiration)
+			if err != nil {
+				return err
+			}
+			if len(expiration) > 0 {
+				expirationDate, err := time.Parse(time.RFC3339, expiration)
+				if err != nil {
+					return fmt.Errorf("expiration must be an RFC3339 timestamp: %w", err)
+				}
+				msg.ExpirationDate = &expirationDate
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(flagExpiration, "", "Optional time the attribute expires at, as an RFC3339 timestamp")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -109,3 +121,103 @@ func NewDeleteAccountAttributeCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewUpdateAccountAttributeCmd creates a command for replacing a single account attribute value.
+func NewUpdateAccountAttributeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [name] [address] [original-type] [original-value] [update-type] [update-value]",
+		Short: "Update an account attribute value on the provenance blockchain",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			account, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("account address must be a Bech32 string: %w", err)
+			}
+			origAttributeType, err := types.AttributeTypeFromString(strings.TrimSpace(args[2]))
+			if err != nil {
+				return fmt.Errorf("original account attribute type is invalid: %w", err)
+			}
+			origValue, err := getAttributeValue(origAttributeType, args[3])
+			if err != nil {
+				return err
+			}
+			updateAttributeType, err := types.AttributeTypeFromString(strings.TrimSpace(args[4]))
+			if err != nil {
+				return fmt.Errorf("update account attribute type is invalid: %w", err)
+			}
+			updateValue, err := getAttributeValue(updateAttributeType, args[5])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateAttributeRequest(
+				account,
+				clientCtx.GetFromAddress(),
+				args[0],
+				origValue,
+				updateValue,
+				origAttributeType,
+				updateAttributeType,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDeleteDistinctAccountAttributeCmd creates a command for removing a single account attribute value.
+func NewDeleteDistinctAccountAttributeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-distinct [name] [address] [type] [value]",
+		Short: "Delete an account attribute with a specific value from the provenance blockchain",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			account, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("account address must be a Bech32 string: %w", err)
+			}
+			attributeType, err := types.AttributeTypeFromString(strings.TrimSpace(args[2]))
+			if err != nil {
+				return fmt.Errorf("account attribute type is invalid: %w", err)
+			}
+			value, err := getAttributeValue(attributeType, args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteDistinctAttributeRequest(
+				account,
+				clientCtx.GetFromAddress(),
+				args[0],
+				value,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getAttributeValue decodes an attribute value argument, bytes values are expected to be base64 encoded.
+func getAttributeValue(attributeType types.AttributeType, value string) ([]byte, error) {
+	value = strings.TrimSpace(value)
+	if attributeType == types.AttributeType_Bytes {
+		return base64.StdEncoding.DecodeString(value)
+	}
+	return []byte(value), nil
+}
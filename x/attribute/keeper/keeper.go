@@ -86,7 +86,7 @@ func (k Keeper) IterateRecords(ctx sdk.Context, handle Handler) error {
 	// Iterate over records, processing callbacks.
 	for ; iterator.Valid(); iterator.Next() {
 		record := types.Attribute{}
-		if err := types.ModuleCdc.UnmarshalBinaryBare(iterator.Value(), &record); err != nil {
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &record); err != nil {
 			return err
 		}
 		// if address := parseAddressFromKey(iterator.Key()); address != "" {
@@ -129,15 +129,12 @@ func (k Keeper) SetAttribute(
 	if !k.nameKeeper.ResolvesTo(ctx, attr.Name, owner) {
 		return fmt.Errorf("\"%s\" does not resolve to address \"%s\"", attr.Name, owner.String())
 	}
-	// Store the sanitized account attribute
-	bz, err := types.ModuleCdc.MarshalBinaryBare(&attr)
-	if err != nil {
-		return err
+	// Ensure the attribute is not already expired
+	if attr.IsExpired(ctx.BlockTime()) {
+		return fmt.Errorf("attribute expiration date %v is before block time of %v", attr.ExpirationDate.UTC(), ctx.BlockTime().UTC())
 	}
-	key := types.AccountAttributeKey(acc, attr)
-	store := ctx.KVStore(k.storeKey)
-	store.Set(key, bz)
-	return nil
+	// Store the sanitized account attribute
+	return k.storeAttribute(ctx.KVStore(k.storeKey), acc, attr)
 }
 
 // Removes attributes under the given account. The attribute name must resolve to the given owner address.
@@ -153,16 +150,17 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, acc sdk.AccAddress, name string
 	// Delete all keys that match the name prefix
 	store := ctx.KVStore(k.storeKey)
 	it := sdk.KVStorePrefixIterator(store, types.AccountAttributesNameKeyPrefix(acc, name))
+	defer it.Close()
 	var count int
 	for ; it.Valid(); it.Next() {
 		attr := types.Attribute{}
-		if err := types.ModuleCdc.UnmarshalBinaryBare(it.Value(), &attr); err != nil {
+		if err := k.cdc.UnmarshalBinaryBare(it.Value(), &attr); err != nil {
 			return err
 		}
 		// Only delete exact matches
 		if attr.Name == name {
 			count++
-			store.Delete(it.Key())
+			k.deleteAttribute(store, it.Key(), attr)
 		}
 	}
 	if count == 0 {
@@ -170,9 +168,214 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, acc sdk.AccAddress, name string
 		ctx.Logger().Error(errm, "name", name)
 		return fmt.Errorf("%s with name %s", errm, name)
 	}
+	k.removeAttributeNameIndex(store, acc, name)
 	return nil
 }
 
+// Replaces a single attribute value under the given account. The attribute name must resolve to the given owner address.
+func (k Keeper) UpdateAttribute(
+	ctx sdk.Context, acc sdk.AccAddress, originalAttribute types.Attribute, updateAttribute types.Attribute, owner sdk.AccAddress,
+) error {
+	// Ensure both attributes are valid
+	if err := originalAttribute.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := updateAttribute.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Ensure attribute value length does not exceed max length value
+	maxLength := k.GetMaxValueLength(ctx)
+	if int(maxLength) < len(updateAttribute.Value) {
+		return fmt.Errorf("attribute value length of %v exceeds max length %v", len(updateAttribute.Value), maxLength)
+	}
+
+	// Ensure names are stored in normalized format.
+	var err error
+	if originalAttribute.Name, err = k.nameKeeper.Normalize(ctx, originalAttribute.Name); err != nil {
+		return fmt.Errorf("unable to normalize attribute name \"%s\": %w", originalAttribute.Name, err)
+	}
+	if updateAttribute.Name, err = k.nameKeeper.Normalize(ctx, updateAttribute.Name); err != nil {
+		return fmt.Errorf("unable to normalize attribute name \"%s\": %w", updateAttribute.Name, err)
+	}
+	if originalAttribute.Name != updateAttribute.Name {
+		return fmt.Errorf("update and original names must match %s : %s", updateAttribute.Name, originalAttribute.Name)
+	}
+	// Verify an account exists for the given owner address
+	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
+		return fmt.Errorf("no account found for owner address \"%s\"", owner.String())
+	}
+	// Verify name resolves to owner
+	if !k.nameKeeper.ResolvesTo(ctx, originalAttribute.Name, owner) {
+		return fmt.Errorf("\"%s\" does not resolve to address \"%s\"", originalAttribute.Name, owner.String())
+	}
+	// Verify the original attribute value exists on the account
+	store := ctx.KVStore(k.storeKey)
+	originalKey := types.AccountAttributeKey(acc, originalAttribute)
+	bz := store.Get(originalKey)
+	if bz == nil {
+		return fmt.Errorf("no attribute with name \"%s\" and the given original value found", originalAttribute.Name)
+	}
+	attr := types.Attribute{}
+	if err = k.cdc.UnmarshalBinaryBare(bz, &attr); err != nil {
+		return err
+	}
+	if attr.Name != originalAttribute.Name || attr.IsExpired(ctx.BlockTime()) {
+		return fmt.Errorf("no attribute with name \"%s\" and the given original value found", originalAttribute.Name)
+	}
+	if attr.AttributeType != originalAttribute.AttributeType {
+		return fmt.Errorf("no attribute with name \"%s\" and type %s found for the given original value",
+			originalAttribute.Name, originalAttribute.AttributeType)
+	}
+	// The update keeps the expiration of the original value unless a new expiration is given
+	if updateAttribute.ExpirationDate == nil {
+		updateAttribute.ExpirationDate = attr.ExpirationDate
+	}
+	if updateAttribute.IsExpired(ctx.BlockTime()) {
+		return fmt.Errorf("attribute expiration date %v is before block time of %v",
+			updateAttribute.ExpirationDate.UTC(), ctx.BlockTime().UTC())
+	}
+	// Replace the original account attribute with the sanitized update
+	k.deleteAttribute(store, originalKey, attr)
+	return k.storeAttribute(store, acc, updateAttribute)
+}
+
+// Removes a single attribute value under the given account. The attribute name must resolve to the given owner address.
+func (k Keeper) DeleteDistinctAttribute(ctx sdk.Context, acc sdk.AccAddress, name string, value []byte, owner sdk.AccAddress) error {
+	// Verify an account exists for the given owner address
+	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
+		return fmt.Errorf("no account found for owner address \"%s\"", owner.String())
+	}
+	// Verify name resolves to owner
+	if !k.nameKeeper.ResolvesTo(ctx, name, owner) {
+		return fmt.Errorf("\"%s\" does not resolve to address \"%s\"", name, owner.String())
+	}
+	// Delete the key for the name and value, only when it is an exact match
+	store := ctx.KVStore(k.storeKey)
+	key := types.AccountAttributeKey(acc, types.Attribute{Name: name, Value: value})
+	bz := store.Get(key)
+	if bz == nil {
+		return fmt.Errorf("no attribute with name \"%s\" and the given value found", name)
+	}
+	attr := types.Attribute{}
+	if err := k.cdc.UnmarshalBinaryBare(bz, &attr); err != nil {
+		return err
+	}
+	if attr.Name != name {
+		return fmt.Errorf("no attribute with name \"%s\" and the given value found", name)
+	}
+	k.deleteAttribute(store, key, attr)
+	k.removeAttributeNameIndex(store, acc, name)
+	return nil
+}
+
+// DeleteExpiredAttributes removes up to limit account attributes that have expired as of the current block time,
+// oldest expiration first. An event is emitted for each removed attribute. Returns the number of attributes removed.
+// Expiration index entries that reference an attribute which no longer exists are dropped.
+func (k Keeper) DeleteExpiredAttributes(ctx sdk.Context, limit int) (int, error) {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.AttributeExpirationTimeKeyPrefix(ctx.BlockTime()))
+	it := store.Iterator(types.AttributeExpirationKeyPrefix, end)
+	var expirationKeys, attributeKeys [][]byte
+	for ; it.Valid() && len(attributeKeys) < limit; it.Next() {
+		expirationKeys = append(expirationKeys, it.Key())
+		attributeKeys = append(attributeKeys, it.Value())
+	}
+	it.Close()
+
+	removed := 0
+	for i, key := range attributeKeys {
+		bz := store.Get(key)
+		if bz == nil {
+			// A stale index entry must not block the removal of the remaining expired attributes.
+			k.Logger(ctx).Error("removing expiration index entry for missing attribute", "key", fmt.Sprintf("%X", key))
+			store.Delete(expirationKeys[i])
+			continue
+		}
+		attr := types.Attribute{}
+		if err := k.cdc.UnmarshalBinaryBare(bz, &attr); err != nil {
+			return 0, err
+		}
+		acc, _, _ := types.SplitAccountAttributeKey(key)
+		k.deleteAttribute(store, key, attr)
+		k.removeAttributeNameIndex(store, acc, attr.Name)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAttributeExpired,
+				sdk.NewAttribute(types.AttributeKeyNameAttribute, attr.Name),
+				sdk.NewAttribute(types.AttributeKeyAccountAddress, acc.String()),
+				sdk.NewAttribute(types.AttributeKeyExpiration, attr.ExpirationDate.UTC().String()),
+			),
+		)
+		removed++
+	}
+	return removed, nil
+}
+
+// BuildAttributeNameIndex rebuilds the attribute name to account index from the stored attributes.
+func (k Keeper) BuildAttributeNameIndex(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.AttributeKeyPrefix)
+	var indexKeys, accounts [][]byte
+	for ; it.Valid(); it.Next() {
+		attr := types.Attribute{}
+		if err := k.cdc.UnmarshalBinaryBare(it.Value(), &attr); err != nil {
+			it.Close()
+			return err
+		}
+		acc, _, _ := types.SplitAccountAttributeKey(it.Key())
+		indexKeys = append(indexKeys, types.AttributeNameAddrKey(attr.Name, acc))
+		accounts = append(accounts, acc.Bytes())
+	}
+	it.Close()
+
+	for i, key := range indexKeys {
+		store.Set(key, accounts[i])
+	}
+	return nil
+}
+
+// Stores an account attribute and maintains the name and expiration indexes for it.
+func (k Keeper) storeAttribute(store sdk.KVStore, acc sdk.AccAddress, attr types.Attribute) error {
+	bz, err := k.cdc.MarshalBinaryBare(&attr)
+	if err != nil {
+		return err
+	}
+	key := types.AccountAttributeKey(acc, attr)
+	// An attribute with the same value may already exist with a different expiration
+	if existing := store.Get(key); existing != nil {
+		current := types.Attribute{}
+		if err = k.cdc.UnmarshalBinaryBare(existing, &current); err != nil {
+			return err
+		}
+		k.deleteAttribute(store, key, current)
+	}
+	store.Set(key, bz)
+	store.Set(types.AttributeNameAddrKey(attr.Name, acc), acc.Bytes())
+	if attr.ExpirationDate != nil {
+		store.Set(types.AttributeExpirationKey(*attr.ExpirationDate, key), key)
+	}
+	return nil
+}
+
+// Removes an account attribute and its expiration index entry.
+func (k Keeper) deleteAttribute(store sdk.KVStore, key []byte, attr types.Attribute) {
+	store.Delete(key)
+	if attr.ExpirationDate != nil {
+		store.Delete(types.AttributeExpirationKey(*attr.ExpirationDate, key))
+	}
+}
+
+// Removes the account from the attribute name index once no attributes with the name remain on the account.
+func (k Keeper) removeAttributeNameIndex(store sdk.KVStore, acc sdk.AccAddress, name string) {
+	it := sdk.KVStorePrefixIterator(store, types.AccountAttributesNameKeyPrefix(acc, name))
+	defer it.Close()
+	if !it.Valid() {
+		store.Delete(types.AttributeNameAddrKey(name, acc))
+	}
+}
+
 // A predicate function for matching names
 type namePred = func(string) bool
 
@@ -180,12 +383,14 @@ type namePred = func(string) bool
 func (k Keeper) prefixScan(ctx sdk.Context, prefix []byte, f namePred) (attrs []types.Attribute, err error) {
 	store := ctx.KVStore(k.storeKey)
 	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
 	for ; it.Valid(); it.Next() {
 		attr := types.Attribute{}
-		if err = types.ModuleCdc.UnmarshalBinaryBare(it.Value(), &attr); err != nil {
+		if err = k.cdc.UnmarshalBinaryBare(it.Value(), &attr); err != nil {
 			return
 		}
-		if f(attr.Name) {
+		// Expired attributes are hidden until they are removed in the end blocker
+		if f(attr.Name) && !attr.IsExpired(ctx.BlockTime()) {
 			attrs = append(attrs, attr)
 		}
 	}
@@ -211,12 +416,5 @@ func (k Keeper) importAttribute(ctx sdk.Context, attr types.Attribute) error {
 		return fmt.Errorf("unable to normalize attribute name \"%s\": %w", attr.Name, err)
 	}
 	// Store the sanitized account attribute
-	bz, err := types.ModuleCdc.MarshalBinaryBare(&attr)
-	if err != nil {
-		return err
-	}
-	key := types.AccountAttributeKey(acc, attr)
-	store := ctx.KVStore(k.storeKey)
-	store.Set(key, bz)
-	return nil
+	return k.storeAttribute(ctx.KVStore(k.storeKey), acc, attr)
 }
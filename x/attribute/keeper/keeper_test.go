@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -11,7 +12,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/provenance-io/provenance/x/attribute/types"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
 	"github.com/stretchr/testify/suite"
@@ -280,3 +283,243 @@ func (s *KeeperTestSuite) TestInitGenesisAddingAttributes() {
 
 	s.Assert().Panics(func() { s.app.AttributeKeeper.InitGenesis(s.ctx, &attributeData) })
 }
+
+func (s *KeeperTestSuite) TestAccountsByAttribute() {
+	accountsByAttribute := func(name string, limit uint64) []string {
+		res, err := s.app.AttributeKeeper.AccountsByAttribute(sdk.WrapSDKContext(s.ctx),
+			&types.QueryAccountsByAttributeRequest{Name: name, Pagination: &query.PageRequest{Limit: limit}})
+		s.NoError(err)
+		return res.Accounts
+	}
+
+	s.Empty(accountsByAttribute("example.attribute", 0))
+
+	for _, value := range []string{"first", "second"} {
+		attr := types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte(value))
+		s.NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, s.user1Addr, attr, s.user1Addr))
+	}
+	attr := types.NewAttribute("example.attribute", s.user2Addr, types.AttributeType_String, []byte("third"))
+	s.NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, s.user2Addr, attr, s.user1Addr))
+
+	s.ElementsMatch([]string{s.user1, s.user2}, accountsByAttribute("example.attribute", 0))
+	s.Len(accountsByAttribute("Example.Attribute", 1), 1)
+	s.Empty(accountsByAttribute("other.attribute", 0))
+
+	s.NoError(s.app.AttributeKeeper.DeleteAttribute(s.ctx, s.user1Addr, "example.attribute", s.user1Addr))
+	s.Equal([]string{s.user2}, accountsByAttribute("example.attribute", 0))
+
+	_, err := s.app.AttributeKeeper.AccountsByAttribute(sdk.WrapSDKContext(s.ctx), &types.QueryAccountsByAttributeRequest{Name: "attribute"})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = invalid attribute name")
+}
+
+func (s *KeeperTestSuite) TestBuildAttributeNameIndex() {
+	for _, acc := range []sdk.AccAddress{s.user1Addr, s.user2Addr} {
+		attr := types.NewAttribute("example.attribute", acc, types.AttributeType_String, []byte("value"))
+		s.NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, acc, attr, s.user1Addr))
+	}
+
+	// Drop the index as it is before the upgrade that adds it
+	index := prefix.NewStore(s.ctx.KVStore(s.app.GetKey(types.StoreKey)), types.AttributeNameAddrKeyPrefix)
+	it := index.Iterator(nil, nil)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	s.Len(keys, 2)
+	for _, key := range keys {
+		index.Delete(key)
+	}
+	res, err := s.app.AttributeKeeper.AccountsByAttribute(sdk.WrapSDKContext(s.ctx), &types.QueryAccountsByAttributeRequest{Name: "example.attribute"})
+	s.NoError(err)
+	s.Empty(res.Accounts)
+
+	s.NoError(s.app.AttributeKeeper.BuildAttributeNameIndex(s.ctx))
+	res, err = s.app.AttributeKeeper.AccountsByAttribute(sdk.WrapSDKContext(s.ctx), &types.QueryAccountsByAttributeRequest{Name: "example.attribute"})
+	s.NoError(err)
+	s.ElementsMatch([]string{s.user1, s.user2}, res.Accounts)
+}
+
+func (s *KeeperTestSuite) TestUpdateAttribute() {
+	attr := types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte("original"))
+	s.NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, s.user1Addr, attr, s.user1Addr))
+	other := types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte("other"))
+	s.NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, s.user1Addr, other, s.user1Addr))
+
+	cases := map[string]struct {
+		original  types.Attribute
+		update    types.Attribute
+		ownerAddr sdk.AccAddress
+		errorMsg  string
+	}{
+		"should fail to update, owner account does not exist": {
+			original:  attr,
+			update:    types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_Int, []byte("10")),
+			ownerAddr: s.user2Addr,
+			errorMsg:  fmt.Sprintf("no account found for owner address \"%s\"", s.user2Addr),
+		},
+		"should fail to update, names do not match": {
+			original:  attr,
+			update:    types.NewAttribute("attribute.example", s.user1Addr, types.AttributeType_Int, []byte("10")),
+			ownerAddr: s.user1Addr,
+			errorMsg:  "update and original names must match attribute.example : example.attribute",
+		},
+		"should fail to update, update value too long": {
+			original:  attr,
+			update:    types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte("0123456789a")),
+			ownerAddr: s.user1Addr,
+			errorMsg:  "attribute value length of 11 exceeds max length 10",
+		},
+		"should fail to update, original value not found": {
+			original:  types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte("dne")),
+			update:    types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_Int, []byte("10")),
+			ownerAddr: s.user1Addr,
+			errorMsg:  "no attribute with name \"example.attribute\" and the given original value found",
+		},
+		"should fail to update, original type does not match": {
+			original:  types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_Bytes, []byte("other")),
+			update:    types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_Int, []byte("10")),
+			ownerAddr: s.user1Addr,
+			errorMsg:  "no attribute with name \"example.attribute\" and type ATTRIBUTE_TYPE_BYTES found for the given original value",
+		},
+		"should successfully update attribute": {
+			original:  attr,
+			update:    types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_Int, []byte("10")),
+			ownerAddr: s.user1Addr,
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+
+		s.Run(n, func() {
+			err := s.app.AttributeKeeper.UpdateAttribute(s.ctx, s.user1Addr, tc.original, tc.update, tc.ownerAddr)
+			if len(tc.errorMsg) > 0 {
+				s.EqualError(err, tc.errorMsg)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+
+	attributes, err := s.app.AttributeKeeper.GetAttributes(s.ctx, s.user1Addr, "example.attribute")
+	s.NoError(err)
+	s.ElementsMatch([]types.Attribute{
+		other,
+		types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_Int, []byte("10")),
+	}, attributes)
+}
+
+func (s *KeeperTestSuite) TestDeleteDistinctAttribute() {
+	for _, value := range []string{"first", "second"} {
+		attr := types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte(value))
+		s.NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, s.user1Addr, attr, s.user1Addr))
+	}
+
+	err := s.app.AttributeKeeper.DeleteDistinctAttribute(s.ctx, s.user1Addr, "example.attribute", []byte("dne"), s.user1Addr)
+	s.EqualError(err, "no attribute with name \"example.attribute\" and the given value found")
+	err = s.app.AttributeKeeper.DeleteDistinctAttribute(s.ctx, s.user1Addr, "example.attribute", []byte("first"), s.user2Addr)
+	s.EqualError(err, fmt.Sprintf("no account found for owner address \"%s\"", s.user2Addr))
+
+	s.NoError(s.app.AttributeKeeper.DeleteDistinctAttribute(s.ctx, s.user1Addr, "example.attribute", []byte("first"), s.user1Addr))
+	attributes, err := s.app.AttributeKeeper.GetAttributes(s.ctx, s.user1Addr, "example.attribute")
+	s.NoError(err)
+	s.Equal([]types.Attribute{types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte("second"))}, attributes)
+	res, err := s.app.AttributeKeeper.AccountsByAttribute(sdk.WrapSDKContext(s.ctx), &types.QueryAccountsByAttributeRequest{Name: "example.attribute"})
+	s.NoError(err)
+	s.Equal([]string{s.user1}, res.Accounts, "account remains indexed while a value remains")
+
+	s.NoError(s.app.AttributeKeeper.DeleteDistinctAttribute(s.ctx, s.user1Addr, "example.attribute", []byte("second"), s.user1Addr))
+	res, err = s.app.AttributeKeeper.AccountsByAttribute(sdk.WrapSDKContext(s.ctx), &types.QueryAccountsByAttributeRequest{Name: "example.attribute"})
+	s.NoError(err)
+	s.Empty(res.Accounts)
+}
+
+func (s *KeeperTestSuite) TestExpiredAttributes() {
+	now := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockTime(now)
+	expiringAttribute := func(value string, expiration time.Time) types.Attribute {
+		attr := types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte(value))
+		attr.ExpirationDate = &expiration
+		return attr
+	}
+
+	err := s.app.AttributeKeeper.SetAttribute(ctx, s.user1Addr, expiringAttribute("past", now), s.user1Addr)
+	s.EqualError(err, "attribute expiration date 2021-05-01 00:00:00 +0000 UTC is before block time of 2021-05-01 00:00:00 +0000 UTC")
+
+	for i, value := range []string{"first", "second", "third"} {
+		attr := expiringAttribute(value, now.Add(time.Duration(i+1)*time.Hour))
+		s.NoError(s.app.AttributeKeeper.SetAttribute(ctx, s.user1Addr, attr, s.user1Addr))
+	}
+	// Setting an existing value again replaces its expiration
+	s.NoError(s.app.AttributeKeeper.SetAttribute(ctx, s.user1Addr, expiringAttribute("third", now.Add(5*time.Hour)), s.user1Addr))
+	s.NoError(s.app.AttributeKeeper.SetAttribute(ctx, s.user1Addr,
+		types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte("forever")), s.user1Addr))
+
+	// Expired attributes are hidden before they are removed
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	attributes, err := s.app.AttributeKeeper.GetAllAttributes(ctx, s.user1Addr)
+	s.NoError(err)
+	s.Len(attributes, 2)
+	res, err := s.app.AttributeKeeper.Attributes(sdk.WrapSDKContext(ctx), &types.QueryAttributesRequest{Account: s.user1})
+	s.NoError(err)
+	s.Len(res.Attributes, 2)
+
+	// Removal is done in bounded batches, oldest expiration first
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	count, err := s.app.AttributeKeeper.DeleteExpiredAttributes(ctx, 1)
+	s.NoError(err)
+	s.Equal(1, count)
+	s.Len(ctx.EventManager().Events(), 1)
+	s.Equal(types.EventTypeAttributeExpired, ctx.EventManager().Events()[0].Type)
+	count, err = s.app.AttributeKeeper.DeleteExpiredAttributes(ctx, 100)
+	s.NoError(err)
+	s.Equal(1, count)
+	count, err = s.app.AttributeKeeper.DeleteExpiredAttributes(ctx, 100)
+	s.NoError(err)
+	s.Equal(0, count)
+
+	records := 0
+	s.NoError(s.app.AttributeKeeper.IterateRecords(ctx, func(types.Attribute) error { records++; return nil }))
+	s.Equal(2, records)
+
+	// The account is removed from the name index once all values are gone
+	ctx = ctx.WithBlockTime(now.Add(5 * time.Hour))
+	s.NoError(s.app.AttributeKeeper.DeleteDistinctAttribute(ctx, s.user1Addr, "example.attribute", []byte("forever"), s.user1Addr))
+	// An account whose remaining values have all expired is not listed before they are removed
+	accounts, err := s.app.AttributeKeeper.AccountsByAttribute(sdk.WrapSDKContext(ctx), &types.QueryAccountsByAttributeRequest{Name: "example.attribute"})
+	s.NoError(err)
+	s.Empty(accounts.Accounts)
+	count, err = s.app.AttributeKeeper.DeleteExpiredAttributes(ctx, 100)
+	s.NoError(err)
+	s.Equal(1, count)
+	accounts, err = s.app.AttributeKeeper.AccountsByAttribute(sdk.WrapSDKContext(ctx), &types.QueryAccountsByAttributeRequest{Name: "example.attribute"})
+	s.NoError(err)
+	s.Empty(accounts.Accounts)
+}
+
+func (s *KeeperTestSuite) TestExpiredAttributesStaleIndex() {
+	now := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockTime(now)
+	for i, value := range []string{"first", "second"} {
+		expiration := now.Add(time.Duration(i+1) * time.Hour)
+		attr := types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte(value))
+		attr.ExpirationDate = &expiration
+		s.NoError(s.app.AttributeKeeper.SetAttribute(ctx, s.user1Addr, attr, s.user1Addr))
+	}
+
+	// Remove the first attribute record but leave its expiration index entry behind
+	store := ctx.KVStore(s.app.GetKey(types.StoreKey))
+	first := types.NewAttribute("example.attribute", s.user1Addr, types.AttributeType_String, []byte("first"))
+	store.Delete(types.AccountAttributeKey(s.user1Addr, first))
+
+	ctx = ctx.WithBlockTime(now.Add(3 * time.Hour))
+	count, err := s.app.AttributeKeeper.DeleteExpiredAttributes(ctx, 100)
+	s.NoError(err)
+	s.Equal(1, count)
+	it := sdk.KVStorePrefixIterator(store, types.AttributeExpirationKeyPrefix)
+	s.False(it.Valid(), "expiration index should be empty")
+	it.Close()
+	count, err = s.app.AttributeKeeper.DeleteExpiredAttributes(ctx, 100)
+	s.NoError(err)
+	s.Equal(0, count)
+}
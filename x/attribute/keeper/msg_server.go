@@ -25,10 +25,11 @@ func (k msgServer) AddAttribute(goCtx context.Context, msg *types.MsgAddAttribut
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	attrib := types.Attribute{
-		Address:       msg.Account,
-		Name:          msg.Name,
-		AttributeType: msg.AttributeType,
-		Value:         msg.Value,
+		Address:        msg.Account,
+		Name:           msg.Name,
+		AttributeType:  msg.AttributeType,
+		Value:          msg.Value,
+		ExpirationDate: msg.ExpirationDate,
 	}
 
 	accountAddr, err := sdk.AccAddressFromBech32(msg.Account)
@@ -89,3 +90,78 @@ func (k msgServer) DeleteAttribute(goCtx context.Context, msg *types.MsgDeleteAt
 
 	return &types.MsgDeleteAttributeResponse{}, nil
 }
+
+func (k msgServer) UpdateAttribute(goCtx context.Context, msg *types.MsgUpdateAttributeRequest) (*types.MsgUpdateAttributeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	originalAttribute := types.Attribute{
+		Address:       msg.Account,
+		Name:          msg.Name,
+		AttributeType: msg.OriginalAttributeType,
+		Value:         msg.OriginalValue,
+	}
+	updateAttribute := types.Attribute{
+		Address:       msg.Account,
+		Name:          msg.Name,
+		AttributeType: msg.UpdateAttributeType,
+		Value:         msg.UpdateValue,
+	}
+
+	accountAddr, err := sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.UpdateAttribute(ctx, accountAddr, originalAttribute, updateAttribute, ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, "attribute")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttributeUpdated,
+			sdk.NewAttribute(types.AttributeKeyNameAttribute, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyAccountAddress, msg.Account),
+		),
+	)
+
+	return &types.MsgUpdateAttributeResponse{}, nil
+}
+
+func (k msgServer) DeleteDistinctAttribute(goCtx context.Context, msg *types.MsgDeleteDistinctAttributeRequest) (*types.MsgDeleteDistinctAttributeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	accountAddr, err := sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.DeleteDistinctAttribute(ctx, accountAddr, msg.Name, msg.Value, ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, "attribute")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistinctAttributeDeleted,
+			sdk.NewAttribute(types.AttributeKeyNameAttribute, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyAccountAddress, msg.Account),
+		),
+	)
+
+	return &types.MsgDeleteDistinctAttributeResponse{}, nil
+}
@@ -44,14 +44,19 @@ func (k Keeper) Attribute(c context.Context, req *types.QueryAttributeRequest) (
 		return nil, status.Errorf(codes.InvalidArgument, "invalid account address")
 	}
 	attributeStore := prefix.NewStore(store, types.AccountAttributesNameKeyPrefix(accAddr, req.Name))
-	pageRes, err := query.Paginate(attributeStore, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(attributeStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var result types.Attribute
 		err = k.cdc.UnmarshalBinaryBare(value, &result)
 		if err != nil {
-			return err
+			return false, err
+		}
+		if result.IsExpired(ctx.BlockTime()) {
+			return false, nil
 		}
-		attributes = append(attributes, result)
-		return nil
+		if accumulate {
+			attributes = append(attributes, result)
+		}
+		return true, nil
 	})
 	if err != nil {
 		return nil, err
@@ -76,14 +81,19 @@ func (k Keeper) Attributes(c context.Context, req *types.QueryAttributesRequest)
 	}
 	attributeStore := prefix.NewStore(store, types.AccountAttributesKeyPrefix(accAddr))
 
-	pageRes, err := query.Paginate(attributeStore, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(attributeStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var result types.Attribute
 		err = k.cdc.UnmarshalBinaryBare(value, &result)
 		if err != nil {
-			return err
+			return false, err
 		}
-		attributes = append(attributes, result)
-		return nil
+		if result.IsExpired(ctx.BlockTime()) {
+			return false, nil
+		}
+		if accumulate {
+			attributes = append(attributes, result)
+		}
+		return true, nil
 	})
 
 	if err != nil {
@@ -119,7 +129,7 @@ func (k Keeper) Scan(c context.Context, req *types.QueryScanRequest) (*types.Que
 		if err != nil {
 			return false, err
 		}
-		if !strings.HasSuffix(result.Name, req.Suffix) {
+		if !strings.HasSuffix(result.Name, req.Suffix) || result.IsExpired(ctx.BlockTime()) {
 			return false, nil
 		}
 		if accumulate {
@@ -134,3 +144,43 @@ func (k Keeper) Scan(c context.Context, req *types.QueryScanRequest) (*types.Que
 
 	return &types.QueryScanResponse{Account: accAddr.String(), Attributes: attributes, Pagination: pageRes}, nil
 }
+
+// AccountsByAttribute queries for all accounts that have an attribute with the given name
+func (k Keeper) AccountsByAttribute(c context.Context, req *types.QueryAccountsByAttributeRequest) (*types.QueryAccountsByAttributeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	name := strings.ToLower(strings.TrimSpace(req.Name))
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty attribute name")
+	}
+	if !strings.Contains(name, ".") {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attribute name")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	accounts := make([]string, 0)
+	store := ctx.KVStore(k.storeKey)
+	accountStore := prefix.NewStore(store, types.AttributeNameKeyPrefix(name))
+
+	pred := func(s string) bool { return strings.EqualFold(s, name) }
+	pageRes, err := query.FilteredPaginate(accountStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		// Accounts stay indexed until the end blocker removes their expired values so only list those with a live value
+		attrs, err := k.prefixScan(ctx, types.AccountAttributesNameKeyPrefix(value, name), pred)
+		if err != nil {
+			return false, err
+		}
+		if len(attrs) == 0 {
+			return false, nil
+		}
+		if accumulate {
+			accounts = append(accounts, sdk.AccAddress(value).String())
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountsByAttributeResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
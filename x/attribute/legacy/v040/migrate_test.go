@@ -44,6 +44,7 @@ func TestMigrate(t *testing.T) {
     {
       "address": "%s",
       "attribute_type": "ATTRIBUTE_TYPE_STRING",
+      "expiration_date": null,
       "name": "test",
       "value": "dGVzdC12YWx1ZQ=="
     }
@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"net/url"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	uuid "github.com/google/uuid"
@@ -40,6 +41,11 @@ func (a Attribute) Hash() []byte {
 	return sum[:]
 }
 
+// IsExpired returns true when the attribute has an expiration date at or before the given time.
+func (a Attribute) IsExpired(blockTime time.Time) bool {
+	return a.ExpirationDate != nil && !a.ExpirationDate.After(blockTime)
+}
+
 // ValidateBascic ensures an attribute is valid.
 func (a Attribute) ValidateBasic() error {
 	if strings.TrimSpace(a.Name) == "" {
@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -134,6 +138,8 @@ type Attribute struct {
 	AttributeType AttributeType `protobuf:"varint,3,opt,name=attribute_type,json=attributeType,proto3,enum=provenance.attribute.v1.AttributeType" json:"attribute_type,omitempty"`
 	// The address the attribute is bound to
 	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// Time that an attribute will expire, expired attributes are hidden from queries and removed from the chain.
+	ExpirationDate *time.Time `protobuf:"bytes,5,opt,name=expiration_date,json=expirationDate,proto3,stdtime" json:"expiration_date,omitempty" yaml:"expiration_date,omitempty"`
 }
 
 func (m *Attribute) Reset()      { *m = Attribute{} }
@@ -196,6 +202,13 @@ func (m *Attribute) GetAddress() string {
 	return ""
 }
 
+func (m *Attribute) GetExpirationDate() *time.Time {
+	if m != nil {
+		return m.ExpirationDate
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("provenance.attribute.v1.AttributeType", AttributeType_name, AttributeType_value)
 	proto.RegisterType((*Params)(nil), "provenance.attribute.v1.Params")
@@ -207,37 +220,43 @@ func init() {
 }
 
 var fileDescriptor_14fe7eb43c711f5e = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x3b, 0xfd, 0xbb, 0x1d, 0x6d, 0x1d, 0xc6, 0x8a, 0x25, 0x87, 0x6c, 0x5c, 0x51, 0x8b,
-	0x60, 0xc3, 0x2a, 0x82, 0x78, 0x6b, 0xdd, 0x54, 0x22, 0x6b, 0x1b, 0x92, 0x89, 0xb0, 0x5e, 0xc2,
-	0xb4, 0x1d, 0xb3, 0x81, 0xe6, 0x0f, 0xc9, 0xb4, 0xb4, 0x5f, 0x21, 0x27, 0x8f, 0x5e, 0x02, 0x1e,
-	0xfc, 0x30, 0x1e, 0xf7, 0xe8, 0x49, 0xa4, 0xfd, 0x22, 0x92, 0x84, 0xdd, 0xd6, 0xda, 0xbd, 0x3d,
-	0xcf, 0xe4, 0xf7, 0xbe, 0xcf, 0x0b, 0x79, 0xe0, 0xb3, 0x20, 0xf4, 0x17, 0xcc, 0xa3, 0xde, 0x84,
-	0xc9, 0x94, 0xf3, 0xd0, 0x19, 0xcf, 0x39, 0x93, 0x17, 0xa7, 0x5b, 0xd3, 0x0d, 0x42, 0x9f, 0xfb,
-	0xf8, 0xe1, 0x16, 0xec, 0x6e, 0xbf, 0x2d, 0x4e, 0x85, 0x96, 0xed, 0xdb, 0x7e, 0xc6, 0xc8, 0xa9,
-	0xca, 0xf1, 0x93, 0x37, 0xb0, 0xaa, 0xd1, 0x90, 0xba, 0x11, 0xee, 0x40, 0xe4, 0xd2, 0xa5, 0xb5,
-	0xa0, 0xb3, 0x39, 0xb3, 0x66, 0xcc, 0xb3, 0xf9, 0x65, 0x1b, 0x48, 0xa0, 0xd3, 0xd0, 0x9b, 0x2e,
-	0x5d, 0x7e, 0x4a, 0x9f, 0xcf, 0xb3, 0xd7, 0xb7, 0xe5, 0x6f, 0xdf, 0x8f, 0x0b, 0x27, 0x3f, 0x00,
-	0xac, 0xf7, 0xae, 0x03, 0x30, 0x86, 0x65, 0x8f, 0xba, 0x2c, 0x9b, 0xa8, 0xeb, 0x99, 0xc6, 0x2d,
-	0x58, 0xc9, 0xb6, 0xb5, 0x8b, 0x12, 0xe8, 0xdc, 0xd5, 0x73, 0x83, 0x3f, 0xc2, 0xe6, 0xcd, 0x5d,
-	0x16, 0x5f, 0x05, 0xac, 0x5d, 0x92, 0x40, 0xa7, 0xf9, 0xf2, 0x69, 0xf7, 0x96, 0xcb, 0xbb, 0x37,
-	0x29, 0x64, 0x15, 0x30, 0xbd, 0x41, 0x77, 0x2d, 0x6e, 0xc3, 0x1a, 0x9d, 0x4e, 0x43, 0x16, 0x45,
-	0xed, 0x72, 0x96, 0x7d, 0x6d, 0xf3, 0x33, 0x9f, 0xff, 0x2e, 0xc2, 0xc6, 0x3f, 0x0b, 0xb0, 0x0c,
-	0x85, 0x1e, 0x21, 0xba, 0xda, 0x37, 0x89, 0x62, 0x91, 0x0b, 0x4d, 0xb1, 0xcc, 0xa1, 0xa1, 0x29,
-	0xef, 0xd4, 0x81, 0xaa, 0x9c, 0xa1, 0x82, 0x70, 0x2f, 0x4e, 0xa4, 0x3b, 0xa6, 0x17, 0x05, 0x6c,
-	0xe2, 0x7c, 0x71, 0xd8, 0x14, 0x3f, 0x82, 0xf7, 0xf7, 0x07, 0x4c, 0xf5, 0x0c, 0x01, 0xe1, 0x28,
-	0x4e, 0xa4, 0x72, 0xaa, 0x0f, 0x20, 0x1f, 0x8c, 0xd1, 0x10, 0x15, 0x73, 0x24, 0xd5, 0xf8, 0x09,
-	0x7c, 0xb0, 0x87, 0x18, 0x44, 0x57, 0x87, 0xef, 0x51, 0x49, 0x80, 0x71, 0x22, 0x55, 0x0d, 0x1e,
-	0x3a, 0x9e, 0x8d, 0x8f, 0x21, 0xde, 0x0f, 0xd3, 0x55, 0x54, 0x16, 0x6a, 0x71, 0x22, 0x95, 0xcc,
-	0xd0, 0x39, 0x00, 0xa8, 0x43, 0x82, 0x2a, 0x39, 0xa0, 0x7a, 0x1c, 0x3f, 0x86, 0xad, 0x3d, 0x60,
-	0x70, 0x3e, 0xea, 0x11, 0x54, 0x15, 0xea, 0x71, 0x22, 0x55, 0x06, 0x33, 0x9f, 0x1e, 0x82, 0x34,
-	0x7d, 0x44, 0x46, 0xa8, 0x96, 0x43, 0x5a, 0xd6, 0xa5, 0xff, 0xa1, 0xfe, 0x05, 0x51, 0x0c, 0x74,
-	0x94, 0x43, 0xfd, 0x15, 0x67, 0x51, 0xdf, 0xfd, 0xb9, 0x16, 0xc1, 0xd5, 0x5a, 0x04, 0x7f, 0xd6,
-	0x22, 0xf8, 0xba, 0x11, 0x0b, 0x57, 0x1b, 0xb1, 0xf0, 0x6b, 0x23, 0x16, 0xa0, 0xe0, 0xf8, 0xb7,
-	0xfd, 0x53, 0x0d, 0x7c, 0x7e, 0x6d, 0x3b, 0xfc, 0x72, 0x3e, 0xee, 0x4e, 0x7c, 0x57, 0xde, 0x52,
-	0x2f, 0x1c, 0x7f, 0xc7, 0xc9, 0xcb, 0x9d, 0xb2, 0xa7, 0x5d, 0x89, 0xc6, 0xd5, 0xac, 0xb7, 0xaf,
-	0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x53, 0xcb, 0xdf, 0xdd, 0x11, 0x03, 0x00, 0x00,
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xc1, 0x6e, 0xda, 0x4c,
+	0x14, 0x85, 0x19, 0x02, 0x24, 0x99, 0xfc, 0x21, 0xd6, 0xfc, 0xa9, 0x6a, 0x79, 0x61, 0xbb, 0x54,
+	0x6d, 0x51, 0x95, 0xda, 0x4a, 0xaa, 0x4a, 0x55, 0x76, 0x50, 0xa0, 0x72, 0x95, 0x82, 0x65, 0x4c,
+	0xa5, 0x74, 0x63, 0x0d, 0x30, 0x71, 0x46, 0xc2, 0x1e, 0xd7, 0x1e, 0x10, 0xbc, 0x02, 0xab, 0xec,
+	0xda, 0x0d, 0x52, 0x1f, 0x27, 0xcb, 0x2c, 0xbb, 0xa2, 0x15, 0xbc, 0x41, 0x9f, 0xa0, 0xc2, 0x16,
+	0x81, 0x52, 0xb2, 0xbb, 0x67, 0xe6, 0x9b, 0x7b, 0xaf, 0x8f, 0x8e, 0xe1, 0x8b, 0x20, 0x64, 0x03,
+	0xe2, 0x63, 0xbf, 0x43, 0x74, 0xcc, 0x79, 0x48, 0xdb, 0x7d, 0x4e, 0xf4, 0xc1, 0xe9, 0x4a, 0x68,
+	0x41, 0xc8, 0x38, 0x43, 0x8f, 0x57, 0xa0, 0xb6, 0xba, 0x1b, 0x9c, 0x4a, 0xc7, 0x2e, 0x73, 0x59,
+	0xcc, 0xe8, 0x8b, 0x2a, 0xc1, 0x25, 0xc5, 0x65, 0xcc, 0xed, 0x11, 0x3d, 0x56, 0xed, 0xfe, 0x95,
+	0xce, 0xa9, 0x47, 0x22, 0x8e, 0xbd, 0x20, 0x01, 0x0a, 0x6f, 0x61, 0xce, 0xc4, 0x21, 0xf6, 0x22,
+	0x54, 0x84, 0x82, 0x87, 0x87, 0xce, 0x00, 0xf7, 0xfa, 0xc4, 0xe9, 0x11, 0xdf, 0xe5, 0xd7, 0x22,
+	0x50, 0x41, 0xf1, 0xd0, 0xca, 0x7b, 0x78, 0xf8, 0x69, 0x71, 0x7c, 0x11, 0x9f, 0x9e, 0x67, 0xbe,
+	0x7d, 0x57, 0x52, 0x85, 0xaf, 0x69, 0xb8, 0x5f, 0x5a, 0x6e, 0x80, 0x10, 0xcc, 0xf8, 0xd8, 0x23,
+	0xf1, 0x8b, 0x7d, 0x2b, 0xae, 0xd1, 0x31, 0xcc, 0xc6, 0xdd, 0xc4, 0xb4, 0x0a, 0x8a, 0xff, 0x59,
+	0x89, 0x40, 0x1f, 0x61, 0xfe, 0x7e, 0x71, 0x87, 0x8f, 0x02, 0x22, 0xee, 0xa8, 0xa0, 0x98, 0x3f,
+	0x7b, 0xae, 0x3d, 0xf0, 0x69, 0xda, 0xfd, 0x14, 0x7b, 0x14, 0x10, 0xeb, 0x10, 0xaf, 0x4b, 0x24,
+	0xc2, 0x5d, 0xdc, 0xed, 0x86, 0x24, 0x8a, 0xc4, 0x4c, 0x3c, 0x7b, 0x29, 0xd1, 0x17, 0x78, 0x44,
+	0x86, 0x01, 0x0d, 0x31, 0xa7, 0xcc, 0x77, 0xba, 0x98, 0x13, 0x31, 0xab, 0x82, 0xe2, 0xc1, 0x99,
+	0xa4, 0x25, 0xae, 0x68, 0x4b, 0x57, 0x34, 0x7b, 0xe9, 0x4a, 0xf9, 0xe4, 0x76, 0xaa, 0x80, 0xdf,
+	0x53, 0x45, 0x1d, 0x61, 0xaf, 0x77, 0x5e, 0xd8, 0x68, 0x70, 0xc2, 0x3c, 0xca, 0x89, 0x17, 0xf0,
+	0x51, 0xe1, 0xe6, 0xa7, 0x02, 0xac, 0xfc, 0xea, 0xbe, 0x82, 0x39, 0x49, 0x9c, 0x79, 0x39, 0x4d,
+	0xc3, 0xc3, 0xbf, 0x76, 0x46, 0x3a, 0x94, 0x4a, 0xb6, 0x6d, 0x19, 0xe5, 0x96, 0x5d, 0x75, 0xec,
+	0x4b, 0xb3, 0xea, 0xb4, 0xea, 0x4d, 0xb3, 0xfa, 0xce, 0xa8, 0x19, 0xd5, 0x8a, 0x90, 0x92, 0x8e,
+	0xc6, 0x13, 0xf5, 0xa0, 0xe5, 0x47, 0x01, 0xe9, 0xd0, 0x2b, 0x4a, 0xba, 0xe8, 0x09, 0xfc, 0x7f,
+	0xf3, 0x41, 0xcb, 0xa8, 0x08, 0x40, 0xda, 0x1b, 0x4f, 0xd4, 0xcc, 0xa2, 0xde, 0x82, 0x7c, 0x68,
+	0x36, 0xea, 0x42, 0x3a, 0x41, 0x16, 0x35, 0x7a, 0x06, 0x1f, 0x6d, 0x20, 0x4d, 0xdb, 0x32, 0xea,
+	0xef, 0x85, 0x1d, 0x09, 0x8e, 0x27, 0x6a, 0xae, 0xc9, 0x43, 0xea, 0xbb, 0x48, 0x81, 0x68, 0x73,
+	0x98, 0x65, 0x08, 0x19, 0x69, 0x77, 0x3c, 0x51, 0x77, 0x5a, 0x21, 0xdd, 0x02, 0x18, 0x75, 0x5b,
+	0xc8, 0x26, 0x80, 0xe1, 0x73, 0xf4, 0x14, 0x1e, 0x6f, 0x00, 0xb5, 0x8b, 0x46, 0xc9, 0x16, 0x72,
+	0xd2, 0xfe, 0x78, 0xa2, 0x66, 0x6b, 0x3d, 0x86, 0xb7, 0x41, 0xa6, 0xd5, 0xb0, 0x1b, 0xc2, 0x6e,
+	0x02, 0x99, 0x71, 0xbe, 0xff, 0x85, 0xca, 0x97, 0x76, 0xb5, 0x29, 0xec, 0x25, 0x50, 0x79, 0xc4,
+	0x49, 0x54, 0xf6, 0x6e, 0x67, 0x32, 0xb8, 0x9b, 0xc9, 0xe0, 0xd7, 0x4c, 0x06, 0x37, 0x73, 0x39,
+	0x75, 0x37, 0x97, 0x53, 0x3f, 0xe6, 0x72, 0x0a, 0x4a, 0x94, 0x3d, 0x14, 0x23, 0x13, 0x7c, 0x7e,
+	0xe3, 0x52, 0x7e, 0xdd, 0x6f, 0x6b, 0x1d, 0xe6, 0xe9, 0x2b, 0xea, 0x15, 0x65, 0x6b, 0x4a, 0x1f,
+	0xae, 0xfd, 0x80, 0x8b, 0x78, 0x46, 0xed, 0x5c, 0x9c, 0x93, 0xd7, 0x7f, 0x06, 0x00, 0x0c, 0x0f,
+	0xf0, 0xce, 0xa5, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -288,6 +307,16 @@ func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpirationDate != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpirationDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpirationDate):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAttribute(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -361,6 +390,10 @@ func (m *Attribute) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
+	if m.ExpirationDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpirationDate)
+		n += 1 + l + sovAttribute(uint64(l))
+	}
 	return n
 }
 
@@ -585,6 +618,42 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttribute(dAtA[iNdEx:])
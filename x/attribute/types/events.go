@@ -5,8 +5,15 @@ const (
 	EventTypeAttributeAdded string = "account_attribute_added"
 	// The type of event generated when account attributes are removed.
 	EventTypeAttributeDeleted string = "account_attribute_deleted"
+	// The type of event generated when an account attribute value is updated.
+	EventTypeAttributeUpdated string = "account_attribute_updated"
+	// The type of event generated when a single account attribute value is removed.
+	EventTypeDistinctAttributeDeleted string = "account_attribute_distinct_deleted"
+	// The type of event generated when an expired account attribute is removed.
+	EventTypeAttributeExpired string = "account_attribute_expired"
 
 	AttributeKeyAttribute      string = "attribute"
 	AttributeKeyNameAttribute  string = "attribute_name"
 	AttributeKeyAccountAddress string = "account_address"
+	AttributeKeyExpiration     string = "attribute_expiration"
 )
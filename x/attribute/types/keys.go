@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -25,6 +26,10 @@ const (
 var (
 	AttributeKeyPrefix = []byte{0x00}
 	AttributeKeyLength = 1 + sdk.AddrLen + 32 + 32 // prefix + address + name-hash + value-hash
+
+	AttributeNameAddrKeyPrefix = []byte{0x01}
+
+	AttributeExpirationKeyPrefix = []byte{0x02}
 )
 
 // AccountAttributeKey creates a key for an account attribute
@@ -45,6 +50,26 @@ func AccountAttributesNameKeyPrefix(acc sdk.AccAddress, attributeName string) []
 	return append(key, GetNameKeyBytes(attributeName)...)
 }
 
+// AttributeNameAddrKey creates a key for the index of accounts that have an attribute with the given name
+func AttributeNameAddrKey(attributeName string, acc sdk.AccAddress) []byte {
+	return append(AttributeNameKeyPrefix(attributeName), acc.Bytes()...)
+}
+
+// AttributeNameKeyPrefix returns a prefix key for all accounts that have an attribute with a given name
+func AttributeNameKeyPrefix(attributeName string) []byte {
+	return append(AttributeNameAddrKeyPrefix, GetNameKeyBytes(attributeName)...)
+}
+
+// AttributeExpirationKey creates a key for the expiration ordered index of an account attribute
+func AttributeExpirationKey(expiration time.Time, attributeKey []byte) []byte {
+	return append(AttributeExpirationTimeKeyPrefix(expiration), attributeKey...)
+}
+
+// AttributeExpirationTimeKeyPrefix returns a prefix key for all account attributes expiring at a given time
+func AttributeExpirationTimeKeyPrefix(expiration time.Time) []byte {
+	return append(AttributeExpirationKeyPrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
 func SplitAccountAttributeKey(key []byte) (addr sdk.AccAddress, nameID []byte, valueID []byte) {
 	if len(key) != AttributeKeyLength {
 		panic(fmt.Sprintf("unexpected key length (%d ≠ %d)", len(key), AttributeKeyLength))
@@ -11,12 +11,17 @@ import (
 const (
 	TypeMsgAddAttribute    = "add_attribute"
 	TypeMsgDeleteAttribute = "delete_attribute"
+
+	TypeMsgUpdateAttribute         = "update_attribute"
+	TypeMsgDeleteDistinctAttribute = "delete_distinct_attribute"
 )
 
 // Compile time interface checks.
 var (
 	_ sdk.Msg = &MsgAddAttributeRequest{}
 	_ sdk.Msg = &MsgDeleteAttributeRequest{}
+	_ sdk.Msg = &MsgUpdateAttributeRequest{}
+	_ sdk.Msg = &MsgDeleteDistinctAttributeRequest{}
 )
 
 // NewMsgAddAttributeRequest creates a new add attribute message
@@ -125,3 +130,128 @@ func (msg MsgDeleteAttributeRequest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgUpdateAttributeRequest creates a new update attribute message
+func NewMsgUpdateAttributeRequest(account sdk.AccAddress, owner sdk.AccAddress, name string, originalValue []byte, updateValue []byte, origAttrType AttributeType, updateAttrType AttributeType) *MsgUpdateAttributeRequest { // nolint:interfacer
+	return &MsgUpdateAttributeRequest{
+		Account:               account.String(),
+		Name:                  strings.ToLower(strings.TrimSpace(name)),
+		Owner:                 owner.String(),
+		OriginalValue:         originalValue,
+		UpdateValue:           updateValue,
+		OriginalAttributeType: origAttrType,
+		UpdateAttributeType:   updateAttrType,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgUpdateAttributeRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the message action.
+func (msg MsgUpdateAttributeRequest) Type() string { return TypeMsgUpdateAttribute }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgUpdateAttributeRequest) ValidateBasic() error {
+	if len(msg.Account) == 0 {
+		return fmt.Errorf("empty account address")
+	}
+	accAddr, err := sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		return err
+	}
+	if len(msg.Owner) == 0 {
+		return fmt.Errorf("empty owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	original := NewAttribute(msg.Name, accAddr, msg.OriginalAttributeType, msg.OriginalValue)
+	if err := original.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid original attribute: %w", err)
+	}
+	update := NewAttribute(msg.Name, accAddr, msg.UpdateAttributeType, msg.UpdateValue)
+	if err := update.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid update attribute: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUpdateAttributeRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the name owner.
+func (msg MsgUpdateAttributeRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(fmt.Errorf("invalid owner value on message: %w", err))
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// String implements stringer interface
+func (msg MsgUpdateAttributeRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// NewMsgDeleteDistinctAttributeRequest creates a new delete distinct attribute message
+func NewMsgDeleteDistinctAttributeRequest(account sdk.AccAddress, owner sdk.AccAddress, name string, value []byte) *MsgDeleteDistinctAttributeRequest { // nolint:interfacer
+	return &MsgDeleteDistinctAttributeRequest{Account: account.String(), Name: strings.ToLower(strings.TrimSpace(name)), Owner: owner.String(), Value: value}
+}
+
+// Route returns the name of the module.
+func (msg MsgDeleteDistinctAttributeRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the message action.
+func (msg MsgDeleteDistinctAttributeRequest) Type() string { return TypeMsgDeleteDistinctAttribute }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgDeleteDistinctAttributeRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("empty name")
+	}
+	if msg.Value == nil {
+		return fmt.Errorf("invalid value: nil")
+	}
+	if len(msg.Account) == 0 {
+		return fmt.Errorf("empty account address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return err
+	}
+	if len(msg.Owner) == 0 {
+		return fmt.Errorf("empty owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	return nil
+}
+
+// String implements stringer interface
+func (msg MsgDeleteDistinctAttributeRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDeleteDistinctAttributeRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the name owner.
+func (msg MsgDeleteDistinctAttributeRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(fmt.Errorf("invalid owner value on message: %w", err))
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -50,3 +50,66 @@ func TestMsgAddAttribute(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for TestMsgUpdateAttribute
+func TestMsgUpdateAttribute(t *testing.T) {
+	tests := []struct {
+		account, owner             sdk.AccAddress
+		name                       string
+		originalValue, updateValue []byte
+		originalType, updateType   AttributeType
+		expectPass                 bool
+	}{
+		{nil, addrs[1], "test", []byte("original"), []byte("update"), AttributeType_String, AttributeType_String, false},
+		{addrs[0], nil, "test", []byte("original"), []byte("update"), AttributeType_String, AttributeType_String, false},
+		{addrs[0], addrs[1], "", []byte("original"), []byte("update"), AttributeType_String, AttributeType_String, false},
+		{addrs[0], addrs[1], "test", nil, []byte("update"), AttributeType_String, AttributeType_String, false},
+		{addrs[0], addrs[1], "test", []byte("original"), []byte("update"), AttributeType_String, AttributeType_Int, false},
+		{addrs[0], addrs[1], "test", []byte("original"), []byte("10"), AttributeType_String, AttributeType_Int, true},
+		{addrs[0], addrs[1], "test", []byte("original"), []byte("update"), AttributeType_String, AttributeType_String, true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgUpdateAttributeRequest(
+			tc.account,
+			tc.owner,
+			tc.name,
+			tc.originalValue,
+			tc.updateValue,
+			tc.originalType,
+			tc.updateType,
+		)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for TestMsgDeleteDistinctAttribute
+func TestMsgDeleteDistinctAttribute(t *testing.T) {
+	tests := []struct {
+		account, owner sdk.AccAddress
+		name           string
+		value          []byte
+		expectPass     bool
+	}{
+		{nil, addrs[1], "test", []byte("value"), false},
+		{addrs[0], nil, "test", []byte("value"), false},
+		{addrs[0], addrs[1], "", []byte("value"), false},
+		{addrs[0], addrs[1], "test", nil, false},
+		{addrs[0], addrs[1], "test", []byte("value"), true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgDeleteDistinctAttributeRequest(tc.account, tc.owner, tc.name, tc.value)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
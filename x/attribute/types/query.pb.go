@@ -432,6 +432,102 @@ func (m *QueryScanResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAccountsByAttributeRequest is the request type for the Query/AccountsByAttribute method.
+type QueryAccountsByAttributeRequest struct {
+	// name is the attribute name to query for
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountsByAttributeRequest) Reset()         { *m = QueryAccountsByAttributeRequest{} }
+func (m *QueryAccountsByAttributeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByAttributeRequest) ProtoMessage()    {}
+func (*QueryAccountsByAttributeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{8}
+}
+func (m *QueryAccountsByAttributeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByAttributeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByAttributeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountsByAttributeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByAttributeRequest.Merge(m, src)
+}
+func (m *QueryAccountsByAttributeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByAttributeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByAttributeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsByAttributeRequest proto.InternalMessageInfo
+
+// QueryAccountsByAttributeResponse is the response type for the Query/AccountsByAttribute method.
+type QueryAccountsByAttributeResponse struct {
+	// a list of addresses of the accounts that have an attribute with the given name
+	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountsByAttributeResponse) Reset()         { *m = QueryAccountsByAttributeResponse{} }
+func (m *QueryAccountsByAttributeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByAttributeResponse) ProtoMessage()    {}
+func (*QueryAccountsByAttributeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{9}
+}
+func (m *QueryAccountsByAttributeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByAttributeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByAttributeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountsByAttributeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByAttributeResponse.Merge(m, src)
+}
+func (m *QueryAccountsByAttributeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByAttributeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByAttributeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsByAttributeResponse proto.InternalMessageInfo
+
+func (m *QueryAccountsByAttributeResponse) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryAccountsByAttributeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.attribute.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.attribute.v1.QueryParamsResponse")
@@ -441,6 +537,8 @@ func init() {
 	proto.RegisterType((*QueryAttributesResponse)(nil), "provenance.attribute.v1.QueryAttributesResponse")
 	proto.RegisterType((*QueryScanRequest)(nil), "provenance.attribute.v1.QueryScanRequest")
 	proto.RegisterType((*QueryScanResponse)(nil), "provenance.attribute.v1.QueryScanResponse")
+	proto.RegisterType((*QueryAccountsByAttributeRequest)(nil), "provenance.attribute.v1.QueryAccountsByAttributeRequest")
+	proto.RegisterType((*QueryAccountsByAttributeResponse)(nil), "provenance.attribute.v1.QueryAccountsByAttributeResponse")
 }
 
 func init() {
@@ -448,46 +546,51 @@ func init() {
 }
 
 var fileDescriptor_79f9aff39a1796c1 = []byte{
-	// 620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x7d, 0x69, 0x9a, 0xdf, 0xaf, 0x4f, 0x17, 0x38, 0x4a, 0x1b, 0x59, 0xc8, 0x29, 0x46,
-	0x22, 0xa5, 0x50, 0x1f, 0x49, 0x95, 0xa5, 0xc0, 0x40, 0x06, 0x60, 0x0c, 0x81, 0x89, 0xed, 0x62,
-	0x5d, 0x8d, 0x25, 0xe2, 0x73, 0x73, 0xe7, 0xa8, 0x55, 0x94, 0x05, 0x31, 0x30, 0x20, 0x81, 0x84,
-	0x04, 0x6b, 0x59, 0x90, 0x78, 0x17, 0x2c, 0xa0, 0x8e, 0x95, 0x58, 0x98, 0x10, 0x4a, 0x18, 0x78,
-	0x19, 0x28, 0xe7, 0x4b, 0xe2, 0x36, 0xb8, 0x09, 0x12, 0x0c, 0xdd, 0xce, 0x97, 0xe7, 0xcf, 0xe7,
-	0xfb, 0xbd, 0xbb, 0x27, 0x70, 0x29, 0x6c, 0xf1, 0x36, 0x0b, 0x68, 0xe0, 0x32, 0x42, 0xa5, 0x6c,
-	0xf9, 0x8d, 0x48, 0x32, 0xd2, 0x2e, 0x91, 0x9d, 0x88, 0xb5, 0xf6, 0x9c, 0xb0, 0xc5, 0x25, 0xc7,
-	0x2b, 0xe3, 0x20, 0x67, 0x14, 0xe4, 0xb4, 0x4b, 0xe6, 0xba, 0xcb, 0x45, 0x93, 0x0b, 0xd2, 0xa0,
-	0x82, 0xc5, 0x19, 0xa4, 0x5d, 0x6a, 0x30, 0x49, 0x4b, 0x24, 0xa4, 0x9e, 0x1f, 0x50, 0xe9, 0xf3,
-	0x20, 0x2e, 0x62, 0x2e, 0x79, 0xdc, 0xe3, 0x6a, 0x49, 0x06, 0x2b, 0xbd, 0x7b, 0xc1, 0xe3, 0xdc,
-	0x7b, 0xc2, 0x08, 0x0d, 0x7d, 0x42, 0x83, 0x80, 0x4b, 0x95, 0x22, 0xf4, 0xaf, 0xc5, 0x34, 0xba,
-	0x31, 0x85, 0x0a, 0xb4, 0x97, 0x00, 0xdf, 0x1f, 0xb4, 0xaf, 0xd1, 0x16, 0x6d, 0x8a, 0x3a, 0xdb,
-	0x89, 0x98, 0x90, 0xf6, 0x43, 0x38, 0x77, 0x64, 0x57, 0x84, 0x3c, 0x10, 0x0c, 0xdf, 0x82, 0x5c,
-	0xa8, 0x76, 0xf2, 0x68, 0x15, 0xad, 0x2d, 0x96, 0x0b, 0x4e, 0x8a, 0x3e, 0x27, 0x4e, 0xac, 0x66,
-	0x0f, 0xbe, 0x15, 0x8c, 0xba, 0x4e, 0xb2, 0xdf, 0x22, 0x38, 0xaf, 0xca, 0xde, 0x1e, 0x86, 0xea,
-	0x7e, 0x38, 0x0f, 0xff, 0x51, 0xd7, 0xe5, 0x51, 0x20, 0x55, 0xe5, 0x85, 0xfa, 0xf0, 0x13, 0x63,
-	0xc8, 0x06, 0xb4, 0xc9, 0xf2, 0x19, 0xb5, 0xad, 0xd6, 0xf8, 0x0e, 0xc0, 0xd8, 0xa4, 0xfc, 0x9c,
-	0x42, 0xb9, 0xec, 0xc4, 0x8e, 0x3a, 0x03, 0x47, 0x9d, 0xf8, 0x0c, 0xb4, 0xa3, 0x4e, 0x8d, 0x7a,
-	0xc3, 0x4e, 0xf5, 0x44, 0xe6, 0xd6, 0xff, 0xcf, 0xf7, 0x0b, 0xc6, 0xcf, 0xfd, 0x82, 0x61, 0x7f,
-	0x42, 0xb0, 0x7c, 0x9c, 0x4c, 0x6b, 0x4e, 0x47, 0xbb, 0x07, 0x30, 0xd2, 0x2c, 0xf2, 0x99, 0xd5,
-	0xb9, 0xb5, 0xc5, 0xb2, 0x9d, 0xea, 0xc8, 0xa8, 0xb2, 0x36, 0x25, 0x91, 0x8b, 0xef, 0xfe, 0x46,
-	0x50, 0x71, 0xaa, 0xa0, 0x18, 0x30, 0xa9, 0xc8, 0x7e, 0x36, 0xa1, 0x43, 0x4c, 0xb7, 0xf8, 0xa8,
-	0x9d, 0x99, 0xbf, 0x60, 0xe7, 0x67, 0x04, 0x2b, 0x13, 0x18, 0xa7, 0xd1, 0xcf, 0x37, 0x08, 0xce,
-	0x28, 0x21, 0x0f, 0x5c, 0x1a, 0x4c, 0x77, 0x72, 0x19, 0x72, 0x22, 0xda, 0xde, 0xf6, 0x77, 0xf5,
-	0x75, 0xd5, 0x5f, 0xff, 0xe0, 0xc2, 0x7e, 0x44, 0x70, 0x36, 0x01, 0x76, 0x0a, 0xbd, 0x2d, 0xbf,
-	0x9c, 0x87, 0x79, 0x25, 0x01, 0xbf, 0x40, 0x90, 0x8b, 0x07, 0x06, 0xbe, 0x9a, 0xca, 0x34, 0x39,
-	0xa5, 0xcc, 0x6b, 0xb3, 0x05, 0xc7, 0xbd, 0xed, 0xe2, 0xd3, 0x2f, 0x3f, 0x5e, 0x67, 0x2e, 0xe2,
-	0x02, 0x49, 0x9b, 0x8d, 0xf1, 0x98, 0xc2, 0x1f, 0x10, 0x2c, 0x8c, 0x1c, 0xc0, 0xce, 0xc9, 0x4d,
-	0x8e, 0x8f, 0x32, 0x93, 0xcc, 0x1c, 0xaf, 0xb9, 0x6e, 0x28, 0xae, 0x0a, 0xde, 0x24, 0x53, 0x67,
-	0x36, 0xe9, 0xe8, 0xf3, 0xec, 0x92, 0xce, 0x60, 0x12, 0x76, 0xf1, 0x7b, 0x04, 0x30, 0x7e, 0x64,
-	0x78, 0xd6, 0xe6, 0x23, 0x0b, 0xaf, 0xcf, 0x9e, 0xa0, 0x71, 0x2b, 0x0a, 0x97, 0xe0, 0x8d, 0xe9,
-	0xb8, 0x62, 0xcc, 0x8b, 0xdf, 0x21, 0xc8, 0x0e, 0xee, 0x2a, 0xbe, 0x72, 0x72, 0xc7, 0xc4, 0x43,
-	0x33, 0xd7, 0x67, 0x09, 0xd5, 0x58, 0x55, 0x85, 0x75, 0x13, 0x6f, 0xfd, 0x91, 0x8b, 0xc2, 0xa5,
-	0x01, 0xe9, 0xc4, 0xaf, 0xb4, 0x5b, 0x6d, 0x1e, 0xf4, 0x2c, 0x74, 0xd8, 0xb3, 0xd0, 0xf7, 0x9e,
-	0x85, 0x5e, 0xf5, 0x2d, 0xe3, 0xb0, 0x6f, 0x19, 0x5f, 0xfb, 0x96, 0x01, 0xa6, 0xcf, 0xd3, 0x58,
-	0x6a, 0xe8, 0x51, 0xc5, 0xf3, 0xe5, 0xe3, 0xa8, 0xe1, 0xb8, 0xbc, 0x99, 0xe8, 0xbe, 0xe1, 0xf3,
-	0x24, 0xcb, 0x6e, 0x02, 0x40, 0xee, 0x85, 0x4c, 0x34, 0x72, 0xea, 0x1f, 0x78, 0xf3, 0x57, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x6f, 0x59, 0xc9, 0xa6, 0x4a, 0x08, 0x00, 0x00,
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xc1, 0x4f, 0x13, 0x4f,
+	0x14, 0xc7, 0x3b, 0x85, 0x5f, 0x7f, 0xf0, 0xb8, 0xe8, 0x03, 0xa1, 0xd9, 0x98, 0x16, 0xd7, 0x44,
+	0x2a, 0xca, 0x0e, 0x85, 0x90, 0x28, 0xea, 0xc1, 0x1e, 0xc4, 0x23, 0x56, 0x4f, 0xde, 0xa6, 0x9b,
+	0x61, 0xdd, 0xc4, 0xee, 0x2c, 0x9d, 0x6d, 0x03, 0x21, 0x5c, 0x8c, 0x09, 0x1e, 0x3c, 0x98, 0x98,
+	0xe8, 0x15, 0x2f, 0x26, 0xfe, 0x0f, 0x1e, 0xbc, 0x68, 0x38, 0x19, 0x12, 0x2f, 0x9e, 0x8c, 0x01,
+	0x0f, 0xfe, 0x19, 0xa6, 0x33, 0xd3, 0x76, 0xa1, 0x2c, 0xad, 0x04, 0x0f, 0xdc, 0x76, 0xa7, 0xef,
+	0xcd, 0xfb, 0x7c, 0xbf, 0xf3, 0xe6, 0x6d, 0xe1, 0x72, 0x58, 0x13, 0x0d, 0x1e, 0xb0, 0xc0, 0xe5,
+	0x94, 0x45, 0x51, 0xcd, 0xaf, 0xd4, 0x23, 0x4e, 0x1b, 0x45, 0xba, 0x5a, 0xe7, 0xb5, 0x75, 0x27,
+	0xac, 0x89, 0x48, 0xe0, 0x44, 0x27, 0xc8, 0x69, 0x07, 0x39, 0x8d, 0xa2, 0x35, 0xed, 0x0a, 0x59,
+	0x15, 0x92, 0x56, 0x98, 0xe4, 0x3a, 0x83, 0x36, 0x8a, 0x15, 0x1e, 0xb1, 0x22, 0x0d, 0x99, 0xe7,
+	0x07, 0x2c, 0xf2, 0x45, 0xa0, 0x37, 0xb1, 0xc6, 0x3c, 0xe1, 0x09, 0xf5, 0x48, 0x9b, 0x4f, 0x66,
+	0xf5, 0xa2, 0x27, 0x84, 0xf7, 0x94, 0x53, 0x16, 0xfa, 0x94, 0x05, 0x81, 0x88, 0x54, 0x8a, 0x34,
+	0xbf, 0x4e, 0x25, 0xd1, 0x75, 0x28, 0x54, 0xa0, 0x3d, 0x06, 0xf8, 0xa0, 0x59, 0x7e, 0x99, 0xd5,
+	0x58, 0x55, 0x96, 0xf9, 0x6a, 0x9d, 0xcb, 0xc8, 0x7e, 0x04, 0xa3, 0x07, 0x56, 0x65, 0x28, 0x02,
+	0xc9, 0xf1, 0x0e, 0x64, 0x42, 0xb5, 0x92, 0x25, 0x93, 0xa4, 0x30, 0x32, 0x97, 0x77, 0x12, 0xf4,
+	0x39, 0x3a, 0xb1, 0x34, 0xb8, 0xf3, 0x23, 0x9f, 0x2a, 0x9b, 0x24, 0xfb, 0x2d, 0x81, 0x0b, 0x6a,
+	0xdb, 0xbb, 0xad, 0x50, 0x53, 0x0f, 0xb3, 0xf0, 0x3f, 0x73, 0x5d, 0x51, 0x0f, 0x22, 0xb5, 0xf3,
+	0x70, 0xb9, 0xf5, 0x8a, 0x08, 0x83, 0x01, 0xab, 0xf2, 0x6c, 0x5a, 0x2d, 0xab, 0x67, 0xbc, 0x07,
+	0xd0, 0x31, 0x29, 0x3b, 0xa0, 0x50, 0xae, 0x38, 0xda, 0x51, 0xa7, 0xe9, 0xa8, 0xa3, 0xcf, 0xc0,
+	0x38, 0xea, 0x2c, 0x33, 0xaf, 0x55, 0xa9, 0x1c, 0xcb, 0x5c, 0x1c, 0x7a, 0xb1, 0x9d, 0x4f, 0xfd,
+	0xde, 0xce, 0xa7, 0xec, 0xcf, 0x04, 0xc6, 0x0f, 0x93, 0x19, 0xcd, 0xc9, 0x68, 0xf7, 0x01, 0xda,
+	0x9a, 0x65, 0x36, 0x3d, 0x39, 0x50, 0x18, 0x99, 0xb3, 0x13, 0x1d, 0x69, 0xef, 0x6c, 0x4c, 0x89,
+	0xe5, 0xe2, 0xd2, 0x11, 0x82, 0xa6, 0x7a, 0x0a, 0xd2, 0x80, 0x71, 0x45, 0xf6, 0xf3, 0x2e, 0x1d,
+	0xb2, 0xb7, 0xc5, 0x07, 0xed, 0x4c, 0x9f, 0x82, 0x9d, 0x5f, 0x08, 0x4c, 0x74, 0x61, 0x9c, 0x45,
+	0x3f, 0xdf, 0x10, 0x38, 0xa7, 0x84, 0x3c, 0x74, 0x59, 0xd0, 0xdb, 0xc9, 0x71, 0xc8, 0xc8, 0xfa,
+	0xca, 0x8a, 0xbf, 0x66, 0xda, 0xd5, 0xbc, 0xfd, 0x83, 0x86, 0xfd, 0x44, 0xe0, 0x7c, 0x0c, 0xec,
+	0x2c, 0x7a, 0xbb, 0x45, 0x20, 0xaf, 0x9b, 0x44, 0x33, 0xca, 0x52, 0xf7, 0x5c, 0x68, 0xdd, 0x7e,
+	0x92, 0x78, 0xfb, 0x4f, 0xa3, 0x5d, 0xb7, 0x08, 0x4c, 0x26, 0x93, 0x18, 0x6f, 0x2d, 0x18, 0x32,
+	0x66, 0x36, 0xa7, 0xdf, 0x40, 0x61, 0xb8, 0xdc, 0x7e, 0xc7, 0xa5, 0x23, 0x90, 0x4e, 0xe2, 0xc9,
+	0xdc, 0xd7, 0x0c, 0xfc, 0xa7, 0x48, 0xf0, 0x25, 0x81, 0x8c, 0x1e, 0xa2, 0x78, 0x2d, 0xf1, 0x9c,
+	0xba, 0x27, 0xb7, 0x75, 0xbd, 0xbf, 0x60, 0x5d, 0xdb, 0x9e, 0x7a, 0xf6, 0xed, 0xd7, 0xeb, 0xf4,
+	0x25, 0xcc, 0xd3, 0xa4, 0xef, 0x85, 0x1e, 0xdd, 0xf8, 0x81, 0xc0, 0x70, 0xdb, 0x13, 0x74, 0x8e,
+	0x2f, 0x72, 0xf8, 0x18, 0x2d, 0xda, 0x77, 0xbc, 0xe1, 0xba, 0xa5, 0xb8, 0x16, 0x70, 0x9e, 0xf6,
+	0xfc, 0x8e, 0xd1, 0x0d, 0x73, 0x0c, 0x9b, 0x74, 0xa3, 0xd9, 0x1f, 0x9b, 0xf8, 0x9e, 0x00, 0x74,
+	0x06, 0x0f, 0xf6, 0x5b, 0xbc, 0x6d, 0xe1, 0x6c, 0xff, 0x09, 0x06, 0x77, 0x41, 0xe1, 0x52, 0x9c,
+	0xe9, 0x8d, 0x2b, 0x3b, 0xbc, 0xf8, 0x8e, 0xc0, 0x60, 0xf3, 0xfe, 0xe2, 0xd5, 0xe3, 0x2b, 0xc6,
+	0x86, 0x8f, 0x35, 0xdd, 0x4f, 0xa8, 0xc1, 0x2a, 0x29, 0xac, 0xdb, 0xb8, 0xf8, 0x57, 0x2e, 0x4a,
+	0x97, 0x05, 0x74, 0x43, 0x4f, 0xae, 0x4d, 0xfc, 0x48, 0x60, 0xf4, 0x88, 0x6b, 0x81, 0x37, 0x7a,
+	0x98, 0x94, 0x78, 0xa7, 0xad, 0x9b, 0x27, 0xc8, 0x34, 0x82, 0x66, 0x95, 0xa0, 0x69, 0x2c, 0x24,
+	0x0b, 0x32, 0xd9, 0xa6, 0x17, 0x4a, 0xd5, 0x9d, 0xbd, 0x1c, 0xd9, 0xdd, 0xcb, 0x91, 0x9f, 0x7b,
+	0x39, 0xf2, 0x6a, 0x3f, 0x97, 0xda, 0xdd, 0xcf, 0xa5, 0xbe, 0xef, 0xe7, 0x52, 0x60, 0xf9, 0x22,
+	0x09, 0x64, 0x99, 0x3c, 0x5e, 0xf0, 0xfc, 0xe8, 0x49, 0xbd, 0xe2, 0xb8, 0xa2, 0x1a, 0xab, 0x35,
+	0xe3, 0x8b, 0x78, 0xe5, 0xb5, 0x58, 0xed, 0x68, 0x3d, 0xe4, 0xb2, 0x92, 0x51, 0x7f, 0xaa, 0xe6,
+	0xff, 0x0c, 0x00, 0x8a, 0x6e, 0x29, 0x4e, 0x1d, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -510,6 +613,8 @@ type QueryClient interface {
 	Attributes(ctx context.Context, in *QueryAttributesRequest, opts ...grpc.CallOption) (*QueryAttributesResponse, error)
 	// Scan queries attributes on a given account (address) for any that match the provided suffix
 	Scan(ctx context.Context, in *QueryScanRequest, opts ...grpc.CallOption) (*QueryScanResponse, error)
+	// AccountsByAttribute queries the accounts that have an attribute with the given name
+	AccountsByAttribute(ctx context.Context, in *QueryAccountsByAttributeRequest, opts ...grpc.CallOption) (*QueryAccountsByAttributeResponse, error)
 }
 
 type queryClient struct {
@@ -556,6 +661,15 @@ func (c *queryClient) Scan(ctx context.Context, in *QueryScanRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) AccountsByAttribute(ctx context.Context, in *QueryAccountsByAttributeRequest, opts ...grpc.CallOption) (*QueryAccountsByAttributeResponse, error) {
+	out := new(QueryAccountsByAttributeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AccountsByAttribute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the attribute module.
@@ -566,6 +680,8 @@ type QueryServer interface {
 	Attributes(context.Context, *QueryAttributesRequest) (*QueryAttributesResponse, error)
 	// Scan queries attributes on a given account (address) for any that match the provided suffix
 	Scan(context.Context, *QueryScanRequest) (*QueryScanResponse, error)
+	// AccountsByAttribute queries the accounts that have an attribute with the given name
+	AccountsByAttribute(context.Context, *QueryAccountsByAttributeRequest) (*QueryAccountsByAttributeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -584,6 +700,9 @@ func (*UnimplementedQueryServer) Attributes(ctx context.Context, req *QueryAttri
 func (*UnimplementedQueryServer) Scan(ctx context.Context, req *QueryScanRequest) (*QueryScanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
 }
+func (*UnimplementedQueryServer) AccountsByAttribute(ctx context.Context, req *QueryAccountsByAttributeRequest) (*QueryAccountsByAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByAttribute not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -661,6 +780,24 @@ func _Query_Scan_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountsByAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsByAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AccountsByAttribute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByAttribute(ctx, req.(*QueryAccountsByAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.attribute.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -681,6 +818,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Scan",
 			Handler:    _Query_Scan_Handler,
 		},
+		{
+			MethodName: "AccountsByAttribute",
+			Handler:    _Query_AccountsByAttribute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/attribute/v1/query.proto",
@@ -1050,6 +1191,92 @@ func (m *QueryScanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAccountsByAttributeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsByAttributeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsByAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsByAttributeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsByAttributeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsByAttributeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1209,6 +1436,42 @@ func (m *QueryScanResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAccountsByAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsByAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2222,6 +2485,242 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAccountsByAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsByAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsByAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsByAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsByAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsByAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
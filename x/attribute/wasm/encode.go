@@ -21,6 +21,10 @@ type AttributeMsgParams struct {
 	Add *AddAttributeParams `json:"add_attribute"`
 	// A request to encode a MsgDeleteAttribute
 	Del *DeleteAttributeParams `json:"delete_attribute"`
+	// A request to encode a MsgUpdateAttribute
+	Update *UpdateAttributeParams `json:"update_attribute"`
+	// A request to encode a MsgDeleteDistinctAttribute
+	DelDistinct *DeleteDistinctAttributeParams `json:"delete_distinct_attribute"`
 }
 
 // AddAttributeParams are params for encoding a MsgAddAttribute
@@ -43,6 +47,32 @@ type DeleteAttributeParams struct {
 	Name string `json:"name"`
 }
 
+// UpdateAttributeParams are params for encoding a MsgUpdateAttribute
+type UpdateAttributeParams struct {
+	// The address of the account to update the attribute on.
+	Address string `json:"address"`
+	// The attribute name.
+	Name string `json:"name"`
+	// The original attribute value.
+	OriginalValue []byte `json:"original_value"`
+	// The original attribute value type.
+	OriginalValueType string `json:"original_value_type"`
+	// The update attribute value.
+	UpdateValue []byte `json:"update_value"`
+	// The update attribute value type.
+	UpdateValueType string `json:"update_value_type"`
+}
+
+// DeleteDistinctAttributeParams are params for encoding a MsgDeleteDistinctAttribute
+type DeleteDistinctAttributeParams struct {
+	// The address of the account to delete the attribute value from.
+	Address string `json:"address"`
+	// The attribute name.
+	Name string `json:"name"`
+	// The attribute value.
+	Value []byte `json:"value"`
+}
+
 // Encoder returns a smart contract message encoder for the attribute module.
 func Encoder(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sdk.Msg, error) {
 	wrapper := struct {
@@ -60,6 +90,10 @@ func Encoder(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sd
 		return params.Add.Encode(contract)
 	case params.Del != nil:
 		return params.Del.Encode(contract)
+	case params.Update != nil:
+		return params.Update.Encode(contract)
+	case params.DelDistinct != nil:
+		return params.DelDistinct.Encode(contract)
 	default:
 		return nil, fmt.Errorf("wasm: invalid attribute encoder params: %s", string(msg))
 	}
@@ -93,6 +127,36 @@ func (params *DeleteAttributeParams) Encode(contract sdk.AccAddress) ([]sdk.Msg,
 	return []sdk.Msg{msg}, nil
 }
 
+// Encode creates a MsgUpdateAttribute.
+// The contract must be the owner of the name of the attribute being updated.
+func (params *UpdateAttributeParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	address, err := sdk.AccAddressFromBech32(params.Address)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid address: %w", err)
+	}
+	msg := types.NewMsgUpdateAttributeRequest(
+		address,
+		contract,
+		params.Name,
+		params.OriginalValue,
+		params.UpdateValue,
+		encodeType(params.OriginalValueType),
+		encodeType(params.UpdateValueType),
+	)
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgDeleteDistinctAttribute.
+// The contract must be the owner of the name of the attribute being deleted.
+func (params *DeleteDistinctAttributeParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	address, err := sdk.AccAddressFromBech32(params.Address)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid address: %w", err)
+	}
+	msg := types.NewMsgDeleteDistinctAttributeRequest(address, contract, params.Name, params.Value)
+	return []sdk.Msg{msg}, nil
+}
+
 // Adapt the attribute type from a string passed in message encode params passed from a smart contract.
 func encodeType(valueType string) types.AttributeType {
 	switch valueType {
@@ -10,6 +10,7 @@ import (
 	"github.com/provenance-io/provenance/x/attribute/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // AttributeQueryParams represents the request type for the attribute module sent by a smart contracts.
@@ -19,6 +20,8 @@ type AttributeQueryParams struct {
 	Get *GetAttributesParams `json:"get_attributes,omitempty"`
 	// Get all account attributes.
 	GetAll *GetAllAttributesParams `json:"get_all_attributes,omitempty"`
+	// Get the accounts that have an attribute with a given name.
+	GetAccounts *GetAccountsByAttributeParams `json:"get_accounts_by_attribute,omitempty"`
 }
 
 // GetAttributesParams are params for querying an account attributes by address and name.
@@ -35,6 +38,16 @@ type GetAllAttributesParams struct {
 	Address string `json:"address"`
 }
 
+// GetAccountsByAttributeParams are params for querying the accounts that have an attribute with a given name.
+type GetAccountsByAttributeParams struct {
+	// The name of the attribute
+	Name string `json:"name"`
+	// The maximum number of accounts to return, the module default is used when zero
+	Limit uint64 `json:"limit,omitempty"`
+	// The next key returned by a previous query, the first page is returned when empty
+	NextKey []byte `json:"next_key,omitempty"`
+}
+
 // Querier returns a smart contract querier for the attribute module.
 func Querier(keeper keeper.Keeper) provwasm.Querier {
 	return func(ctx sdk.Context, query json.RawMessage, version string) ([]byte, error) {
@@ -53,6 +66,8 @@ func Querier(keeper keeper.Keeper) provwasm.Querier {
 			return params.Get.Run(ctx, keeper)
 		case params.GetAll != nil:
 			return params.GetAll.Run(ctx, keeper)
+		case params.GetAccounts != nil:
+			return params.GetAccounts.Run(ctx, keeper)
 		default:
 			return nil, fmt.Errorf("wasm: invalid account attribute query: %s", string(query))
 		}
@@ -85,6 +100,23 @@ func (params *GetAllAttributesParams) Run(ctx sdk.Context, keeper keeper.Keeper)
 	return createResponse(address, attrs)
 }
 
+// Run queries for a page of the accounts that have an attribute with a given name.
+func (params *GetAccountsByAttributeParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	req := &types.QueryAccountsByAttributeRequest{
+		Name:       params.Name,
+		Pagination: &query.PageRequest{Key: params.NextKey, Limit: params.Limit},
+	}
+	res, err := keeper.AccountsByAttribute(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: accounts by attribute query failed: %w", err)
+	}
+	bz, err := json.Marshal(AccountsResponse{Name: params.Name, Accounts: res.Accounts, NextKey: res.Pagination.NextKey})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal response failed: %w", err)
+	}
+	return bz, nil
+}
+
 // Create a JSON response from the results of a account attribute query.
 func createResponse(address sdk.AccAddress, attrs []types.Attribute) ([]byte, error) {
 	res := AttributeResponse{Address: address.String()}
@@ -18,3 +18,13 @@ type AttributeResponse struct {
 	// The attributes queried for the account.
 	Attributes []Attribute `json:"attributes,omitempty"`
 }
+
+// AccountsResponse returns the accounts that have an attribute with a given name.
+type AccountsResponse struct {
+	// The attribute name.
+	Name string `json:"name"`
+	// The Bech32 addresses of the accounts that have the attribute.
+	Accounts []string `json:"accounts,omitempty"`
+	// The key to use when querying for the next page of accounts, empty if there are no more accounts.
+	NextKey []byte `json:"next_key,omitempty"`
+}
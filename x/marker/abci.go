@@ -4,7 +4,6 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/provenance-io/provenance/x/marker/keeper"
-	"github.com/provenance-io/provenance/x/marker/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
@@ -12,35 +11,13 @@ import (
 
 // BeginBlocker returns the begin blocker for the marker module.
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper, bk bankkeeper.Keeper) {
-	// Iterate through all marker accounts and check for supply above or below expected targets.
-	var err error
-	k.IterateMarkers(ctx, func(record types.MarkerAccountI) bool {
-		// Supply checks are only done against active markers with a fixed supply.
-		if record.GetStatus() == types.StatusActive && record.HasFixedSupply() {
-			requiredSupply := record.GetSupply()
-			currentSupply := getCurrentSupply(ctx, record.GetDenom(), bk)
+	// Scheduled supply increases due at this block time are applied first so their supply changes are reconciled below.
+	k.ExecuteMintSchedules(ctx)
 
-			// If the current amount of marker coin in circulation doesn't match configured supply, make adjustments
-			if !requiredSupply.IsEqual(currentSupply) {
-				err = k.AdjustCirculation(ctx, record, requiredSupply)
-			}
-			// else supply is equal, nothing to do here.
-		}
-		return err != nil
-	})
-	// We have no way of dealing with this and the invariant will fail soon from mismatch halting the chain.
-	if err != nil {
+	// Only markers with a supply change recorded since the last block are checked for supply above or below
+	// expected targets.
+	if err := k.ReconcileSupply(ctx); err != nil {
+		// We have no way of dealing with this and the invariant will fail soon from mismatch halting the chain.
 		panic(err)
 	}
 }
-
-// Iterator over all coins and find the any matching our target marker denom, add their amounts to the returned total.
-func getCurrentSupply(ctx sdk.Context, denom string, bk bankkeeper.Keeper) sdk.Coin {
-	sup := bk.GetSupply(ctx)
-	for _, coin := range sup.GetTotal() {
-		if coin.Denom == denom {
-			return coin
-		}
-	}
-	return sdk.NewInt64Coin(denom, 0)
-}
@@ -0,0 +1,212 @@
+package marker_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker"
+	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// setupMarkers creates the requested number of active fixed supply markers with no pending supply changes.
+func setupMarkers(t require.TestingT, count int) (*simapp.App, sdk.Context, sdk.AccAddress) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	manager := types.MustGetMarkerAddress("manager")
+
+	for i := 0; i < count; i++ {
+		denom := fmt.Sprintf("coin%d", i)
+		mac := types.NewEmptyMarkerAccount(denom, manager.String(), []types.AccessGrant{*types.NewAccessGrant(manager,
+			[]types.Access{types.Access_Mint, types.Access_Burn, types.Access_Withdraw})})
+		require.NoError(t, mac.SetSupply(sdk.NewInt64Coin(denom, 1000)))
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+		require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, manager, denom))
+		require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, manager, denom))
+	}
+	marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+	return app, ctx, manager
+}
+
+func TestBeginBlockerReconcilesChangedMarkers(t *testing.T) {
+	app, ctx, _ := setupMarkers(t, 3)
+
+	// Nothing is pending once the begin blocker has run.
+	app.MarkerKeeper.IterateSupplyChangedMarkers(ctx, func(addr sdk.AccAddress) bool {
+		require.Fail(t, "no markers should have pending supply changes", addr.String())
+		return false
+	})
+
+	// Increase the required supply without minting, only a flagged marker is reconciled.
+	for _, denom := range []string{"coin1", "coin2"} {
+		m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+		require.NoError(t, err)
+		require.NoError(t, m.SetSupply(sdk.NewInt64Coin(denom, 1500)))
+		app.MarkerKeeper.SetMarker(ctx, m)
+	}
+	app.MarkerKeeper.MarkSupplyChanged(ctx, types.MustGetMarkerAddress("coin1"))
+
+	marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+	supply := app.BankKeeper.GetSupply(ctx).GetTotal()
+	require.Equal(t, sdk.NewInt(1500), supply.AmountOf("coin1"))
+	require.Equal(t, sdk.NewInt(1000), supply.AmountOf("coin2"))
+
+	// The invariant still checks every marker.
+	_, broken := keeper.AllInvariants(app.MarkerKeeper, app.BankKeeper)(ctx)
+	require.True(t, broken)
+
+	app.MarkerKeeper.MarkSupplyChanged(ctx, types.MustGetMarkerAddress("coin2"))
+	marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+	require.Equal(t, sdk.NewInt(1500), app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf("coin2"))
+	_, broken = keeper.AllInvariants(app.MarkerKeeper, app.BankKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestBeginBlockerReconcilesBankSupplyChanges(t *testing.T) {
+	app, ctx, _ := setupMarkers(t, 2)
+
+	// coin minted by another module through the bank keeper flags the marker for reconciliation.
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("coin1", 200))))
+	flagged := []sdk.AccAddress{}
+	app.MarkerKeeper.IterateSupplyChangedMarkers(ctx, func(addr sdk.AccAddress) bool {
+		flagged = append(flagged, addr)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{types.MustGetMarkerAddress("coin1")}, flagged)
+
+	marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+	require.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf("coin1"))
+	_, broken := keeper.AllInvariants(app.MarkerKeeper, app.BankKeeper)(ctx)
+	require.False(t, broken)
+}
+
+// fullScanBeginBlocker is the previous begin blocker design that checks the supply of every marker in every block.
+func fullScanBeginBlocker(ctx sdk.Context, k keeper.Keeper, bk types.BankKeeper) {
+	var err error
+	k.IterateMarkers(ctx, func(record types.MarkerAccountI) bool {
+		if record.GetStatus() == types.StatusActive && record.HasFixedSupply() {
+			requiredSupply := record.GetSupply()
+			currentSupply := sdk.NewInt64Coin(record.GetDenom(), 0)
+			for _, coin := range bk.GetSupply(ctx).GetTotal() {
+				if coin.Denom == record.GetDenom() {
+					currentSupply = coin
+					break
+				}
+			}
+			if !requiredSupply.IsEqual(currentSupply) {
+				err = k.AdjustCirculation(ctx, record, requiredSupply)
+			}
+		}
+		return err != nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func benchmarkBeginBlocker(b *testing.B, markers int, fullScan bool) {
+	app, ctx, manager := setupMarkers(b, markers)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// A single marker supply change per block.
+		require.NoError(b, app.MarkerKeeper.MintCoin(ctx, manager, sdk.NewInt64Coin("coin0", 1)))
+		if fullScan {
+			fullScanBeginBlocker(ctx, app.MarkerKeeper, app.BankKeeper)
+		} else {
+			marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+		}
+	}
+}
+
+func BenchmarkBeginBlockerFullScan10(b *testing.B)  { benchmarkBeginBlocker(b, 10, true) }
+func BenchmarkBeginBlockerFullScan100(b *testing.B) { benchmarkBeginBlocker(b, 100, true) }
+func BenchmarkBeginBlockerFullScan500(b *testing.B) { benchmarkBeginBlocker(b, 500, true) }
+
+func BenchmarkBeginBlockerSupplyChanged10(b *testing.B)  { benchmarkBeginBlocker(b, 10, false) }
+func BenchmarkBeginBlockerSupplyChanged100(b *testing.B) { benchmarkBeginBlocker(b, 100, false) }
+func BenchmarkBeginBlockerSupplyChanged500(b *testing.B) { benchmarkBeginBlocker(b, 500, false) }
+
+func TestBeginBlockerExecutesMintSchedules(t *testing.T) {
+	app, ctx, manager := setupMarkers(t, 2)
+	target := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	start := time.Unix(1_600_000_000, 0).UTC()
+	ctx = ctx.WithBlockTime(start)
+
+	require.NoError(t, app.MarkerKeeper.SetMintSchedule(ctx, manager, "coin0", []types.MintScheduleEntry{
+		types.NewMintScheduleEntry(start.Add(2*time.Hour), sdk.NewInt(300), ""),
+		types.NewMintScheduleEntry(start.Add(time.Hour), sdk.NewInt(200), target.String()),
+	}))
+	// an entry exceeding the max supply can not be executed and is dropped.
+	require.NoError(t, app.MarkerKeeper.SetMintSchedule(ctx, manager, "coin1", []types.MintScheduleEntry{
+		types.NewMintScheduleEntry(start.Add(time.Hour), app.MarkerKeeper.GetParams(ctx).MaxSupply, ""),
+	}))
+
+	// nothing is due yet.
+	marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+	require.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf("coin0"))
+
+	ctx = ctx.WithBlockTime(start.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+	supply := app.BankKeeper.GetSupply(ctx).GetTotal()
+	require.Equal(t, sdk.NewInt(1200), supply.AmountOf("coin0"))
+	require.Equal(t, sdk.NewInt(1000), supply.AmountOf("coin1"))
+	require.Equal(t, sdk.NewInt(200), app.BankKeeper.GetBalance(ctx, target, "coin0").Amount)
+
+	executed, failed := 0, 0
+	for _, e := range ctx.EventManager().Events() {
+		switch e.Type {
+		case types.EventTypeScheduledMint:
+			executed++
+		case types.EventTypeScheduledMintFailed:
+			failed++
+		}
+	}
+	require.Equal(t, 1, executed)
+	require.Equal(t, 1, failed)
+
+	schedule := app.MarkerKeeper.GetMintSchedule(ctx, types.MustGetMarkerAddress("coin0"))
+	require.NotNil(t, schedule)
+	require.Equal(t, []types.MintScheduleEntry{types.NewMintScheduleEntry(start.Add(2*time.Hour), sdk.NewInt(300), "")},
+		schedule.Entries)
+	require.Nil(t, app.MarkerKeeper.GetMintSchedule(ctx, types.MustGetMarkerAddress("coin1")))
+
+	// entries are dropped once the account that scheduled them no longer holds the required access.
+	require.NoError(t, app.MarkerKeeper.SetMintSchedule(ctx, manager, "coin1", []types.MintScheduleEntry{
+		types.NewMintScheduleEntry(start.Add(2*time.Hour), sdk.NewInt(100), target.String()),
+	}))
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "coin1")
+	require.NoError(t, err)
+	require.NoError(t, m.RevokeAccess(manager))
+	require.NoError(t, m.GrantAccess(types.NewAccessGrant(manager, []types.Access{types.Access_Mint})))
+	app.MarkerKeeper.SetMarker(ctx, m)
+
+	// once the last entry is executed the schedule is removed and the coin remains in the marker.
+	ctx = ctx.WithBlockTime(start.Add(3 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+	require.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf("coin1"))
+	require.Nil(t, app.MarkerKeeper.GetMintSchedule(ctx, types.MustGetMarkerAddress("coin1")))
+	failedEvent := sdk.NewEvent(types.EventTypeScheduledMintFailed,
+		sdk.NewAttribute(types.EventAttributeDenomKey, "coin1"),
+		sdk.NewAttribute(types.EventAttributeAmountKey, "100"),
+		sdk.NewAttribute(types.EventAttributeErrorKey, manager.String()+" does not have ACCESS_WITHDRAW on coin1 markeraccount"),
+		sdk.NewAttribute(types.EventAttributeModuleNameKey, types.ModuleName),
+	)
+	require.Contains(t, ctx.EventManager().Events(), failedEvent)
+	require.Equal(t, sdk.NewInt(1500), app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf("coin0"))
+	require.Equal(t, sdk.NewInt(1300),
+		app.BankKeeper.GetBalance(ctx, types.MustGetMarkerAddress("coin0"), "coin0").Amount)
+	require.Nil(t, app.MarkerKeeper.GetMintSchedule(ctx, types.MustGetMarkerAddress("coin0")))
+
+	_, broken := keeper.AllInvariants(app.MarkerKeeper, app.BankKeeper)(ctx)
+	require.False(t, broken)
+}
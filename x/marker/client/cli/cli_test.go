@@ -50,7 +50,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	// Configure Genesis data for marker module
 	var markerData markertypes.GenesisState
 	markerData.Params.EnableGovernance = true
-	markerData.Params.MaxTotalSupply = 1000000
+	markerData.Params.MaxSupply = sdk.NewInt(1000000)
 	markerData.Markers = []markertypes.MarkerAccount{
 		{
 			BaseAccount: &authtypes.BaseAccount{
@@ -126,7 +126,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"testcoin",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"100","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"100","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false,"required_attributes":[],"allow_forced_transfer":false}}`,
 		},
 		{
 			"get testcoin marker test",
@@ -138,6 +138,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			`marker:
   '@type': /provenance.marker.v1.MarkerAccount
   access_control: []
+  allow_forced_transfer: false
   allow_governance_control: false
   base_account:
     account_number: "100"
@@ -147,6 +148,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
   denom: testcoin
   manager: ""
   marker_type: MARKER_TYPE_COIN
+  required_attributes: []
   status: MARKER_STATUS_ACTIVE
   supply: "1000"
   supply_fixed: true`,
@@ -166,7 +168,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"lockedcoin",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"110","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"110","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false,"required_attributes":[],"allow_forced_transfer":false}}`,
 		},
 		{
 			"query access",
@@ -184,6 +186,15 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			},
 			"escrow: []",
 		},
+		{
+			"query holding",
+			markercli.AllHoldersCmd(),
+			[]string{
+				"testcoin",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"balances":[{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","coins":[{"denom":"testcoin","amount":"1000"}]}],"pagination":{"next_key":null,"total":"0"}}`,
+		},
 		{
 			"query supply",
 			markercli.MarkerSupplyCmd(),
@@ -192,6 +203,41 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			},
 			fmt.Sprintf("amount:\n  amount: \"%s\"\n  denom: %s", s.cfg.BondedTokens.Mul(sdk.NewInt(int64(s.cfg.NumValidators))), s.cfg.BondDenom),
 		},
+		{
+			"query frozen holders",
+			markercli.FrozenHoldersCmd(),
+			[]string{
+				"lockedcoin",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"addresses":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
+		{
+			"query frozen status",
+			markercli.FrozenStatusCmd(),
+			[]string{
+				"lockedcoin",
+				s.accountAddr.String(),
+			},
+			"frozen: false",
+		},
+		{
+			"query mint schedule",
+			markercli.MintScheduleCmd(),
+			[]string{
+				"lockedcoin",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"schedule":{"denom":"lockedcoin","entries":[],"administrator":""}}`,
+		},
+		{
+			"query all mint schedules",
+			markercli.AllMintSchedulesCmd(),
+			[]string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"schedules":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -304,6 +350,231 @@ func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 			},
 			false, &sdk.TxResponse{}, 0,
 		},
+		{
+			"add freeze access",
+			markercli.GetCmdAddAccess(),
+			[]string{
+				s.testnet.Validators[0].Address.String(),
+				"hotdog",
+				"freeze",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"freeze holder",
+			markercli.GetCmdFreezeHolder(),
+			[]string{
+				"hotdog",
+				s.accountAddr.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"unfreeze holder",
+			markercli.GetCmdUnfreezeHolder(),
+			[]string{
+				"hotdog",
+				s.accountAddr.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"unfreeze holder not frozen",
+			markercli.GetCmdUnfreezeHolder(),
+			[]string{
+				"hotdog",
+				s.accountAddr.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 4,
+		},
+		{
+			"force transfer not allowed on marker",
+			markercli.GetCmdForceTransfer(),
+			[]string{
+				s.accountAddr.String(),
+				s.testnet.Validators[0].Address.String(),
+				"10hotdog",
+				"lost key recovery",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 4,
+		},
+		{
+			"add withdraw access",
+			markercli.GetCmdAddAccess(),
+			[]string{
+				s.testnet.Validators[0].Address.String(),
+				"hotdog",
+				"withdraw",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"set mint schedule",
+			markercli.GetCmdSetMintSchedule(),
+			[]string{
+				"hotdog",
+				"2100-01-01T00:00:00Z,100",
+				fmt.Sprintf("2100-02-01T00:00:00Z,100,%s", s.accountAddr.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"set mint schedule invalid entry",
+			markercli.GetCmdSetMintSchedule(),
+			[]string{
+				"hotdog",
+				"2100-01-01T00:00:00Z",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"withdraw restricted coin",
+			markercli.GetCmdWithdrawCoins(),
+			[]string{
+				"hotdog",
+				"50hotdog",
+				s.testnet.Validators[0].Address.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"transfer restricted coin",
+			markercli.GetCmdTransfer(),
+			[]string{
+				s.testnet.Validators[0].Address.String(),
+				s.accountAddr.String(),
+				"10hotdog",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"transfer more than held",
+			markercli.GetCmdTransfer(),
+			[]string{
+				s.testnet.Validators[0].Address.String(),
+				s.accountAddr.String(),
+				"1000hotdog",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 5,
+		},
+		{
+			"transfer invalid from address",
+			markercli.GetCmdTransfer(),
+			[]string{
+				"notanaddress",
+				s.accountAddr.String(),
+				"10hotdog",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"create marker with supply fixed and governance control",
+			markercli.GetCmdAddMarker(),
+			[]string{
+				"1000govdog",
+				"--type=COIN",
+				"--supply-fixed",
+				"--allow-governance-control",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"set denom metadata",
+			markercli.GetCmdSetDenomMetadata(),
+			[]string{
+				"govdog",
+				"kgovdog",
+				"govdog coin",
+				"govdog:0,kgovdog:3:kdog|kilodog",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"set denom metadata display without unit",
+			markercli.GetCmdSetDenomMetadata(),
+			[]string{
+				"govdog",
+				"mgovdog",
+				"govdog coin",
+				"govdog:0",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"set denom metadata invalid unit",
+			markercli.GetCmdSetDenomMetadata(),
+			[]string{
+				"govdog",
+				"govdog",
+				"govdog coin",
+				"govdog",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
 		{
 			"remove access",
 			markercli.GetCmdDeleteAccess(),
@@ -29,6 +29,10 @@ func GetQueryCmd() *cobra.Command {
 		MarkerAccessCmd(),
 		MarkerEscrowCmd(),
 		MarkerSupplyCmd(),
+		FrozenHoldersCmd(),
+		FrozenStatusCmd(),
+		MintScheduleCmd(),
+		AllMintSchedulesCmd(),
 	)
 	return queryCmd
 }
@@ -111,9 +115,8 @@ func AllHoldersCmd() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().Uint32(flags.FlagPage, 1, "Query a specific page of paginated results")
-	cmd.Flags().Uint32(flags.FlagLimit, 200, "Query number of results per page returned")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "holding")
 	return cmd
 }
 
@@ -232,3 +235,131 @@ func MarkerSupplyCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// FrozenHoldersCmd is the CLI command for listing the accounts frozen for a restricted marker coin.
+func FrozenHoldersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen [address|denom]",
+		Short: "List all accounts frozen for the given marker",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			var response *types.QueryFrozenHoldersResponse
+			if response, err = queryClient.FrozenHolders(
+				context.Background(),
+				&types.QueryFrozenHoldersRequest{
+					Id:         id,
+					Pagination: pageReq,
+				},
+			); err != nil {
+				fmt.Printf("failed to query frozen holders for \"%s\": %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen")
+	return cmd
+}
+
+// FrozenStatusCmd is the CLI command for querying whether an account is frozen for a restricted marker coin.
+func FrozenStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-status [address|denom] [holder address]",
+		Short: "Get whether the holder is frozen for the given marker",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+
+			var response *types.QueryFrozenStatusResponse
+			if response, err = queryClient.FrozenStatus(
+				context.Background(),
+				&types.QueryFrozenStatusRequest{Id: id, Address: strings.TrimSpace(args[1])},
+			); err != nil {
+				fmt.Printf("failed to query frozen status of \"%s\" for \"%s\": %v\n", args[1], id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MintScheduleCmd is the CLI command for querying the pending scheduled supply increases of a marker.
+func MintScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-schedule [address|denom]",
+		Short: "Get the pending scheduled supply increases of the given marker",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.ToLower(strings.TrimSpace(args[0]))
+
+			var response *types.QueryMintScheduleResponse
+			if response, err = queryClient.MintSchedule(
+				context.Background(),
+				&types.QueryMintScheduleRequest{Id: id},
+			); err != nil {
+				fmt.Printf("failed to query mint schedule for \"%s\": %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// AllMintSchedulesCmd is the CLI command for listing the pending scheduled supply increases of all markers.
+func AllMintSchedulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-schedules",
+		Short: "List the pending scheduled supply increases of all markers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			var response *types.QueryAllMintSchedulesResponse
+			if response, err = queryClient.AllMintSchedules(
+				context.Background(),
+				&types.QueryAllMintSchedulesRequest{Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query mint schedules: %v\n", err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mint schedules")
+	return cmd
+}
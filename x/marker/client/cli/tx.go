@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/provenance-io/provenance/x/marker/types"
 
@@ -12,11 +14,18 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/spf13/cobra"
 )
 
-const flagType = "type"
+const (
+	flagType                = "type"
+	flagRequiredAttributes  = "required-attributes"
+	flagAllowForcedTransfer = "allow-forced-transfer"
+	flagSupplyFixed         = "supply-fixed"
+	flagAllowGovernance     = "allow-governance-control"
+)
 
 // NewTxCmd returns the top-level command for marker CLI transactions.
 func NewTxCmd() *cobra.Command {
@@ -38,6 +47,12 @@ func NewTxCmd() *cobra.Command {
 		GetCmdDeleteAccess(),
 		GetCmdWithdrawCoins(),
 		GetCmdAddMarker(),
+		GetCmdFreezeHolder(),
+		GetCmdUnfreezeHolder(),
+		GetCmdForceTransfer(),
+		GetCmdSetMintSchedule(),
+		GetCmdTransfer(),
+		GetCmdSetDenomMetadata(),
 	)
 	return txCmd
 }
@@ -54,6 +69,9 @@ with the given supply amount and denomination provided in the coin argument
 
 Example:
 $ %s tx marker new 1000hotdogcoin --type COIN --from mykey
+$ %[1]s tx marker new 1000hotdogcoin --type RESTRICTED --required-attributes kyc.provenance.io --from mykey
+$ %[1]s tx marker new 1000hotdogcoin --type RESTRICTED --allow-forced-transfer --from mykey
+$ %[1]s tx marker new 1000hotdogcoin --type COIN --supply-fixed --allow-governance-control --from mykey
 `, version.AppName)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -78,11 +96,31 @@ $ %s tx marker new 1000hotdogcoin --type COIN --from mykey
 				}
 			}
 			msg := types.NewAddMarkerRequest(coin.Denom, coin.Amount, callerAddr, callerAddr, typeValue)
+			msg.RequiredAttributes, err = cmd.Flags().GetStringSlice(flagRequiredAttributes)
+			if err != nil {
+				return fmt.Errorf("invalid required attributes: %w", err)
+			}
+			msg.AllowForcedTransfer, err = cmd.Flags().GetBool(flagAllowForcedTransfer)
+			if err != nil {
+				return fmt.Errorf("invalid allow forced transfer: %w", err)
+			}
+			msg.SupplyFixed, err = cmd.Flags().GetBool(flagSupplyFixed)
+			if err != nil {
+				return fmt.Errorf("invalid supply fixed: %w", err)
+			}
+			msg.AllowGovernanceControl, err = cmd.Flags().GetBool(flagAllowGovernance)
+			if err != nil {
+				return fmt.Errorf("invalid allow governance control: %w", err)
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	cmd.Flags().String(flagType, "COIN", "a marker type to assign (default is COIN)")
+	cmd.Flags().StringSlice(flagRequiredAttributes, []string{}, "comma separated account attributes recipients of a RESTRICTED marker must have")
+	cmd.Flags().Bool(flagAllowForcedTransfer, false, "allow accounts with force_transfer access to recover RESTRICTED marker coin from any holder")
+	cmd.Flags().Bool(flagSupplyFixed, false, "the marker supply is fixed and the bank supply is kept equal to it")
+	cmd.Flags().Bool(flagAllowGovernance, false, "allow governance proposals to control the marker")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -323,6 +361,108 @@ $ %s tx marker revoke pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom --from
 	return cmd
 }
 
+// GetCmdFreezeHolder implements the freeze holder of a restricted marker coin command.
+func GetCmdFreezeHolder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze [denom] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Freeze the balance of a restricted marker coin held by the address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze the balance of a restricted marker coin held by the address so it can not be sent.
+From Address must have the freeze access on the marker.
+
+Example:
+$ %s tx marker freeze coindenom pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			holderAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "freeze invalid holder address %s", args[1])
+			}
+			msg := types.NewFreezeHolderRequest(args[0], clientCtx.GetFromAddress(), holderAddr)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUnfreezeHolder implements the unfreeze holder of a restricted marker coin command.
+func GetCmdUnfreezeHolder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze [denom] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Unfreeze the balance of a restricted marker coin held by the address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreeze the balance of a restricted marker coin held by the address so it can be sent again.
+From Address must have the freeze access on the marker.
+
+Example:
+$ %s tx marker unfreeze coindenom pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			holderAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "unfreeze invalid holder address %s", args[1])
+			}
+			msg := types.NewUnfreezeHolderRequest(args[0], clientCtx.GetFromAddress(), holderAddr)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdForceTransfer implements the forced transfer of restricted marker coin command.
+func GetCmdForceTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-transfer [from] [to] [coin] [reason]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Recover restricted marker coin from a holder without the holder signature",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Recover restricted marker coin from the from address into the to address without the signature
+of the from address.  The marker must allow forced transfers and the From Address must have the force_transfer access
+on the marker.  The reason is recorded with the transfer for compliance review.
+
+Example:
+$ %s tx marker force-transfer pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 10coindenom "lost key recovery" --from mykey
+`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "invalid from address %s", args[0])
+			}
+			toAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "invalid to address %s", args[1])
+			}
+			coin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid coin %s", args[2])
+			}
+			msg := types.NewForceTransferRequest(clientCtx.GetFromAddress(), fromAddr, toAddr, coin, args[3])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdWithdrawCoins implements the withdraw coins from escrow command.
 func GetCmdWithdrawCoins() *cobra.Command {
 	cmd := &cobra.Command{
@@ -356,3 +496,162 @@ func GetCmdWithdrawCoins() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdSetMintSchedule implements the command to replace the scheduled supply increases of a marker.
+func GetCmdSetMintSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-schedule [denom] [time,amount[,to address]]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Replace the scheduled supply increases of the marker",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the scheduled supply increases of the marker.  Each entry is an RFC 3339 block time, the
+amount of coin to mint once that block time is reached, and an optional address the new coin is withdrawn to.  When no
+address is given the coin remains in the marker.  Providing no entries removes the schedule.  Must be called by a user
+with the mint access on the marker.
+
+Example:
+$ %s tx marker set-mint-schedule coindenom 2021-06-01T00:00:00Z,1000 2021-07-01T00:00:00Z,1000,pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --from mykey
+`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			entries := make([]types.MintScheduleEntry, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				entry, err := parseMintScheduleEntry(arg)
+				if err != nil {
+					return err
+				}
+				entries = append(entries, entry)
+			}
+			msg := types.NewSetMintScheduleRequest(args[0], clientCtx.GetFromAddress(), entries)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseMintScheduleEntry parses a scheduled supply increase of the form time,amount[,to address]
+func parseMintScheduleEntry(arg string) (types.MintScheduleEntry, error) {
+	parts := strings.Split(arg, ",")
+	if len(parts) < 2 || len(parts) > 3 {
+		return types.MintScheduleEntry{}, fmt.Errorf("invalid mint schedule entry %s, expected time,amount[,to address]", arg)
+	}
+	at, err := time.Parse(time.RFC3339, strings.TrimSpace(parts[0]))
+	if err != nil {
+		return types.MintScheduleEntry{}, sdkErrors.Wrapf(err, "invalid mint schedule entry time %s", parts[0])
+	}
+	amount, ok := sdk.NewIntFromString(strings.TrimSpace(parts[1]))
+	if !ok {
+		return types.MintScheduleEntry{}, fmt.Errorf("invalid mint schedule entry amount %s", parts[1])
+	}
+	toAddress := ""
+	if len(parts) == 3 {
+		toAddress = strings.TrimSpace(parts[2])
+	}
+	entry := types.NewMintScheduleEntry(at.UTC(), amount, toAddress)
+	return entry, entry.Validate()
+}
+
+// GetCmdTransfer implements the transfer of restricted marker coin command.
+func GetCmdTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [from] [to] [coin]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Transfer restricted marker coin from one account to another",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer restricted marker coin held by the from address to the to address.  Must be called by
+a user with the transfer access on the marker.
+
+Example:
+$ %s tx marker transfer pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 10coindenom --from mykey
+`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "invalid from address %s", args[0])
+			}
+			toAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "invalid to address %s", args[1])
+			}
+			coin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid coin %s", args[2])
+			}
+			msg := types.NewTransferRequest(clientCtx.GetFromAddress(), fromAddr, toAddr, coin)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetDenomMetadata implements the command to record the bank denom metadata of a marker.
+func GetCmdSetDenomMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [base] [display] [description] [denom-units]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Set the bank denom metadata of a proposed marker",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the bank denom metadata of the marker with the base denom.  The denom units are a comma
+separated list of denom:exponent with an optional third part listing aliases separated by a pipe.  The units must
+include the base and display denoms.  Must be called by the manager or a user with the admin access on the marker
+while it is in the Proposed status.
+
+Example:
+$ %s tx marker set-denom-metadata nhotdog hotdog "hotdog coin" "nhotdog:0,hotdog:9:dog|frank" --from mykey
+`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			units, err := parseDenomUnits(args[3])
+			if err != nil {
+				return err
+			}
+			metadata := banktypes.Metadata{
+				Base:        args[0],
+				Display:     args[1],
+				Description: args[2],
+				DenomUnits:  units,
+			}
+			msg := types.NewSetDenomMetadataRequest(metadata, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseDenomUnits parses a comma separated list of denom units of the form denom:exponent[:alias|alias]
+func parseDenomUnits(arg string) ([]*banktypes.DenomUnit, error) {
+	units := []*banktypes.DenomUnit{}
+	for _, unit := range strings.Split(arg, ",") {
+		parts := strings.Split(strings.TrimSpace(unit), ":")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, fmt.Errorf("invalid denom unit %s, expected denom:exponent[:alias|alias]", unit)
+		}
+		exponent, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil {
+			return nil, sdkErrors.Wrapf(err, "invalid denom unit exponent %s", parts[1])
+		}
+		du := &banktypes.DenomUnit{Denom: parts[0], Exponent: uint32(exponent)}
+		if len(parts) == 3 && len(parts[2]) > 0 {
+			du.Aliases = strings.Split(parts[2], "|")
+		}
+		units = append(units, du)
+	}
+	return units, nil
+}
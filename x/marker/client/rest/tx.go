@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	// "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 
@@ -44,6 +45,30 @@ func RegisterTxRoutes(clientCtx client.Context, r *mux.Router) {
 		"/marker/{denom}/revoke",
 		revokeAccessHandlerFn(clientCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/marker/{denom}/transfer",
+		transferHandlerFn(clientCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/marker/{denom}/forcetransfer",
+		forceTransferHandlerFn(clientCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/marker/{denom}/metadata",
+		setDenomMetadataHandlerFn(clientCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/marker/{denom}/freeze",
+		freezeHolderHandlerFn(clientCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/marker/{denom}/unfreeze",
+		unfreezeHolderHandlerFn(clientCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/marker/{denom}/mintschedule",
+		setMintScheduleHandlerFn(clientCtx),
+	).Methods("POST")
 }
 
 type (
@@ -53,6 +78,11 @@ type (
 		Supply     sdk.Int        `json:"supply" yaml:"supply"`
 		Manager    sdk.AccAddress `json:"manager" yaml:"manager"`
 		MarkerType string         `json:"marker_type" yaml:"marker_type"`
+
+		SupplyFixed            bool     `json:"supply_fixed" yaml:"supply_fixed"`
+		AllowGovernanceControl bool     `json:"allow_governance_control" yaml:"allow_governance_control"`
+		RequiredAttributes     []string `json:"required_attributes" yaml:"required_attributes"`
+		AllowForcedTransfer    bool     `json:"allow_forced_transfer" yaml:"allow_forced_transfer"`
 	}
 
 	// MarkerAccessRequest is used for grant/revoke permissions for a given address on a marker
@@ -74,6 +104,36 @@ type (
 		BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
 		NewStatus string       `json:"new_status" yaml:"new_status"`
 	}
+
+	// TransferRequest defines the properties of a request to move restricted marker coin between accounts
+	TransferRequest struct {
+		BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		From    sdk.AccAddress `json:"from" yaml:"from"`
+		To      sdk.AccAddress `json:"to" yaml:"to"`
+		Amount  sdk.Coin       `json:"amount" yaml:"amount"`
+		// Reason is required for a forced transfer and ignored otherwise
+		Reason string `json:"reason" yaml:"reason"`
+	}
+
+	// DenomMetadataRequest defines the bank denom metadata to record for a marker
+	DenomMetadataRequest struct {
+		BaseReq     rest.BaseReq           `json:"base_req" yaml:"base_req"`
+		Description string                 `json:"description" yaml:"description"`
+		DenomUnits  []*banktypes.DenomUnit `json:"denom_units" yaml:"denom_units"`
+		Display     string                 `json:"display" yaml:"display"`
+	}
+
+	// HolderRequest is used to freeze or unfreeze an account holding a restricted marker coin
+	HolderRequest struct {
+		BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Holder  sdk.AccAddress `json:"holder" yaml:"holder"`
+	}
+
+	// MintScheduleRequest replaces the scheduled supply increases of a marker
+	MintScheduleRequest struct {
+		BaseReq rest.BaseReq              `json:"base_req" yaml:"base_req"`
+		Entries []types.MintScheduleEntry `json:"entries" yaml:"entries"`
+	}
 )
 
 func mintSupplyHandlerFn(clientCtx client.Context) http.HandlerFunc {
@@ -227,6 +287,10 @@ func createMarkerHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		}
 
 		msg := types.NewAddMarkerRequest(denom, req.Supply, fromAddr, req.Manager, typeValue)
+		msg.SupplyFixed = req.SupplyFixed
+		msg.AllowGovernanceControl = req.AllowGovernanceControl
+		msg.RequiredAttributes = req.RequiredAttributes
+		msg.AllowForcedTransfer = req.AllowForcedTransfer
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
@@ -319,3 +383,193 @@ func revokeAccessHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+func transferHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TransferRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		denom := mux.Vars(r)["denom"]
+		err := sdk.ValidateDenom(denom)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if denom != req.Amount.Denom {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "denom to transfer must match marker denom")
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewTransferRequest(fromAddr, req.From, req.To, req.Amount)
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func forceTransferHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TransferRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		denom := mux.Vars(r)["denom"]
+		err := sdk.ValidateDenom(denom)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if denom != req.Amount.Denom {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "denom to transfer must match marker denom")
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewForceTransferRequest(fromAddr, req.From, req.To, req.Amount, req.Reason)
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func setDenomMetadataHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DenomMetadataRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		denom := mux.Vars(r)["denom"]
+		err := sdk.ValidateDenom(denom)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		metadata := banktypes.Metadata{
+			Description: req.Description,
+			DenomUnits:  req.DenomUnits,
+			Base:        denom,
+			Display:     req.Display,
+		}
+		msg := types.NewSetDenomMetadataRequest(metadata, fromAddr)
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func freezeHolderHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req HolderRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		denom := mux.Vars(r)["denom"]
+		err := sdk.ValidateDenom(denom)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewFreezeHolderRequest(denom, fromAddr, req.Holder)
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func unfreezeHolderHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req HolderRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		denom := mux.Vars(r)["denom"]
+		err := sdk.ValidateDenom(denom)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewUnfreezeHolderRequest(denom, fromAddr, req.Holder)
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func setMintScheduleHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MintScheduleRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		denom := mux.Vars(r)["denom"]
+		err := sdk.ValidateDenom(denom)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewSetMintScheduleRequest(denom, fromAddr, req.Entries)
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -51,6 +51,22 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgTransferRequest:
 			res, err := msgServer.Transfer(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgForceTransferRequest:
+			res, err := msgServer.ForceTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetDenomMetadataRequest:
+			res, err := msgServer.SetDenomMetadata(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFreezeHolderRequest:
+			res, err := msgServer.FreezeHolder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUnfreezeHolderRequest:
+			res, err := msgServer.UnfreezeHolder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetMintScheduleRequest:
+			res, err := msgServer.SetMintSchedule(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown message type: %v", msg.Type())
@@ -75,6 +91,8 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return keeper.HandleChangeStatusProposal(ctx, k, c)
 		case *types.WithdrawEscrowProposal:
 			return keeper.HandleWithdrawEscrowProposal(ctx, k, c)
+		case *types.SetMintScheduleProposal:
+			return keeper.HandleSetMintScheduleProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized marker proposal content type: %T", c)
 		}
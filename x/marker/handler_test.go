@@ -11,10 +11,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	simapp "github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/x/marker"
 	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
 )
 
 func TestInvalidMsg(t *testing.T) {
@@ -35,3 +38,28 @@ func TestInvalidProposal(t *testing.T) {
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "unrecognized marker proposal content type: *types.TextProposal"))
 }
+
+func TestSetDenomMetadataMsg(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	h := marker.NewHandler(app.MarkerKeeper)
+
+	manager := sdk.AccAddress("manager_____________")
+	mac := types.NewEmptyMarkerAccount("testcoin", manager.String(), nil)
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin("testcoin", 1000)))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+
+	metadata := banktypes.Metadata{
+		Description: "a test coin",
+		Base:        "testcoin",
+		Display:     "ktestcoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "testcoin", Exponent: 0},
+			{Denom: "ktestcoin", Exponent: 3},
+		},
+	}
+	res, err := h(ctx, types.NewSetDenomMetadataRequest(metadata, manager))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, metadata, app.BankKeeper.GetDenomMetaData(ctx, "testcoin"))
+}
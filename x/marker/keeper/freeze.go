@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// frozenValue is stored for each account in the frozen set of a denom.
+var frozenValue = []byte{0x01}
+
+// FreezeHolder prevents the holder from moving any of its balance of the restricted coin of the marker.  The caller
+// must hold the freeze access right on the marker.
+func (k Keeper) FreezeHolder(ctx sdk.Context, caller sdk.AccAddress, denom string, holder sdk.AccAddress) error {
+	if err := k.validateFreezeAuthority(ctx, caller, denom); err != nil {
+		return err
+	}
+	if k.bankKeeper.BlockedAddr(holder) {
+		return fmt.Errorf("%s is a blocked address and can not be frozen", holder)
+	}
+	k.SetFrozen(ctx, denom, holder, true)
+	return nil
+}
+
+// UnfreezeHolder restores the ability of the holder to move its balance of the restricted coin of the marker.  The
+// caller must hold the freeze access right on the marker.
+func (k Keeper) UnfreezeHolder(ctx sdk.Context, caller sdk.AccAddress, denom string, holder sdk.AccAddress) error {
+	if err := k.validateFreezeAuthority(ctx, caller, denom); err != nil {
+		return err
+	}
+	if !k.IsFrozen(ctx, denom, holder) {
+		return fmt.Errorf("%s is not frozen for %s", holder, denom)
+	}
+	k.SetFrozen(ctx, denom, holder, false)
+	return nil
+}
+
+// validateFreezeAuthority ensures the marker exists, is a restricted coin, and the caller holds the freeze access right.
+func (k Keeper) validateFreezeAuthority(ctx sdk.Context, caller sdk.AccAddress, denom string) error {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return fmt.Errorf("marker type is not restricted_coin, freezing holders not supported")
+	}
+	if !m.AddressHasAccess(caller, types.Access_Freeze) {
+		return fmt.Errorf("%s is not allowed to freeze holders of %s", caller, denom)
+	}
+	return nil
+}
+
+// SetFrozen adds or removes the holder from the frozen set of the denom.
+func (k Keeper) SetFrozen(ctx sdk.Context, denom string, holder sdk.AccAddress, frozen bool) {
+	store := ctx.KVStore(k.storeKey)
+	if frozen {
+		store.Set(types.FrozenKey(denom, holder), frozenValue)
+	} else {
+		store.Delete(types.FrozenKey(denom, holder))
+	}
+}
+
+// IsFrozen returns true if the holder may not move its balance of the denom.
+func (k Keeper) IsFrozen(ctx sdk.Context, denom string, holder sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.FrozenKey(denom, holder))
+}
+
+// GetFrozenHolders returns a page of the accounts frozen for the given denom.
+func (k Keeper) GetFrozenHolders(ctx sdk.Context, denom string, pageRequest *query.PageRequest) ([]string, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FrozenDenomPrefix(denom))
+	results := []string{}
+	pageRes, err := query.Paginate(store, pageRequest, func(key []byte, _ []byte) error {
+		results = append(results, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return results, pageRes, nil
+}
+
+// IterateFrozenHolders processes the denom and address of every frozen holder of every marker.
+func (k Keeper) IterateFrozenHolders(ctx sdk.Context, cb func(denom string, addr sdk.AccAddress) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FrozenKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// key is the prefix, a length byte, the denom and then the address.
+		key := iterator.Key()[len(types.FrozenKeyPrefix):]
+		denomLen := int(key[0])
+		if cb(string(key[1:1+denomLen]), key[1+denomLen:]) {
+			break
+		}
+	}
+}
+
+// ValidateNotFrozen ensures the sender of a bank send is not frozen for any of the coins being sent.
+func (k Keeper) ValidateNotFrozen(ctx sdk.Context, from sdk.AccAddress, amount sdk.Coins) error {
+	for _, coin := range amount {
+		if k.IsFrozen(ctx, coin.Denom, from) {
+			return fmt.Errorf("%s is frozen and can not send %s", from, coin.Denom)
+		}
+	}
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 // InitGenesis creates the initial genesis state for the marker module.  Typically these
 // accounts would be listed with the rest of the accounts and not created here.
 func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	// genesis files exported before the max supply param existed only have the max total supply.
+	data.Params.MigrateMaxSupply()
 	k.SetParams(ctx, data.Params)
 	if err := data.Validate(); err != nil {
 		panic(err)
@@ -22,6 +24,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		if m, ok := acc[i].(types.MarkerAccountI); ok {
 			if err := m.Validate(); err == nil {
 				store.Set(types.MarkerStoreKey(m.GetAddress()), m.GetAddress())
+				k.MarkSupplyChanged(ctx, m.GetAddress())
 			}
 		}
 	}
@@ -29,8 +32,20 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	if data.Markers != nil {
 		for i := range data.Markers {
 			k.SetMarker(ctx, &data.Markers[i])
+			k.MarkSupplyChanged(ctx, data.Markers[i].GetAddress())
 		}
 	}
+	for _, f := range data.FrozenHolders {
+		addr, err := sdk.AccAddressFromBech32(f.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.SetFrozen(ctx, f.Denom, addr, true)
+	}
+	for _, ms := range data.MintSchedules {
+		types.SortMintScheduleEntries(ms.Entries)
+		k.storeMintSchedule(ctx, types.MustGetMarkerAddress(ms.Denom), ms)
+	}
 }
 
 // ExportGenesis exports the current keeper state of the marker module.ExportGenesis
@@ -56,5 +71,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 	}
 
 	k.IterateMarkers(ctx, appendToMarkers)
-	return types.NewGenesisState(params, markers)
+
+	frozen := make([]types.FrozenHolder, 0)
+	k.IterateFrozenHolders(ctx, func(denom string, addr sdk.AccAddress) bool {
+		frozen = append(frozen, types.FrozenHolder{Denom: denom, Address: addr.String()})
+		return false
+	})
+
+	schedules := make([]types.MintSchedule, 0)
+	k.IterateMintSchedules(ctx, func(schedule types.MintSchedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+
+	data = types.NewGenesisState(params, markers)
+	data.FrozenHolders = frozen
+	data.MintSchedules = schedules
+	return data
 }
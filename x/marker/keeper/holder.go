@@ -0,0 +1,387 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// holderIndexValue is stored for each entry in the holder index, the balance itself is always read from the bank.
+var holderIndexValue = []byte{0x01}
+
+// GetMarkerHolders returns a page of the accounts holding the given denom (and the amount) from the holder index.
+func (k Keeper) GetMarkerHolders(ctx sdk.Context, denom string, pageRequest *query.PageRequest) ([]types.Balance, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HolderDenomPrefix(denom))
+	results := []types.Balance{}
+	pageRes, err := query.Paginate(store, pageRequest, func(key []byte, _ []byte) error {
+		addr := sdk.AccAddress(key)
+		results = append(results, types.Balance{
+			Address: addr.String(),
+			Coins:   sdk.NewCoins(k.bankKeeper.GetBalance(ctx, addr, denom)),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return results, pageRes, nil
+}
+
+// IterateMarkerHolders processes the address of each account holding the given denom.
+func (k Keeper) IterateMarkerHolders(ctx sdk.Context, denom string, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HolderDenomPrefix(denom))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(iterator.Key()) {
+			break
+		}
+	}
+}
+
+// BuildHolderIndex clears and rebuilds the holder index from every balance of a marker denom held in the bank module.
+// This is a full scan of the bank balances intended for initializing the index on an existing chain.
+func (k Keeper) BuildHolderIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.HolderKeyPrefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	markerDenoms := make(map[string]bool)
+	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) (stop bool) {
+		isMarker, found := markerDenoms[coin.Denom]
+		if !found {
+			isMarker = isMarkerDenom(store, coin.Denom)
+			markerDenoms[coin.Denom] = isMarker
+		}
+		if isMarker {
+			setHolder(store, addr, coin)
+		}
+		return false
+	})
+}
+
+// isMarkerDenom returns true if there is a marker for the denom.  Only the holders of marker denoms are indexed.
+func isMarkerDenom(store sdk.KVStore, denom string) bool {
+	addr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return false
+	}
+	return store.Has(types.MarkerStoreKey(addr))
+}
+
+// setHolder adds or removes the account from the holder index of the coin denom based on the balance amount.
+func setHolder(store sdk.KVStore, addr sdk.AccAddress, balance sdk.Coin) {
+	key := types.HolderKey(balance.Denom, addr)
+	if balance.Amount.IsPositive() {
+		store.Set(key, holderIndexValue)
+	} else {
+		store.Delete(key)
+	}
+}
+
+// HolderIndexBankKeeper wraps the bank keeper to keep the marker holder index current with every balance change and
+// to enforce the marker send restrictions and frozen holders on transfers between accounts.  Modules must be given this keeper in place of
+// the bank keeper for their balance changes to be indexed and their transfers to be checked.
+type HolderIndexBankKeeper struct {
+	bankkeeper.Keeper
+
+	// Key to access the marker key-value store holding the index.
+	storeKey sdk.StoreKey
+
+	// The marker keeper is created with this bank keeper so it is held by reference.
+	markerKeeper *Keeper
+}
+
+var _ bankkeeper.Keeper = HolderIndexBankKeeper{}
+
+// NewHolderIndexBankKeeper returns a bank keeper that maintains the marker holder index in the marker store and checks
+// the send restrictions of the marker keeper.
+func NewHolderIndexBankKeeper(bankKeeper bankkeeper.Keeper, key sdk.StoreKey, markerKeeper *Keeper) HolderIndexBankKeeper {
+	return HolderIndexBankKeeper{Keeper: bankKeeper, storeKey: key, markerKeeper: markerKeeper}
+}
+
+// sendRestrictionsBypassKey marks a context used for transfers made by the marker keeper which performs its own checks.
+type sendRestrictionsBypassKey struct{}
+
+// withoutSendRestrictions returns a context in which the bank keeper does not enforce the marker send restrictions.
+func withoutSendRestrictions(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(sendRestrictionsBypassKey{}, true)
+}
+
+// hasSendRestrictions returns true if the marker send restrictions apply to transfers made with the context.
+func (k HolderIndexBankKeeper) hasSendRestrictions(ctx sdk.Context) bool {
+	return k.markerKeeper != nil && ctx.Value(sendRestrictionsBypassKey{}) == nil
+}
+
+// validateSender ensures the sender is not frozen for any of the coins.
+func (k HolderIndexBankKeeper) validateSender(ctx sdk.Context, from sdk.AccAddress, amt sdk.Coins) error {
+	if !k.hasSendRestrictions(ctx) {
+		return nil
+	}
+	if err := k.markerKeeper.ValidateNotFrozen(ctx, from, amt); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+	return nil
+}
+
+// validateSend checks the marker send restrictions for a transfer of the coins to the recipient.
+func (k HolderIndexBankKeeper) validateSend(ctx sdk.Context, to sdk.AccAddress, amt sdk.Coins) error {
+	if !k.hasSendRestrictions(ctx) {
+		return nil
+	}
+	if err := k.markerKeeper.ValidateSendRestrictions(ctx, to, amt); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+	return nil
+}
+
+// indexHolders updates the holder index entries of each address for the marker denoms of the given coins.
+func (k HolderIndexBankKeeper) indexHolders(ctx sdk.Context, amt sdk.Coins, addrs ...sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range amt {
+		if !isMarkerDenom(store, coin.Denom) {
+			continue
+		}
+		for _, addr := range addrs {
+			setHolder(store, addr, k.Keeper.GetBalance(ctx, addr, coin.Denom))
+		}
+	}
+}
+
+// flagSupplyChanged flags the markers of the coins for supply reconciliation so supply changes made by any module are
+// checked at the start of the next block.
+func (k HolderIndexBankKeeper) flagSupplyChanged(ctx sdk.Context, amt sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range amt {
+		if isMarkerDenom(store, coin.Denom) {
+			addr := types.MustGetMarkerAddress(coin.Denom)
+			store.Set(types.SupplyChangedKey(addr), addr)
+		}
+	}
+}
+
+// InitGenesis indexes the genesis balances after they have been set by the bank keeper.
+func (k HolderIndexBankKeeper) InitGenesis(ctx sdk.Context, genState *banktypes.GenesisState) {
+	k.Keeper.InitGenesis(ctx, genState)
+	for _, balance := range genState.Balances {
+		addr, err := sdk.AccAddressFromBech32(balance.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.indexHolders(ctx, balance.Coins, addr)
+	}
+}
+
+// InputOutputCoins checks the send restrictions of each input and output, performs the multi-send and indexes each
+// input and output account.
+func (k HolderIndexBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	inputAddrs := make([]sdk.AccAddress, len(inputs))
+	for i, in := range inputs {
+		addr, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+		if err = k.validateSender(ctx, addr, in.Coins); err != nil {
+			return err
+		}
+		inputAddrs[i] = addr
+	}
+	outputAddrs := make([]sdk.AccAddress, len(outputs))
+	for i, out := range outputs {
+		addr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		if err = k.validateSend(ctx, addr, out.Coins); err != nil {
+			return err
+		}
+		outputAddrs[i] = addr
+	}
+	if err := k.Keeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return err
+	}
+	for i, in := range inputs {
+		k.indexHolders(ctx, in.Coins, inputAddrs[i])
+	}
+	for i, out := range outputs {
+		k.indexHolders(ctx, out.Coins, outputAddrs[i])
+	}
+	return nil
+}
+
+// SendCoins checks the send restrictions, performs the send and indexes both accounts.
+func (k HolderIndexBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.validateSender(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+	if err := k.validateSend(ctx, toAddr, amt); err != nil {
+		return err
+	}
+	if err := k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	k.indexHolders(ctx, amt, fromAddr, toAddr)
+	return nil
+}
+
+// SubtractCoins subtracts the coins and indexes the account.
+func (k HolderIndexBankKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.Keeper.SubtractCoins(ctx, addr, amt); err != nil {
+		return err
+	}
+	k.indexHolders(ctx, amt, addr)
+	return nil
+}
+
+// AddCoins adds the coins and indexes the account.
+func (k HolderIndexBankKeeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.Keeper.AddCoins(ctx, addr, amt); err != nil {
+		return err
+	}
+	k.indexHolders(ctx, amt, addr)
+	return nil
+}
+
+// SetBalance sets the balance and indexes the account.
+func (k HolderIndexBankKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance sdk.Coin) error {
+	if err := k.Keeper.SetBalance(ctx, addr, balance); err != nil {
+		return err
+	}
+	k.indexHolders(ctx, sdk.Coins{balance}, addr)
+	return nil
+}
+
+// SetBalances replaces all balances of the account and indexes both the previous and the new denoms.
+func (k HolderIndexBankKeeper) SetBalances(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error {
+	previous := k.Keeper.GetAllBalances(ctx, addr)
+	if err := k.Keeper.SetBalances(ctx, addr, balances); err != nil {
+		return err
+	}
+	k.indexHolders(ctx, previous, addr)
+	k.indexHolders(ctx, balances, addr)
+	return nil
+}
+
+// SendCoinsFromModuleToAccount performs the send and indexes both accounts.
+func (k HolderIndexBankKeeper) SendCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	if err := k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt); err != nil {
+		return err
+	}
+	k.indexHolders(ctx, amt, authtypes.NewModuleAddress(senderModule), recipientAddr)
+	return nil
+}
+
+// SendCoinsFromModuleToModule performs the send and indexes both module accounts.
+func (k HolderIndexBankKeeper) SendCoinsFromModuleToModule(
+	ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins,
+) error {
+	if err := k.Keeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt); err != nil {
+		return err
+	}
+	k.indexHolders(ctx, amt, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule))
+	return nil
+}
+
+// SendCoinsFromAccountToModule checks the sender is not frozen, performs the send and indexes both accounts.
+func (k HolderIndexBankKeeper) SendCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	if err := k.validateSender(ctx, senderAddr, amt); err != nil {
+		return err
+	}
+	if err := k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt); err != nil {
+		return err
+	}
+	k.indexHolders(ctx, amt, senderAddr, authtypes.NewModuleAddress(recipientModule))
+	return nil
+}
+
+// DelegateCoinsFromAccountToModule checks the sender is not frozen, performs the delegation and indexes both accounts.
+func (k HolderIndexBankKeeper) DelegateCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	if err := k.validateSender(ctx, senderAddr, amt); err != nil {
+		return err
+	}
+	if err := k.Keeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt); err != nil {
+		return err
+	}
+	k.indexHolders(ctx, amt, senderAddr, authtypes.NewModuleAddress(recipientModule))
+	return nil
+}
+
+// UndelegateCoinsFromModuleToAccount performs the undelegation and indexes both accounts.
+func (k HolderIndexBankKeeper) UndelegateCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	if err := k.Keeper.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt); err != nil {
+		return err
+	}
+	k.indexHolders(ctx, amt, authtypes.NewModuleAddress(senderModule), recipientAddr)
+	return nil
+}
+
+// DelegateCoins checks the delegator is not frozen, performs the delegation and indexes both accounts.
+func (k HolderIndexBankKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.validateSender(ctx, delegatorAddr, amt); err != nil {
+		return err
+	}
+	if err := k.Keeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt); err != nil {
+		return err
+	}
+	k.indexHolders(ctx, amt, delegatorAddr, moduleAccAddr)
+	return nil
+}
+
+// UndelegateCoins performs the undelegation and indexes both accounts.
+func (k HolderIndexBankKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.Keeper.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt); err != nil {
+		return err
+	}
+	k.indexHolders(ctx, amt, moduleAccAddr, delegatorAddr)
+	return nil
+}
+
+// MintCoins mints the coins, indexes the minting module account and flags the markers of the coins for supply
+// reconciliation.
+func (k HolderIndexBankKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	if err := k.Keeper.MintCoins(ctx, moduleName, amt); err != nil {
+		return err
+	}
+	k.indexHolders(ctx, amt, authtypes.NewModuleAddress(moduleName))
+	k.flagSupplyChanged(ctx, amt)
+	return nil
+}
+
+// BurnCoins burns the coins, indexes the burning module account and flags the markers of the coins for supply
+// reconciliation.
+func (k HolderIndexBankKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	if err := k.Keeper.BurnCoins(ctx, moduleName, amt); err != nil {
+		return err
+	}
+	k.indexHolders(ctx, amt, authtypes.NewModuleAddress(moduleName))
+	k.flagSupplyChanged(ctx, amt)
+	return nil
+}
+
+// SetSupply sets the total supply and flags the markers of every denom in it for supply reconciliation.
+func (k HolderIndexBankKeeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
+	k.Keeper.SetSupply(ctx, supply)
+	k.flagSupplyChanged(ctx, supply.GetTotal())
+}
@@ -29,11 +29,12 @@ func supplyInvariant(mk Keeper, bk bankkeeper.Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		statusMessage := ""
 		isBroken := false
+		total := bk.GetSupply(ctx).GetTotal()
 		mk.IterateMarkers(ctx, func(record types.MarkerAccountI) bool {
 			// Invariant checks are only done against active markers.
 			if record.GetStatus() == types.StatusActive && record.HasFixedSupply() {
 				requiredSupply := record.GetSupply()
-				currentSupply := getCurrentSupply(ctx, requiredSupply.Denom, bk)
+				currentSupply := sdk.NewCoin(requiredSupply.Denom, total.AmountOf(requiredSupply.Denom))
 
 				// Just log the supply status
 				if !requiredSupply.IsEqual(currentSupply) {
@@ -58,14 +59,3 @@ func supplyInvariant(mk Keeper, bk bankkeeper.Keeper) sdk.Invariant {
 		return statusMessage, isBroken
 	}
 }
-
-// Iterator over all coins and find the any matching our target marker denom, add their amounts to the returned total.
-func getCurrentSupply(ctx sdk.Context, denom string, bk bankkeeper.Keeper) sdk.Coin {
-	sup := bk.GetSupply(ctx)
-	for _, coin := range sup.GetTotal() {
-		if coin.Denom == denom {
-			return coin
-		}
-	}
-	return sdk.NewInt64Coin(denom, 0)
-}
@@ -47,6 +47,12 @@ type Keeper struct {
 	// To handle movement of coin between accounts and check total supply
 	bankKeeper bankkeeper.Keeper
 
+	// To check the attributes required to receive restricted coins
+	attrKeeper types.AttrKeeper
+
+	// To find the ibc transfer escrow accounts that can not be the source of a forced transfer
+	channelKeeper types.ChannelKeeper
+
 	// Key to access the key-value store from sdk.Context.
 	storeKey sdk.StoreKey
 
@@ -65,17 +71,21 @@ func NewKeeper(
 	paramSpace paramtypes.Subspace,
 	authKeeper authkeeper.AccountKeeper,
 	bankKeeper bankkeeper.Keeper,
+	attrKeeper types.AttrKeeper,
+	channelKeeper types.ChannelKeeper,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
 	return Keeper{
-		paramSpace: paramSpace,
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		storeKey:   key,
-		cdc:        cdc,
+		paramSpace:    paramSpace,
+		authKeeper:    authKeeper,
+		bankKeeper:    bankKeeper,
+		attrKeeper:    attrKeeper,
+		channelKeeper: channelKeeper,
+		storeKey:      key,
+		cdc:           cdc,
 	}
 }
 
@@ -117,7 +127,7 @@ func (k Keeper) SetMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 
 	// If Set Marker is called on an Active Marker then ensure the send_enabled configuration is also correct.
 	if marker.GetStatus() == types.StatusActive {
-		k.ensureSendEnabledStatus(ctx, marker.GetDenom(), marker.GetMarkerType() == types.MarkerType_Coin)
+		k.ensureSendEnabledStatus(ctx, marker.GetDenom(), marker.AllowsBankSend())
 	}
 }
 
@@ -2,19 +2,35 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	simapp "github.com/provenance-io/provenance/app"
 
+	"github.com/CosmWasm/wasmd/x/wasm"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/stretchr/testify/require"
 
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/marker/keeper"
 	"github.com/provenance-io/provenance/x/marker/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
 
 func TestAccountMapperGetSet(t *testing.T) {
@@ -476,8 +492,440 @@ func TestAccountImplictControl(t *testing.T) {
 	require.Error(t, app.MarkerKeeper.TransferCoin(ctx, user, user2, user, sdk.NewCoin("testcoin", sdk.NewInt(10))))
 }
 
+func TestRequiredAttributes(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	user := testUserAddress("test")
+	user2 := testUserAddress("test2")
+	user3 := testUserAddress("test3")
+
+	var nameData nametypes.GenesisState
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("kyc.provenance.io", user, false))
+	nameData.Params = nametypes.DefaultParams()
+	app.NameKeeper.InitGenesis(ctx, nameData)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, user))
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx, user2,
+		attrtypes.NewAttribute("kyc.provenance.io", user2, attrtypes.AttributeType_String, []byte("verified")), user))
+
+	mac := types.NewEmptyMarkerAccount("restrictedcoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	mac.RequiredAttributes = []string{"kyc.provenance.io"}
+	require.NoError(t, mac.SetManager(user))
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("restrictedcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "restrictedcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "restrictedcoin"))
+
+	// A restricted coin with required attributes can be sent through the bank module.
+	require.True(t, app.BankKeeper.SendEnabledCoin(ctx, sdk.NewCoin("restrictedcoin", sdk.NewInt(10))))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("restrictedcoin", 10))
+	require.NoError(t, app.MarkerKeeper.ValidateSendRestrictions(ctx, user2, coins))
+	require.EqualError(t, app.MarkerKeeper.ValidateSendRestrictions(ctx, user3, coins),
+		user3.String()+" does not have the attribute kyc.provenance.io required to receive restrictedcoin")
+
+	// Coins without a marker or without required attributes are not checked.
+	require.NoError(t, app.MarkerKeeper.ValidateSendRestrictions(ctx, user3, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+
+	// Sends dispatched by a contract are checked by the bank keeper.
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, user2, "restrictedcoin", coins))
+	require.EqualError(t, wasmBankSend(ctx, app, user2, user3, sdk.NewInt64Coin("restrictedcoin", 5)),
+		user3.String()+" does not have the attribute kyc.provenance.io required to receive restrictedcoin: unauthorized")
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx, user3,
+		attrtypes.NewAttribute("kyc.provenance.io", user3, attrtypes.AttributeType_String, []byte("verified")), user))
+	require.NoError(t, wasmBankSend(ctx, app, user2, user3, sdk.NewInt64Coin("restrictedcoin", 5)))
+	require.Equal(t, sdk.NewInt64Coin("restrictedcoin", 5), app.BankKeeper.GetBalance(ctx, user3, "restrictedcoin"))
+
+	// Removing the attribute from the recipient blocks further sends.
+	require.NoError(t, app.AttributeKeeper.DeleteAttribute(ctx, user2, "kyc.provenance.io", user))
+	require.Error(t, app.MarkerKeeper.ValidateSendRestrictions(ctx, user2, coins))
+	require.Error(t, app.BankKeeper.SendCoins(ctx, user3, user2, sdk.NewCoins(sdk.NewInt64Coin("restrictedcoin", 5))))
+}
+
+// wasmBankSend dispatches a bank send of the coin from a contract through the wasm message handler.
+func wasmBankSend(ctx sdk.Context, app *simapp.App, contract, to sdk.AccAddress, coin sdk.Coin) error {
+	router := baseapp.NewRouter().AddRoute(sdk.NewRoute(banktypes.RouterKey, bank.NewHandler(app.BankKeeper)))
+	_, _, err := wasm.NewMessageHandler(router, nil, nil, nil, nil).DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{
+		Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{
+			ToAddress: to.String(), Amount: wasmvmtypes.Coins{wasmvmtypes.NewCoin(coin.Amount.Uint64(), coin.Denom)},
+		}},
+	})
+	return err
+}
+
 // testUserAddress gives a quick way to make a valid test address (no keys though)
 func testUserAddress(name string) sdk.AccAddress {
 	addr := types.MustGetMarkerAddress(name)
 	return addr
 }
+
+func TestHolderIndex(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.MarkerKeeper.SetParams(ctx, types.DefaultParams())
+	addr := types.MustGetMarkerAddress("testcoin")
+	user := testUserAddress("test")
+
+	mac := types.NewEmptyMarkerAccount("testcoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("testcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "testcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "testcoin"))
+
+	holders := []sdk.AccAddress{}
+	for i := 0; i < 5; i++ {
+		holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, holder, "testcoin",
+			sdk.NewCoins(sdk.NewInt64Coin("testcoin", 10))))
+		holders = append(holders, holder)
+	}
+
+	// pages through the index using the next key of each page.
+	allHolders := func() []types.Balance {
+		balances := []types.Balance{}
+		pageReq := &query.PageRequest{Limit: 2}
+		for {
+			res, err := app.MarkerKeeper.Holding(sdk.WrapSDKContext(ctx), &types.QueryHoldingRequest{Id: "testcoin", Pagination: pageReq})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(res.Balances), 2)
+			balances = append(balances, res.Balances...)
+			if len(res.Pagination.NextKey) == 0 {
+				return balances
+			}
+			pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+		}
+	}
+	balances := allHolders()
+	require.Len(t, balances, 6, "the marker and each of the holders")
+	require.ElementsMatch(t, balances, app.MarkerKeeper.GetAllMarkerHolders(ctx, "testcoin"))
+	require.Contains(t, balances, types.Balance{Address: addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("testcoin", 950))})
+
+	// moving a full balance with a multi send removes the holder from the index.
+	require.NoError(t, app.BankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{banktypes.NewInput(holders[0], sdk.NewCoins(sdk.NewInt64Coin("testcoin", 10)))},
+		[]banktypes.Output{banktypes.NewOutput(holders[1], sdk.NewCoins(sdk.NewInt64Coin("testcoin", 10)))}))
+	balances = allHolders()
+	require.Len(t, balances, 5)
+	require.NotContains(t, balances, types.Balance{Address: holders[0].String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("testcoin", 10))})
+	require.Contains(t, balances, types.Balance{Address: holders[1].String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("testcoin", 20))})
+
+	// only the holders of marker denoms are indexed.
+	require.NoError(t, app.BankKeeper.SetBalance(ctx, holders[2], sdk.NewInt64Coin("othercoin", 10)))
+	require.Empty(t, app.MarkerKeeper.GetAllMarkerHolders(ctx, "othercoin"))
+
+	// a rebuild from the bank balances results in the same index.
+	app.MarkerKeeper.BuildHolderIndex(ctx)
+	require.ElementsMatch(t, balances, allHolders())
+	require.Empty(t, app.MarkerKeeper.GetAllMarkerHolders(ctx, "othercoin"))
+}
+
+func TestFreezeHolder(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.MarkerKeeper.SetParams(ctx, types.DefaultParams())
+	user := testUserAddress("test")
+	user2 := testUserAddress("test2")
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	var nameData nametypes.GenesisState
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("kyc.provenance.io", user, false))
+	nameData.Params = nametypes.DefaultParams()
+	app.NameKeeper.InitGenesis(ctx, nameData)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, user))
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx, user2,
+		attrtypes.NewAttribute("kyc.provenance.io", user2, attrtypes.AttributeType_String, []byte("verified")), user))
+
+	mac := types.NewEmptyMarkerAccount("freezecoin", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Transfer, types.Access_Freeze})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	mac.RequiredAttributes = []string{"kyc.provenance.io"}
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("freezecoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "freezecoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "freezecoin"))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, holder, "freezecoin",
+		sdk.NewCoins(sdk.NewInt64Coin("freezecoin", 100))))
+
+	// only accounts with the freeze access can freeze or unfreeze holders.
+	require.EqualError(t, app.MarkerKeeper.FreezeHolder(ctx, user2, "freezecoin", holder),
+		user2.String()+" is not allowed to freeze holders of freezecoin")
+	require.EqualError(t, app.MarkerKeeper.FreezeHolder(ctx, user, "nocoin", holder),
+		"marker not found for nocoin: marker nocoin not found for address: "+types.MustGetMarkerAddress("nocoin").String())
+	require.EqualError(t, app.MarkerKeeper.UnfreezeHolder(ctx, user, "freezecoin", holder),
+		holder.String()+" is not frozen for freezecoin")
+
+	require.NoError(t, app.MarkerKeeper.FreezeHolder(ctx, user, "freezecoin", holder))
+	require.True(t, app.MarkerKeeper.IsFrozen(ctx, "freezecoin", holder))
+	require.False(t, app.MarkerKeeper.IsFrozen(ctx, "freezecoin", user2))
+
+	// a frozen holder can not send its balance by transfer or bank send.
+	coin := sdk.NewInt64Coin("freezecoin", 10)
+	require.EqualError(t, app.MarkerKeeper.TransferCoin(ctx, holder, user2, user, coin),
+		holder.String()+" is frozen and can not send freezecoin")
+	require.EqualError(t, app.MarkerKeeper.ValidateNotFrozen(ctx, holder, sdk.NewCoins(coin)),
+		holder.String()+" is frozen and can not send freezecoin")
+	require.NoError(t, app.MarkerKeeper.ValidateNotFrozen(ctx, holder, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+
+	// the frozen set is checked by the bank keeper for sends from contracts and into the ibc transfer escrow.
+	require.EqualError(t, wasmBankSend(ctx, app, holder, user2, coin),
+		holder.String()+" is frozen and can not send freezecoin: unauthorized")
+	escrow := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+	require.EqualError(t, app.BankKeeper.SendCoins(ctx, holder, escrow, sdk.NewCoins(coin)),
+		holder.String()+" is frozen and can not send freezecoin: unauthorized")
+
+	// sends into module accounts such as a gov deposit are checked as well.
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, govtypes.NewTextProposal("title", "description"))
+	require.NoError(t, err)
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, holder, sdk.NewCoins(coin))
+	require.EqualError(t, err, holder.String()+" is frozen and can not send freezecoin: unauthorized")
+	require.EqualError(t, app.BankKeeper.DelegateCoinsFromAccountToModule(ctx, holder, stakingtypes.BondedPoolName,
+		sdk.NewCoins(coin)), holder.String()+" is frozen and can not send freezecoin: unauthorized")
+	require.Equal(t, sdk.NewInt64Coin("freezecoin", 100), app.BankKeeper.GetBalance(ctx, holder, "freezecoin"))
+
+	frozen, err := app.MarkerKeeper.FrozenHolders(sdk.WrapSDKContext(ctx), &types.QueryFrozenHoldersRequest{Id: "freezecoin"})
+	require.NoError(t, err)
+	require.Equal(t, []string{holder.String()}, frozen.Addresses)
+	frozenStatus, err := app.MarkerKeeper.FrozenStatus(sdk.WrapSDKContext(ctx),
+		&types.QueryFrozenStatusRequest{Id: mac.GetAddress().String(), Address: holder.String()})
+	require.NoError(t, err)
+	require.True(t, frozenStatus.Frozen)
+
+	// frozen holders are exported and imported with the genesis state.
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.FrozenHolder{{Denom: "freezecoin", Address: holder.String()}}, genesis.FrozenHolders)
+	app2 := simapp.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{})
+	app2.MarkerKeeper.InitGenesis(ctx2, genesis)
+	require.True(t, app2.MarkerKeeper.IsFrozen(ctx2, "freezecoin", holder))
+
+	require.NoError(t, app.MarkerKeeper.UnfreezeHolder(ctx, user, "freezecoin", holder))
+	require.False(t, app.MarkerKeeper.IsFrozen(ctx, "freezecoin", holder))
+	require.NoError(t, app.MarkerKeeper.TransferCoin(ctx, holder, user2, user, coin))
+	frozenStatus, err = app.MarkerKeeper.FrozenStatus(sdk.WrapSDKContext(ctx),
+		&types.QueryFrozenStatusRequest{Id: "freezecoin", Address: holder.String()})
+	require.NoError(t, err)
+	require.False(t, frozenStatus.Frozen)
+}
+
+func TestForceTransfer(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.MarkerKeeper.SetParams(ctx, types.DefaultParams())
+	user := testUserAddress("test")
+	user2 := testUserAddress("test2")
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	newMarker := func(denom string, allowForcedTransfer bool) {
+		mac := types.NewEmptyMarkerAccount(denom, user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+			[]types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Freeze, types.Access_ForceTransfer})})
+		mac.MarkerType = types.MarkerType_RestrictedCoin
+		mac.AllowForcedTransfer = allowForcedTransfer
+		require.NoError(t, mac.SetSupply(sdk.NewCoin(denom, sdk.NewInt(1000))))
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+		require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, denom))
+		require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, denom))
+		require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, holder, denom,
+			sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	}
+	newMarker("clawcoin", true)
+	newMarker("nonclawcoin", false)
+
+	require.EqualError(t, app.MarkerKeeper.ForceTransfer(ctx, holder, user, user, sdk.NewInt64Coin("nonclawcoin", 10), "recovery"),
+		"marker nonclawcoin does not allow forced transfers")
+	require.EqualError(t, app.MarkerKeeper.ForceTransfer(ctx, holder, user2, user2, sdk.NewInt64Coin("clawcoin", 10), "recovery"),
+		user2.String()+" is not allowed to force transfers of clawcoin")
+	require.Error(t, app.MarkerKeeper.ForceTransfer(ctx, holder, user, user, sdk.NewInt64Coin("clawcoin", 101), "recovery"))
+
+	// coin held by module accounts, markers and ibc escrow accounts can not be recovered.
+	bondedPool := app.AccountKeeper.GetModuleAddress(stakingtypes.BondedPoolName)
+	require.EqualError(t, app.MarkerKeeper.ForceTransfer(ctx, bondedPool, user2, user, sdk.NewInt64Coin("clawcoin", 10), "recovery"),
+		bondedPool.String()+" is a blocked address and can not be the source of a forced transfer")
+	customModule := authtypes.NewEmptyModuleAccount("custom")
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, customModule))
+	require.EqualError(t, app.MarkerKeeper.ForceTransfer(ctx, customModule.GetAddress(), user2, user, sdk.NewInt64Coin("clawcoin", 10), "recovery"),
+		customModule.GetAddress().String()+" is a module account and can not be the source of a forced transfer")
+	nonClawAddr := types.MustGetMarkerAddress("nonclawcoin")
+	require.EqualError(t, app.MarkerKeeper.ForceTransfer(ctx, nonClawAddr, user2, user, sdk.NewInt64Coin("clawcoin", 10), "recovery"),
+		nonClawAddr.String()+" is a marker account and can not be the source of a forced transfer")
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, ibctransfertypes.PortID, "channel-0", channeltypes.Channel{})
+	escrow := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+	require.EqualError(t, app.MarkerKeeper.ForceTransfer(ctx, escrow, user2, user, sdk.NewInt64Coin("clawcoin", 10), "recovery"),
+		escrow.String()+" is an ibc escrow account and can not be the source of a forced transfer")
+
+	// the balance of a frozen holder can be recovered.
+	require.NoError(t, app.MarkerKeeper.FreezeHolder(ctx, user, "clawcoin", holder))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.MarkerKeeper.ForceTransfer(ctx, holder, user2, user, sdk.NewInt64Coin("clawcoin", 60), "lost key"))
+	require.Equal(t, sdk.NewInt64Coin("clawcoin", 40), app.BankKeeper.GetBalance(ctx, holder, "clawcoin"))
+	require.Equal(t, sdk.NewInt64Coin("clawcoin", 60), app.BankKeeper.GetBalance(ctx, user2, "clawcoin"))
+
+	// the forced transfer event follows the bank transfer and message events.
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 3)
+	require.Equal(t, types.EventTypeForceTransfer, events[2].Type)
+	require.Contains(t, events[2].Attributes, abci.EventAttribute{Key: []byte(types.EventAttributeReasonKey), Value: []byte("lost key")})
+	require.Contains(t, events[2].Attributes, abci.EventAttribute{Key: []byte(types.EventAttributeFromKey), Value: []byte(holder.String())})
+}
+
+func TestMaxSupply(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	user := testUserAddress("test")
+
+	// supply in 18 decimal base units beyond the range of a uint64.
+	maxSupply := sdk.NewIntWithDecimal(1, 30)
+	app.MarkerKeeper.SetParams(ctx, types.NewParams(maxSupply, true, types.DefaultUnrestrictedDenomRegex))
+
+	newMarker := func(denom string, supply sdk.Int) *types.MarkerAccount {
+		mac := types.NewEmptyMarkerAccount(denom, user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+			[]types.Access{types.Access_Mint, types.Access_Burn, types.Access_Withdraw})})
+		require.NoError(t, mac.SetSupply(sdk.NewCoin(denom, supply)))
+		return mac
+	}
+
+	require.EqualError(t, app.MarkerKeeper.AddMarkerAccount(ctx, newMarker("toobig", maxSupply.AddRaw(1))),
+		"requested supply 1000000000000000000000000000001 exceeds maximum allowed value 1000000000000000000000000000000")
+
+	supply := sdk.NewIntWithDecimal(1, 25)
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, newMarker("bigcoin", supply)))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "bigcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "bigcoin"))
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, user, sdk.NewCoin("bigcoin", supply)))
+	require.Equal(t, supply.MulRaw(2), app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf("bigcoin"))
+	require.EqualError(t, app.MarkerKeeper.MintCoin(ctx, user, sdk.NewCoin("bigcoin", maxSupply)),
+		"requested supply 1000020000000000000000000000000 exceeds maximum allowed value 1000000000000000000000000000000")
+}
+
+func TestMigrateParamsMaxSupply(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// a param store from before the max supply param only has the max total supply.
+	subspace, found := app.ParamsKeeper.GetSubspace(types.ModuleName)
+	require.True(t, found)
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramStore.Set(types.ParamStoreKeyMaxTotalSupply, []byte(`"5000"`))
+	paramStore.Delete(types.ParamStoreKeyMaxSupply)
+	require.False(t, subspace.Has(ctx, types.ParamStoreKeyMaxSupply))
+
+	app.MarkerKeeper.MigrateParams(ctx)
+	var stored sdk.Int
+	subspace.Get(ctx, types.ParamStoreKeyMaxSupply, &stored)
+	require.Equal(t, sdk.NewInt(5000), stored)
+	require.Equal(t, sdk.NewInt(5000), app.MarkerKeeper.GetParams(ctx).MaxSupply)
+	require.Equal(t, uint64(5000), app.MarkerKeeper.GetParams(ctx).MaxTotalSupply) // nolint:staticcheck
+
+	// a migrated max supply is not replaced.
+	app.MarkerKeeper.SetParams(ctx, types.NewParams(sdk.NewInt(7000), true, types.DefaultUnrestrictedDenomRegex))
+	app.MarkerKeeper.MigrateParams(ctx)
+	require.Equal(t, sdk.NewInt(7000), app.MarkerKeeper.GetParams(ctx).MaxSupply)
+
+	// the deprecated max total supply can no longer be changed.
+	require.PanicsWithValue(t, "parameter MaxTotalSupply not registered", func() {
+		_ = subspace.Update(ctx, types.ParamStoreKeyMaxTotalSupply, []byte(`"9000"`))
+	})
+}
+
+func TestMintSchedule(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.MarkerKeeper.SetParams(ctx, types.DefaultParams())
+	user := testUserAddress("test")
+	user2 := testUserAddress("test2")
+	at := time.Unix(1_600_000_000, 0).UTC()
+
+	minter := testUserAddress("test3")
+	mac := types.NewEmptyMarkerAccount("schedulecoin", user.String(), []types.AccessGrant{
+		*types.NewAccessGrant(user, []types.Access{types.Access_Mint, types.Access_Withdraw}),
+		*types.NewAccessGrant(minter, []types.Access{types.Access_Mint}),
+	})
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin("schedulecoin", 1000)))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+
+	entries := []types.MintScheduleEntry{
+		types.NewMintScheduleEntry(at.Add(time.Hour), sdk.NewInt(20), user2.String()),
+		types.NewMintScheduleEntry(at, sdk.NewInt(10), ""),
+	}
+	require.EqualError(t, app.MarkerKeeper.SetMintSchedule(ctx, user, "schedulecoin", entries),
+		"cannot schedule supply increases for a marker that is not in Active status")
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "schedulecoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "schedulecoin"))
+
+	// only accounts with the mint access can schedule supply increases.
+	require.EqualError(t, app.MarkerKeeper.SetMintSchedule(ctx, user2, "schedulecoin", entries),
+		user2.String()+" does not have ACCESS_MINT on schedulecoin markeraccount")
+	// sending the new coin to a target address also requires the withdraw access.
+	require.EqualError(t, app.MarkerKeeper.SetMintSchedule(ctx, minter, "schedulecoin", entries),
+		minter.String()+" does not have ACCESS_WITHDRAW on schedulecoin markeraccount")
+	require.NoError(t, app.MarkerKeeper.SetMintSchedule(ctx, minter, "schedulecoin", entries[1:]))
+	require.EqualError(t, app.MarkerKeeper.SetMintSchedule(ctx, user, "schedulecoin",
+		[]types.MintScheduleEntry{types.NewMintScheduleEntry(at, sdk.NewInt(0), "")}),
+		"mint schedule entry amount must be positive")
+	tooMany := make([]types.MintScheduleEntry, types.MaxMintScheduleEntries+1)
+	for i := range tooMany {
+		tooMany[i] = types.NewMintScheduleEntry(at.Add(time.Duration(i)*time.Hour), sdk.NewInt(1), "")
+	}
+	require.EqualError(t, app.MarkerKeeper.SetMintSchedule(ctx, user, "schedulecoin", tooMany),
+		"mint schedule cannot have more than 100 entries")
+	blocked := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.EqualError(t, app.MarkerKeeper.SetMintSchedule(ctx, user, "schedulecoin",
+		[]types.MintScheduleEntry{types.NewMintScheduleEntry(at, sdk.NewInt(10), blocked.String())}),
+		blocked.String()+" is not allowed to receive funds")
+
+	// entries are stored in the order they are due.
+	require.NoError(t, app.MarkerKeeper.SetMintSchedule(ctx, user, "schedulecoin", entries))
+	expected := types.MintSchedule{
+		Denom: "schedulecoin", Entries: []types.MintScheduleEntry{entries[1], entries[0]}, Administrator: user.String(),
+	}
+	schedule, err := app.MarkerKeeper.MintSchedule(sdk.WrapSDKContext(ctx), &types.QueryMintScheduleRequest{Id: "schedulecoin"})
+	require.NoError(t, err)
+	require.Equal(t, expected, schedule.Schedule)
+	all, err := app.MarkerKeeper.AllMintSchedules(sdk.WrapSDKContext(ctx), &types.QueryAllMintSchedulesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.MintSchedule{expected}, all.Schedules)
+
+	// the schedule is indexed by the time of its first entry only.
+	dueIndex := func() [][]byte {
+		iterator := sdk.KVStorePrefixIterator(ctx.KVStore(app.GetKey(types.StoreKey)), types.MintScheduleDueKeyPrefix)
+		defer iterator.Close()
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		return keys
+	}
+	require.Equal(t, [][]byte{types.MintScheduleDueKey(at, mac.GetAddress())}, dueIndex())
+
+	// mint schedules are exported and imported with the genesis state.
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.MintSchedule{expected}, genesis.MintSchedules)
+	app2 := simapp.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{})
+	app2.MarkerKeeper.InitGenesis(ctx2, genesis)
+	require.Equal(t, &expected, app2.MarkerKeeper.GetMintSchedule(ctx2, mac.GetAddress()))
+
+	// governance can only replace the schedule of markers that allow governance control.
+	m, err := app.MarkerKeeper.GetMarker(ctx, mac.GetAddress())
+	require.NoError(t, err)
+	m.(*types.MarkerAccount).AllowGovernanceControl = false
+	app.MarkerKeeper.SetMarker(ctx, m)
+	proposal := types.NewSetMintScheduleProposal("title", "description", "schedulecoin", entries[:1])
+	require.EqualError(t, keeper.HandleSetMintScheduleProposal(ctx, app.MarkerKeeper, proposal),
+		"schedulecoin marker does not allow governance control")
+	m.(*types.MarkerAccount).AllowGovernanceControl = true
+	app.MarkerKeeper.SetMarker(ctx, m)
+	require.NoError(t, keeper.HandleSetMintScheduleProposal(ctx, app.MarkerKeeper, proposal))
+	require.Equal(t, types.MintSchedule{Denom: "schedulecoin", Entries: entries[:1]},
+		*app.MarkerKeeper.GetMintSchedule(ctx, mac.GetAddress()))
+	require.Equal(t, [][]byte{types.MintScheduleDueKey(at.Add(time.Hour), mac.GetAddress())}, dueIndex())
+
+	// an empty list of entries removes the schedule.
+	require.NoError(t, app.MarkerKeeper.SetMintSchedule(ctx, user, "schedulecoin", nil))
+	require.Nil(t, app.MarkerKeeper.GetMintSchedule(ctx, mac.GetAddress()))
+	require.Empty(t, dueIndex())
+	schedule, err = app.MarkerKeeper.MintSchedule(sdk.WrapSDKContext(ctx), &types.QueryMintScheduleRequest{Id: "schedulecoin"})
+	require.NoError(t, err)
+	require.Empty(t, schedule.Schedule.Entries)
+}
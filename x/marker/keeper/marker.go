@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
 )
@@ -12,14 +14,12 @@ import (
 // GetAllMarkerHolders returns an array of all account addresses holding the given denom (and the amount)
 func (k Keeper) GetAllMarkerHolders(ctx sdk.Context, denom string) []types.Balance {
 	var results []types.Balance
-	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) (stop bool) {
-		if coin.Denom == denom && !coin.Amount.IsZero() {
-			results = append(results,
-				types.Balance{
-					Address: addr.String(),
-					Coins:   sdk.NewCoins(coin),
-				})
-		}
+	k.IterateMarkerHolders(ctx, denom, func(addr sdk.AccAddress) (stop bool) {
+		results = append(results,
+			types.Balance{
+				Address: addr.String(),
+				Coins:   sdk.NewCoins(k.bankKeeper.GetBalance(ctx, addr, denom)),
+			})
 		return false // do not stop iterating
 	})
 	return results
@@ -52,6 +52,11 @@ func (k Keeper) AddMarkerAccount(ctx sdk.Context, marker types.MarkerAccountI) e
 		return fmt.Errorf("marker address does not match expected %s for denom %s", markerAddress, marker.GetDenom())
 	}
 
+	maxAllowed := k.GetParams(ctx).MaxSupply
+	if marker.GetSupply().Amount.GT(maxAllowed) {
+		return fmt.Errorf("requested supply %s exceeds maximum allowed value %s", marker.GetSupply().Amount, maxAllowed)
+	}
+
 	// Should not exist yet
 	existing, err := k.GetMarker(ctx, markerAddress)
 	if err != nil {
@@ -159,7 +164,7 @@ func (k Keeper) WithdrawCoins(
 		recipient = caller
 	}
 
-	if err := k.bankKeeper.InputOutputCoins(ctx, []banktypes.Input{banktypes.NewInput(m.GetAddress(), coins)},
+	if err := k.bankKeeper.InputOutputCoins(withoutSendRestrictions(ctx), []banktypes.Input{banktypes.NewInput(m.GetAddress(), coins)},
 		[]banktypes.Output{banktypes.NewOutput(recipient, coins)}); err != nil {
 		return err
 	}
@@ -299,9 +304,9 @@ func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI,
 func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin) error {
 	inCirculation := sdk.NewCoin(marker.GetDenom(), k.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(marker.GetDenom()))
 	total := inCirculation.Add(coin)
-	maxAllowed := k.GetParams(ctx).MaxTotalSupply
-	if total.Amount.Uint64() > maxAllowed {
-		return fmt.Errorf("requested supply %d exceeds maximum allowed value %d", total.Amount, maxAllowed)
+	maxAllowed := k.GetParams(ctx).MaxSupply
+	if total.Amount.GT(maxAllowed) {
+		return fmt.Errorf("requested supply %s exceeds maximum allowed value %s", total.Amount, maxAllowed)
 	}
 
 	// If the marker has a fixed supply then adjust the supply to match the new total
@@ -312,6 +317,7 @@ func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coi
 		// save the updated marker
 		k.SetMarker(ctx, marker)
 	}
+	k.MarkSupplyChanged(ctx, marker.GetAddress())
 
 	return k.AdjustCirculation(ctx, marker, total)
 }
@@ -338,6 +344,7 @@ func (k Keeper) DecreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coi
 		// Finalize supply update in marker record
 		k.SetMarker(ctx, marker)
 	}
+	k.MarkSupplyChanged(ctx, marker.GetAddress())
 
 	// Adjust circulation to match configured supply.
 	if err := k.AdjustCirculation(ctx, marker, inCirculation); err != nil {
@@ -411,10 +418,8 @@ func (k Keeper) ActivateMarker(ctx sdk.Context, caller sdk.Address, denom string
 
 	// Verify the send_enabled status of this coin denom matches the marker types
 	switch m.GetMarkerType() {
-	case types.MarkerType_Coin:
-		k.ensureSendEnabledStatus(ctx, denom, true)
-	case types.MarkerType_RestrictedCoin:
-		k.ensureSendEnabledStatus(ctx, denom, false)
+	case types.MarkerType_Coin, types.MarkerType_RestrictedCoin:
+		k.ensureSendEnabledStatus(ctx, denom, m.AllowsBankSend())
 	default:
 		return fmt.Errorf("marker of %s type can not be activated", m.GetMarkerType())
 	}
@@ -444,6 +449,7 @@ func (k Keeper) ActivateMarker(ctx sdk.Context, caller sdk.Address, denom string
 
 	// record status as active
 	k.SetMarker(ctx, m)
+	k.MarkSupplyChanged(ctx, m.GetAddress())
 	return nil
 }
 
@@ -545,8 +551,11 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 	if k.bankKeeper.BlockedAddr(to) {
 		return fmt.Errorf("%s is not allowed to receive funds", to)
 	}
-	// send the coins between accounts (does not check send_enabled on coin denom)
-	if err = k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(amount)); err != nil {
+	if k.IsFrozen(ctx, amount.Denom, from) {
+		return fmt.Errorf("%s is frozen and can not send %s", from, amount.Denom)
+	}
+	// send the coins between accounts (does not check send_enabled on coin denom or the required attributes)
+	if err = k.bankKeeper.SendCoins(withoutSendRestrictions(ctx), from, to, sdk.NewCoins(amount)); err != nil {
 		return err
 	}
 
@@ -562,6 +571,98 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 	return nil
 }
 
+// ForceTransfer recovers restricted coins from a holder account without the signature of the holder.  The marker must
+// allow forced transfers and the administrator account must hold the force transfer access right.  Balances of frozen
+// holders may be recovered.
+func (k Keeper) ForceTransfer(ctx sdk.Context, from, to, admin sdk.AccAddress, amount sdk.Coin, reason string) error {
+	m, err := k.GetMarkerByDenom(ctx, amount.Denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", amount.Denom, err)
+	}
+	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return fmt.Errorf("marker type is not restricted_coin, forced transfer not supported")
+	}
+	if !m.AllowsForcedTransfer() {
+		return fmt.Errorf("marker %s does not allow forced transfers", amount.Denom)
+	}
+	if !m.AddressHasAccess(admin, types.Access_ForceTransfer) {
+		return fmt.Errorf("%s is not allowed to force transfers of %s", admin, amount.Denom)
+	}
+	if err = k.validateForceTransferSource(ctx, from); err != nil {
+		return err
+	}
+	if k.bankKeeper.BlockedAddr(to) {
+		return fmt.Errorf("%s is not allowed to receive funds", to)
+	}
+	// send the coins between accounts (does not check send_enabled on coin denom or the required attributes)
+	if err = k.bankKeeper.SendCoins(withoutSendRestrictions(ctx), from, to, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForceTransfer,
+			sdk.NewAttribute(types.EventAttributeDenomKey, amount.Denom),
+			sdk.NewAttribute(types.EventAttributeAmountKey, amount.String()),
+			sdk.NewAttribute(types.EventAttributeAdministratorKey, admin.String()),
+			sdk.NewAttribute(types.EventAttributeFromKey, from.String()),
+			sdk.NewAttribute(types.EventAttributeToKey, to.String()),
+			sdk.NewAttribute(types.EventAttributeReasonKey, reason),
+			sdk.NewAttribute(types.EventAttributeModuleNameKey, types.ModuleName),
+		),
+	)
+	return nil
+}
+
+// validateForceTransferSource ensures coin is only recovered from accounts held by users.  Module accounts (such as the
+// bonded pool), markers and the ibc transfer escrow accounts hold coin on behalf of others.
+func (k Keeper) validateForceTransferSource(ctx sdk.Context, from sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(from) {
+		return fmt.Errorf("%s is a blocked address and can not be the source of a forced transfer", from)
+	}
+	switch k.authKeeper.GetAccount(ctx, from).(type) {
+	case types.MarkerAccountI:
+		return fmt.Errorf("%s is a marker account and can not be the source of a forced transfer", from)
+	case authtypes.ModuleAccountI:
+		return fmt.Errorf("%s is a module account and can not be the source of a forced transfer", from)
+	}
+	for _, channel := range k.channelKeeper.GetAllChannels(ctx) {
+		if ibctransfertypes.GetEscrowAddress(channel.PortId, channel.ChannelId).Equals(from) {
+			return fmt.Errorf("%s is an ibc escrow account and can not be the source of a forced transfer", from)
+		}
+	}
+	return nil
+}
+
+// ValidateSendRestrictions ensures the recipient of a bank send has all of the attributes required by the markers of
+// any restricted coins being sent.
+func (k Keeper) ValidateSendRestrictions(ctx sdk.Context, to sdk.AccAddress, amount sdk.Coins) error {
+	var attributes map[string]bool
+	for _, coin := range amount {
+		m, err := k.GetMarkerByDenom(ctx, coin.Denom)
+		if err != nil || m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+			continue
+		}
+		// The recipient attributes are only loaded once a restricted coin is found.
+		if attributes == nil {
+			attrs, err := k.attrKeeper.GetAllAttributes(ctx, to)
+			if err != nil {
+				return fmt.Errorf("unable to get attributes for %s: %w", to, err)
+			}
+			attributes = make(map[string]bool, len(attrs))
+			for _, a := range attrs {
+				attributes[a.Name] = true
+			}
+		}
+		for _, name := range m.GetRequiredAttributes() {
+			if !attributes[name] {
+				return fmt.Errorf("%s does not have the attribute %s required to receive %s", to, name, coin.Denom)
+			}
+		}
+	}
+	return nil
+}
+
 // SetMarkerMetadata updates the denom metadata records for the current marker.
 func (k Keeper) SetMarkerMetadata(ctx sdk.Context, metadata banktypes.Metadata, caller sdk.AccAddress) error {
 	if metadata.Base == "" {
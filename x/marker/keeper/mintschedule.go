@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetMintSchedule replaces the scheduled supply increases of the marker.  The caller must hold the mint access right
+// on the marker and the withdraw access right when an entry sends the coin to a target address.  An empty list of
+// entries removes the schedule.
+func (k Keeper) SetMintSchedule(ctx sdk.Context, caller sdk.AccAddress, denom string, entries []types.MintScheduleEntry) error {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	if !m.AddressHasAccess(caller, types.Access_Mint) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Mint, m.GetDenom())
+	}
+	for _, e := range entries {
+		if err = validateMintScheduleAuthority(m, caller, e); err != nil {
+			return err
+		}
+	}
+	return k.setMintSchedule(ctx, m, caller.String(), entries)
+}
+
+// validateMintScheduleAuthority ensures the administrator holds the access rights needed to execute the entry.
+func validateMintScheduleAuthority(m types.MarkerAccountI, admin sdk.AccAddress, e types.MintScheduleEntry) error {
+	if !m.AddressHasAccess(admin, types.Access_Mint) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", admin, types.Access_Mint, m.GetDenom())
+	}
+	if len(e.ToAddress) > 0 && !m.AddressHasAccess(admin, types.Access_Withdraw) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", admin, types.Access_Withdraw, m.GetDenom())
+	}
+	return nil
+}
+
+// setMintSchedule validates the entries against the state of the marker and stores them ordered by time.  The
+// administrator is empty for a schedule set by governance.
+func (k Keeper) setMintSchedule(ctx sdk.Context, m types.MarkerAccountI, administrator string, entries []types.MintScheduleEntry) error {
+	if m.GetStatus() != types.StatusActive {
+		return fmt.Errorf("cannot schedule supply increases for a marker that is not in Active status")
+	}
+	if err := types.ValidateMintScheduleEntries(entries); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if len(e.ToAddress) == 0 {
+			continue
+		}
+		// validated above
+		to, _ := sdk.AccAddressFromBech32(e.ToAddress)
+		if k.bankKeeper.BlockedAddr(to) {
+			return fmt.Errorf("%s is not allowed to receive funds", e.ToAddress)
+		}
+	}
+	schedule := types.MintSchedule{
+		Denom:         m.GetDenom(),
+		Entries:       append([]types.MintScheduleEntry{}, entries...),
+		Administrator: administrator,
+	}
+	types.SortMintScheduleEntries(schedule.Entries)
+	k.storeMintSchedule(ctx, m.GetAddress(), schedule)
+	return nil
+}
+
+// storeMintSchedule writes the schedule and its due time index entry to the store, removing both when no entries
+// remain.  The entries must be ordered by time.
+func (k Keeper) storeMintSchedule(ctx sdk.Context, addr sdk.AccAddress, schedule types.MintSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	if existing := k.GetMintSchedule(ctx, addr); existing != nil {
+		store.Delete(types.MintScheduleDueKey(existing.Entries[0].Time, addr))
+	}
+	if len(schedule.Entries) == 0 {
+		store.Delete(types.MintScheduleKey(addr))
+		return
+	}
+	store.Set(types.MintScheduleKey(addr), k.cdc.MustMarshalBinaryBare(&schedule))
+	store.Set(types.MintScheduleDueKey(schedule.Entries[0].Time, addr), addr.Bytes())
+}
+
+// GetMintSchedule returns the pending scheduled supply increases of the marker, nil if there are none.
+func (k Keeper) GetMintSchedule(ctx sdk.Context, addr sdk.AccAddress) *types.MintSchedule {
+	bz := ctx.KVStore(k.storeKey).Get(types.MintScheduleKey(addr))
+	if len(bz) == 0 {
+		return nil
+	}
+	var schedule types.MintSchedule
+	k.cdc.MustUnmarshalBinaryBare(bz, &schedule)
+	return &schedule
+}
+
+// GetMintSchedules returns a page of the pending mint schedules of all markers.
+func (k Keeper) GetMintSchedules(ctx sdk.Context, pageRequest *query.PageRequest) ([]types.MintSchedule, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintScheduleKeyPrefix)
+	results := []types.MintSchedule{}
+	pageRes, err := query.Paginate(store, pageRequest, func(_ []byte, value []byte) error {
+		var schedule types.MintSchedule
+		if err := k.cdc.UnmarshalBinaryBare(value, &schedule); err != nil {
+			return err
+		}
+		results = append(results, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return results, pageRes, nil
+}
+
+// IterateMintSchedules processes the mint schedule of every marker.
+func (k Keeper) IterateMintSchedules(ctx sdk.Context, cb func(schedule types.MintSchedule) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.MintScheduleKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.MintSchedule
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &schedule)
+		if cb(schedule) {
+			break
+		}
+	}
+}
+
+// ExecuteMintSchedules increases the supply of every marker with scheduled entries due at the current block time and
+// sends the new coin to the target address of each entry.  Entries that can not be executed, including those whose
+// administrator no longer holds the required access, are dropped from the schedule with an event recording the reason.
+func (k Keeper) ExecuteMintSchedules(ctx sdk.Context) {
+	// only the schedules with an entry due at or before the block time are read from the due time index.
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.MintScheduleDueKeyPrefix,
+		sdk.PrefixEndBytes(types.MintScheduleDueTimeKeyPrefix(ctx.BlockTime())))
+	due := []sdk.AccAddress{}
+	for ; iterator.Valid(); iterator.Next() {
+		due = append(due, iterator.Value())
+	}
+	iterator.Close()
+
+	for _, addr := range due {
+		schedule := k.GetMintSchedule(ctx, addr)
+		if schedule == nil {
+			continue
+		}
+		remaining := []types.MintScheduleEntry{}
+		for _, e := range schedule.Entries {
+			if e.Time.After(ctx.BlockTime()) {
+				remaining = append(remaining, e)
+				continue
+			}
+			// each entry is applied in isolation so a failure does not leave a partial supply change behind.
+			cacheCtx, writeCache := ctx.CacheContext()
+			if err := k.executeMintScheduleEntry(cacheCtx, *schedule, e); err != nil {
+				k.Logger(ctx).Error("unable to execute scheduled supply increase", "marker", schedule.Denom, "err", err)
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeScheduledMintFailed,
+						sdk.NewAttribute(types.EventAttributeDenomKey, schedule.Denom),
+						sdk.NewAttribute(types.EventAttributeAmountKey, e.Amount.String()),
+						sdk.NewAttribute(types.EventAttributeErrorKey, err.Error()),
+						sdk.NewAttribute(types.EventAttributeModuleNameKey, types.ModuleName),
+					),
+				)
+				continue
+			}
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+		schedule.Entries = remaining
+		k.storeMintSchedule(ctx, addr, *schedule)
+	}
+}
+
+// executeMintScheduleEntry checks the marker still allows the entry, increases the supply of the marker and withdraws
+// the new coin to the target of the entry.
+func (k Keeper) executeMintScheduleEntry(ctx sdk.Context, schedule types.MintSchedule, e types.MintScheduleEntry) error {
+	denom := schedule.Denom
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	if m.GetStatus() != types.StatusActive {
+		return fmt.Errorf("cannot mint coin for a marker that is not in Active status")
+	}
+	if len(schedule.Administrator) > 0 {
+		admin, err := sdk.AccAddressFromBech32(schedule.Administrator)
+		if err != nil {
+			return err
+		}
+		if err = validateMintScheduleAuthority(m, admin, e); err != nil {
+			return err
+		}
+	} else if !m.HasGovernanceEnabled() {
+		return fmt.Errorf("%s marker does not allow governance control", denom)
+	}
+	coin := sdk.NewCoin(denom, e.Amount)
+	var to sdk.AccAddress
+	if len(e.ToAddress) > 0 {
+		if to, err = sdk.AccAddressFromBech32(e.ToAddress); err != nil {
+			return err
+		}
+		if k.bankKeeper.BlockedAddr(to) {
+			return fmt.Errorf("%s is not allowed to receive funds", e.ToAddress)
+		}
+		if err = k.ValidateSendRestrictions(ctx, to, sdk.NewCoins(coin)); err != nil {
+			return err
+		}
+	}
+	if err = k.IncreaseSupply(ctx, m, coin); err != nil {
+		return err
+	}
+	if !to.Empty() {
+		coins := sdk.NewCoins(coin)
+		if err := k.bankKeeper.InputOutputCoins(withoutSendRestrictions(ctx), []banktypes.Input{banktypes.NewInput(m.GetAddress(), coins)},
+			[]banktypes.Output{banktypes.NewOutput(to, coins)}); err != nil {
+			return err
+		}
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduledMint,
+			sdk.NewAttribute(types.EventAttributeDenomKey, denom),
+			sdk.NewAttribute(types.EventAttributeAmountKey, e.Amount.String()),
+			sdk.NewAttribute(types.EventAttributeToKey, e.ToAddress),
+			sdk.NewAttribute(types.EventAttributeModuleNameKey, types.ModuleName),
+		),
+	)
+	return nil
+}
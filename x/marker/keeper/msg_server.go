@@ -68,6 +68,8 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 		msg.MarkerType)
 	ma.SupplyFixed = msg.SupplyFixed
 	ma.AllowGovernanceControl = msg.AllowGovernanceControl
+	ma.RequiredAttributes = msg.RequiredAttributes
+	ma.AllowForcedTransfer = msg.AllowForcedTransfer
 
 	if err := k.Keeper.AddMarkerAccount(ctx, ma); err != nil {
 		ctx.Logger().Error("unable to add marker", "err", err)
@@ -382,3 +384,119 @@ func (k msgServer) SetDenomMetadata(
 	)
 	return &types.MsgSetDenomMetadataResponse{}, nil
 }
+
+// FreezeHolder handles a message to freeze the balance of a marker coin held by an account
+func (k msgServer) FreezeHolder(goCtx context.Context, msg *types.MsgFreezeHolderRequest) (*types.MsgFreezeHolderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := k.Keeper.FreezeHolder(ctx, msg.GetSigners()[0], msg.Denom, holder); err != nil {
+		ctx.Logger().Error("unable to freeze marker holder", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFreezeHolder,
+			sdk.NewAttribute(types.EventAttributeHolderKey, msg.Holder),
+			sdk.NewAttribute(types.EventAttributeDenomKey, msg.Denom),
+			sdk.NewAttribute(types.EventAttributeAdministratorKey, msg.Administrator),
+			sdk.NewAttribute(types.EventAttributeModuleNameKey, types.ModuleName),
+		),
+	)
+	return &types.MsgFreezeHolderResponse{}, nil
+}
+
+// UnfreezeHolder handles a message to unfreeze the balance of a marker coin held by an account
+func (k msgServer) UnfreezeHolder(goCtx context.Context, msg *types.MsgUnfreezeHolderRequest) (*types.MsgUnfreezeHolderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := k.Keeper.UnfreezeHolder(ctx, msg.GetSigners()[0], msg.Denom, holder); err != nil {
+		ctx.Logger().Error("unable to unfreeze marker holder", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnfreezeHolder,
+			sdk.NewAttribute(types.EventAttributeHolderKey, msg.Holder),
+			sdk.NewAttribute(types.EventAttributeDenomKey, msg.Denom),
+			sdk.NewAttribute(types.EventAttributeAdministratorKey, msg.Administrator),
+			sdk.NewAttribute(types.EventAttributeModuleNameKey, types.ModuleName),
+		),
+	)
+	return &types.MsgUnfreezeHolderResponse{}, nil
+}
+
+// ForceTransfer handles a message to recover restricted coin from a holder without the signature of the holder.
+func (k msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForceTransferRequest) (*types.MsgForceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.ForceTransfer(ctx, from, to, msg.GetSigners()[0], msg.Amount, msg.Reason); err != nil {
+		ctx.Logger().Error("unable to force transfer marker coin", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+	return &types.MsgForceTransferResponse{}, nil
+}
+
+// SetMintSchedule handles a message to replace the scheduled supply increases of a marker
+func (k msgServer) SetMintSchedule(goCtx context.Context, msg *types.MsgSetMintScheduleRequest) (*types.MsgSetMintScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.Keeper.SetMintSchedule(ctx, msg.GetSigners()[0], msg.Denom, msg.Entries); err != nil {
+		ctx.Logger().Error("unable to set marker mint schedule", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetMintSchedule,
+			sdk.NewAttribute(types.EventAttributeDenomKey, msg.Denom),
+			sdk.NewAttribute(types.EventAttributeAdministratorKey, msg.Administrator),
+			sdk.NewAttribute(types.EventAttributeModuleNameKey, types.ModuleName),
+		),
+	)
+	return &types.MsgSetMintScheduleResponse{}, nil
+}
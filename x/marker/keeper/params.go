@@ -1,15 +1,36 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
 )
 
-// GetParams returns the total set of distribution parameters.
+// GetParams returns the total set of marker parameters.  The deprecated max total supply is not stored, it is reported
+// from the max supply for clients that still read it.
 func (k Keeper) GetParams(clientCtx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(clientCtx, &params)
-	return params
+	return types.NewParams(params.MaxSupply, params.EnableGovernance, params.UnrestrictedDenomRegex)
+}
+
+// MigrateParams stores the max supply param using the value of the deprecated max total supply if it is not yet set.
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyMaxSupply) {
+		return
+	}
+	var params types.Params
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+	// the max total supply is no longer registered in the param key table so it is decoded the way the subspace would.
+	if bz := k.paramSpace.GetRaw(ctx, types.ParamStoreKeyMaxTotalSupply); bz != nil {
+		if err := codec.NewLegacyAmino().UnmarshalJSON(bz, &params.MaxTotalSupply); err != nil { // nolint:staticcheck
+			panic(err)
+		}
+	}
+	params.MigrateMaxSupply()
+	k.SetParams(ctx, params)
 }
 
 // SetParams sets the distribution parameters to the param space.
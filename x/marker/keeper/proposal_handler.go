@@ -99,7 +99,7 @@ func HandleSupplyIncreaseProposal(ctx sdk.Context, k Keeper, c *types.SupplyIncr
 			return err
 		}
 
-		if err := k.bankKeeper.InputOutputCoins(ctx, []banktypes.Input{banktypes.NewInput(addr, sdk.NewCoins(c.Amount))},
+		if err := k.bankKeeper.InputOutputCoins(withoutSendRestrictions(ctx), []banktypes.Input{banktypes.NewInput(addr, sdk.NewCoins(c.Amount))},
 			[]banktypes.Output{banktypes.NewOutput(recipient, sdk.NewCoins(c.Amount))}); err != nil {
 			return err
 		}
@@ -257,6 +257,7 @@ func HandleChangeStatusProposal(ctx sdk.Context, k Keeper, c *types.ChangeStatus
 	}
 
 	k.SetMarker(ctx, m)
+	k.MarkSupplyChanged(ctx, m.GetAddress())
 
 	logger := k.Logger(ctx)
 	logger.Info("changed marker status", "marker", c.Denom, "stats", c.NewStatus.String())
@@ -286,7 +287,7 @@ func HandleWithdrawEscrowProposal(ctx sdk.Context, k Keeper, c *types.WithdrawEs
 		return err
 	}
 
-	if err := k.bankKeeper.InputOutputCoins(ctx, []banktypes.Input{banktypes.NewInput(addr, c.Amount)},
+	if err := k.bankKeeper.InputOutputCoins(withoutSendRestrictions(ctx), []banktypes.Input{banktypes.NewInput(addr, c.Amount)},
 		[]banktypes.Output{banktypes.NewOutput(recipient, c.Amount)}); err != nil {
 		return err
 	}
@@ -296,3 +297,31 @@ func HandleWithdrawEscrowProposal(ctx sdk.Context, k Keeper, c *types.WithdrawEs
 
 	return nil
 }
+
+// HandleSetMintScheduleProposal handles a governance proposal request to replace the scheduled supply increases of a
+// marker
+func HandleSetMintScheduleProposal(ctx sdk.Context, k Keeper, c *types.SetMintScheduleProposal) error {
+	addr, err := types.MarkerAddress(c.Denom)
+	if err != nil {
+		return err
+	}
+	m, err := k.GetMarker(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return fmt.Errorf("%s marker does not exist", c.Denom)
+	}
+	if !m.HasGovernanceEnabled() {
+		return fmt.Errorf("%s marker does not allow governance control", c.Denom)
+	}
+
+	if err := k.setMintSchedule(ctx, m, "", c.Entries); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("marker mint schedule updated", "marker", c.Denom, "entries", len(c.Entries))
+
+	return nil
+}
@@ -1,12 +1,14 @@
 package keeper
 
 import (
+	"math"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
@@ -139,13 +141,16 @@ func queryHolders(ctx sdk.Context, _ []string, req abci.RequestQuery, keeper Kee
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	holders := keeper.GetAllMarkerHolders(ctx, params.Denom)
-
-	start, end := client.Paginate(len(holders), params.Page, params.Limit, len(holders))
-	if start < 0 || end < 0 {
-		holders = []types.Balance{}
-	} else {
-		holders = holders[start:end]
+	// pages start at one, without a limit all holders are returned.
+	holders := []types.Balance{}
+	if params.Page > 0 {
+		pageRequest := &query.PageRequest{Limit: math.MaxUint64}
+		if params.Limit > 0 {
+			pageRequest = &query.PageRequest{Offset: uint64((params.Page - 1) * params.Limit), Limit: uint64(params.Limit)}
+		}
+		if holders, _, err = keeper.GetMarkerHolders(ctx, params.Denom, pageRequest); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
 	}
 
 	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, holders)
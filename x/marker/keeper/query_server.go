@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -15,8 +14,6 @@ import (
 	"github.com/provenance-io/provenance/x/marker/types"
 )
 
-const defaultLimit = 100
-
 var _ types.QueryServer = Keeper{}
 
 // Params queries params of distribution module
@@ -81,31 +78,14 @@ func (k Keeper) Holding(c context.Context, req *types.QueryHoldingRequest) (*typ
 	if err != nil {
 		return nil, err
 	}
-	pageRequest := req.Pagination
-	// if the PageRequest is nil, use default PageRequest
-	if pageRequest == nil {
-		pageRequest = &query.PageRequest{}
-	}
-
-	balances := k.GetAllMarkerHolders(ctx, marker.GetDenom())
-	limit := pageRequest.Limit
-	if limit == 0 {
-		limit = defaultLimit
-	}
-	end := pageRequest.Offset + limit
-	totalResults := uint64(len(balances))
-
-	if pageRequest.Offset > totalResults {
-		return nil, fmt.Errorf("invalid offset")
-	}
-
-	if end > totalResults {
-		end = totalResults
+	balances, pageRes, err := k.GetMarkerHolders(ctx, marker.GetDenom(), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	return &types.QueryHoldingResponse{
-		Balances:   balances[pageRequest.Offset:end],
-		Pagination: &query.PageResponse{Total: totalResults},
+		Balances:   balances,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -166,3 +146,67 @@ func (k Keeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRe
 
 	return &types.QueryDenomMetadataResponse{Metadata: metadata}, nil
 }
+
+// FrozenHolders query for all accounts frozen for the given marker coin
+func (k Keeper) FrozenHolders(c context.Context, req *types.QueryFrozenHoldersRequest) (*types.QueryFrozenHoldersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	addresses, pageRes, err := k.GetFrozenHolders(ctx, marker.GetDenom(), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryFrozenHoldersResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+// FrozenStatus query for whether an account is frozen for the given marker coin
+func (k Keeper) FrozenStatus(c context.Context, req *types.QueryFrozenStatusRequest) (*types.QueryFrozenStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFrozenStatusResponse{Frozen: k.IsFrozen(ctx, marker.GetDenom(), addr)}, nil
+}
+
+// MintSchedule query for the pending scheduled supply increases of a marker
+func (k Keeper) MintSchedule(c context.Context, req *types.QueryMintScheduleRequest) (*types.QueryMintScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	schedule := k.GetMintSchedule(ctx, marker.GetAddress())
+	if schedule == nil {
+		schedule = &types.MintSchedule{Denom: marker.GetDenom(), Entries: []types.MintScheduleEntry{}}
+	}
+	return &types.QueryMintScheduleResponse{Schedule: *schedule}, nil
+}
+
+// AllMintSchedules query for the pending scheduled supply increases of all markers
+func (k Keeper) AllMintSchedules(c context.Context, req *types.QueryAllMintSchedulesRequest) (*types.QueryAllMintSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	schedules, pageRes, err := k.GetMintSchedules(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryAllMintSchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// MarkSupplyChanged flags a marker for supply reconciliation at the start of the next block.
+func (k Keeper) MarkSupplyChanged(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SupplyChangedKey(addr), addr)
+}
+
+// IterateSupplyChangedMarkers processes the address of each marker flagged for supply reconciliation.
+func (k Keeper) IterateSupplyChangedMarkers(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SupplyChangedKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(iterator.Value()) {
+			break
+		}
+	}
+}
+
+// ReconcileSupply checks the markers flagged as having a supply change and mints or burns coin for any active fixed
+// supply marker whose circulation does not match its required supply.  The flags are cleared once processed.  Markers
+// are flagged by the marker keeper and by the bank keeper wrapper whenever coin of the marker is minted or burned.
+func (k Keeper) ReconcileSupply(ctx sdk.Context) error {
+	var addrs []sdk.AccAddress
+	k.IterateSupplyChangedMarkers(ctx, func(addr sdk.AccAddress) bool {
+		addrs = append(addrs, addr)
+		return false
+	})
+	if len(addrs) == 0 {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	total := k.bankKeeper.GetSupply(ctx).GetTotal()
+	for _, addr := range addrs {
+		store.Delete(types.SupplyChangedKey(addr))
+		record, err := k.GetMarker(ctx, addr)
+		if err != nil {
+			return err
+		}
+		// Supply checks are only done against active markers with a fixed supply.
+		if record == nil || record.GetStatus() != types.StatusActive || !record.HasFixedSupply() {
+			continue
+		}
+		requiredSupply := record.GetSupply()
+		// If the current amount of marker coin in circulation doesn't match configured supply, make adjustments
+		if !requiredSupply.Amount.Equal(total.AmountOf(record.GetDenom())) {
+			if err = k.AdjustCirculation(ctx, record, requiredSupply); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
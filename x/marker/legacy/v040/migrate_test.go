@@ -48,6 +48,7 @@ func TestMigrate(t *testing.T) {
 
 	migrated := v040.Migrate(gs)
 	expected := fmt.Sprintf(`{
+  "frozen_holders": [],
   "markers": [
     {
       "access_control": [
@@ -60,6 +61,7 @@ func TestMigrate(t *testing.T) {
           ]
         }
       ],
+      "allow_forced_transfer": false,
       "allow_governance_control": false,
       "base_account": {
         "account_number": "5",
@@ -70,13 +72,16 @@ func TestMigrate(t *testing.T) {
       "denom": "hotdog",
       "manager": "%s",
       "marker_type": "MARKER_TYPE_COIN",
+      "required_attributes": [],
       "status": "MARKER_STATUS_ACTIVE",
       "supply": "1",
       "supply_fixed": false
     }
   ],
+  "mint_schedules": [],
   "params": {
     "enable_governance": true,
+    "max_supply": "100000000000",
     "max_total_supply": "100000000000",
     "unrestricted_denom_regex": "[a-zA-Z][a-zA-Z0-9/]{2,64}"
   }
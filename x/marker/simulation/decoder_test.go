@@ -0,0 +1,66 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/simulation"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	markerAddr := types.MustGetMarkerAddress("testcoin")
+	holderAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	schedule := types.MintSchedule{
+		Denom:   "testcoin",
+		Entries: []types.MintScheduleEntry{types.NewMintScheduleEntry(time.Unix(1000, 0).UTC(), sdk.NewInt(100), "")},
+	}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.MarkerStoreKey(markerAddr), Value: markerAddr.Bytes()},
+			{Key: types.SupplyChangedKey(markerAddr), Value: markerAddr.Bytes()},
+			{Key: types.HolderKey("testcoin", holderAddr), Value: []byte{0x01}},
+			{Key: types.FrozenKey("testcoin", holderAddr), Value: []byte{0x01}},
+			{Key: types.MintScheduleKey(markerAddr), Value: cdc.MustMarshalBinaryBare(&schedule)},
+			{Key: types.MintScheduleDueKey(schedule.Entries[0].Time, markerAddr), Value: markerAddr.Bytes()},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Marker", fmt.Sprintf("%v\n%v", markerAddr, markerAddr)},
+		{"SupplyChanged", fmt.Sprintf("%v\n%v", markerAddr, markerAddr)},
+		{"Holder", fmt.Sprintf("%v\n%v", []byte{0x01}, []byte{0x01})},
+		{"Frozen", fmt.Sprintf("%v\n%v", []byte{0x01}, []byte{0x01})},
+		{"MintSchedule", fmt.Sprintf("%v\n%v", schedule, schedule)},
+		{"MintScheduleDue", fmt.Sprintf("%v\n%v", markerAddr, markerAddr)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// Simulation parameter constants
+const (
+	EnableGovernance       = "enable_governance"
+	MaxSupply              = "max_supply"
+	UnrestrictedDenomRegex = "unrestricted_denom_regex"
+)
+
+// GenEnableGovernance returns a randomized EnableGovernance parameter.
+func GenEnableGovernance(r *rand.Rand) bool {
+	return r.Int63n(101) <= 50 // 50% chance of governance control being enabled
+}
+
+// GenMaxSupply randomized Max Supply, scaled by up to 18 decimal places to exercise supply limits beyond a uint64
+func GenMaxSupply(r *rand.Rand) sdk.Int {
+	base := sdk.NewInt(r.Int63n(types.DefaultMaxTotalSupply) + 1000000000) // always leaves room for the simulated markers
+	return base.Mul(sdk.NewIntWithDecimal(1, r.Intn(19)))
+}
+
+// GenUnrestrictedDenomRegex returns a randomized denom validation expression that always accepts the simulated denoms
+func GenUnrestrictedDenomRegex(r *rand.Rand) string {
+	return fmt.Sprintf(`[a-zA-Z][a-zA-Z0-9/]{%d,64}`, r.Intn(8)+2)
+}
+
+// GenMarkerDenom returns a random lower case denom for a simulated marker
+func GenMarkerDenom(r *rand.Rand) string {
+	return "sim" + strings.ToLower(simtypes.RandStringOfLength(r, 10))
+}
+
+// GenMarkers returns a randomized set of active markers with all access granted to one of the simulation accounts
+func GenMarkers(r *rand.Rand, accs []simtypes.Account) []types.MarkerAccount {
+	markers := make([]types.MarkerAccount, r.Intn(4))
+	for i := range markers {
+		denom := GenMarkerDenom(r)
+		admin, _ := simtypes.RandomAcc(r, accs)
+		markers[i] = *types.NewMarkerAccount(
+			authtypes.NewBaseAccount(types.MustGetMarkerAddress(denom), nil, uint64(len(accs)+i), 0),
+			sdk.NewInt64Coin(denom, int64(simtypes.RandIntBetween(r, 1000, 1000000))),
+			nil,
+			[]types.AccessGrant{*types.NewAccessGrant(admin.Address, allAccess())},
+			types.StatusActive,
+			randomMarkerType(r),
+		)
+	}
+	return markers
+}
+
+// allAccess returns every access type that can be granted on a marker
+func allAccess() types.AccessList {
+	return types.AccessList{
+		types.Access_Mint, types.Access_Burn, types.Access_Deposit, types.Access_Withdraw,
+		types.Access_Delete, types.Access_Admin, types.Access_Transfer, types.Access_Freeze,
+		types.Access_ForceTransfer,
+	}
+}
+
+// RandomizedGenState generates a random GenesisState for marker
+func RandomizedGenState(simState *module.SimulationState) {
+	var enableGovernance bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EnableGovernance, &enableGovernance, simState.Rand,
+		func(r *rand.Rand) { enableGovernance = GenEnableGovernance(r) },
+	)
+
+	var maxSupply sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxSupply, &maxSupply, simState.Rand,
+		func(r *rand.Rand) { maxSupply = GenMaxSupply(r) },
+	)
+
+	var unrestrictedDenomRegex string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, UnrestrictedDenomRegex, &unrestrictedDenomRegex, simState.Rand,
+		func(r *rand.Rand) { unrestrictedDenomRegex = GenUnrestrictedDenomRegex(r) },
+	)
+
+	markerGenesis := types.NewGenesisState(
+		types.NewParams(maxSupply, enableGovernance, unrestrictedDenomRegex),
+		GenMarkers(simState.Rand, simState.Accounts),
+	)
+
+	bz, err := json.MarshalIndent(&markerGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated marker parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(markerGenesis)
+}
@@ -0,0 +1,715 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgAddMarker        = "op_weight_msg_add_marker"
+	OpWeightMsgAddAccess        = "op_weight_msg_add_access"
+	OpWeightMsgDeleteAccess     = "op_weight_msg_delete_access"
+	OpWeightMsgFinalize         = "op_weight_msg_finalize"
+	OpWeightMsgActivate         = "op_weight_msg_activate"
+	OpWeightMsgCancel           = "op_weight_msg_cancel"
+	OpWeightMsgDelete           = "op_weight_msg_delete"
+	OpWeightMsgMint             = "op_weight_msg_mint"
+	OpWeightMsgBurn             = "op_weight_msg_burn"
+	OpWeightMsgWithdraw         = "op_weight_msg_withdraw"
+	OpWeightMsgTransfer         = "op_weight_msg_transfer"
+	OpWeightMsgSetDenomMetadata = "op_weight_msg_set_denom_metadata"
+	OpWeightMsgFreezeHolder     = "op_weight_msg_freeze_holder"
+	OpWeightMsgUnfreezeHolder   = "op_weight_msg_unfreeze_holder"
+	OpWeightMsgForceTransfer    = "op_weight_msg_force_transfer"
+	OpWeightMsgSetMintSchedule  = "op_weight_msg_set_mint_schedule"
+)
+
+// Default simulation operation weights, markers are created often enough for the other operations to find one.
+const (
+	DefaultWeightMsgAddMarker        = 100
+	DefaultWeightMsgAddAccess        = 30
+	DefaultWeightMsgDeleteAccess     = 10
+	DefaultWeightMsgFinalize         = 50
+	DefaultWeightMsgActivate         = 50
+	DefaultWeightMsgCancel           = 10
+	DefaultWeightMsgDelete           = 10
+	DefaultWeightMsgMint             = 50
+	DefaultWeightMsgBurn             = 30
+	DefaultWeightMsgWithdraw         = 50
+	DefaultWeightMsgTransfer         = 50
+	DefaultWeightMsgSetDenomMetadata = 20
+	DefaultWeightMsgFreezeHolder     = 10
+	DefaultWeightMsgUnfreezeHolder   = 10
+	DefaultWeightMsgForceTransfer    = 20
+	DefaultWeightMsgSetMintSchedule  = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler, k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simulation.WeightedOperations {
+	weight := func(key string, defaultWeight int) int {
+		var w int
+		appParams.GetOrGenerate(cdc, key, &w, nil, func(_ *rand.Rand) { w = defaultWeight })
+		return w
+	}
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddMarker, DefaultWeightMsgAddMarker),
+			SimulateMsgAddMarker(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddAccess, DefaultWeightMsgAddAccess),
+			SimulateMsgAddAccess(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgDeleteAccess, DefaultWeightMsgDeleteAccess),
+			SimulateMsgDeleteAccess(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgFinalize, DefaultWeightMsgFinalize),
+			SimulateMsgFinalize(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgActivate, DefaultWeightMsgActivate),
+			SimulateMsgActivate(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgCancel, DefaultWeightMsgCancel),
+			SimulateMsgCancel(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgDelete, DefaultWeightMsgDelete),
+			SimulateMsgDelete(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgMint, DefaultWeightMsgMint),
+			SimulateMsgMint(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgBurn, DefaultWeightMsgBurn),
+			SimulateMsgBurn(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgWithdraw, DefaultWeightMsgWithdraw),
+			SimulateMsgWithdraw(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgTransfer, DefaultWeightMsgTransfer),
+			SimulateMsgTransfer(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSetDenomMetadata, DefaultWeightMsgSetDenomMetadata),
+			SimulateMsgSetDenomMetadata(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgFreezeHolder, DefaultWeightMsgFreezeHolder),
+			SimulateMsgFreezeHolder(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgUnfreezeHolder, DefaultWeightMsgUnfreezeHolder),
+			SimulateMsgUnfreezeHolder(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgForceTransfer, DefaultWeightMsgForceTransfer),
+			SimulateMsgForceTransfer(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSetMintSchedule, DefaultWeightMsgSetMintSchedule),
+			SimulateMsgSetMintSchedule(k, ak, bk),
+		),
+	}
+}
+
+// SimulateMsgAddMarker creates a proposed marker managed by a random account that is granted all access.
+func SimulateMsgAddMarker(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		denom := GenMarkerDenom(r)
+		if _, err := k.GetMarkerByDenom(ctx, denom); err == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeAddMarkerRequest, "marker already exists"), nil, nil
+		}
+
+		msg := types.NewAddMarkerRequest(
+			denom,
+			sdk.NewInt(int64(simtypes.RandIntBetween(r, 1000, 1000000))),
+			simAccount.Address,
+			simAccount.Address,
+			randomMarkerType(r),
+		)
+		msg.AccessList = []types.AccessGrant{*types.NewAccessGrant(simAccount.Address, allAccess())}
+		msg.SupplyFixed = r.Intn(2) == 0
+		msg.AllowGovernanceControl = r.Intn(2) == 0
+		msg.AllowForcedTransfer = msg.MarkerType == types.MarkerType_RestrictedCoin && r.Intn(2) == 0
+
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, simAccount)
+	}
+}
+
+// SimulateMsgAddAccess grants random access on a marker to a random account.
+func SimulateMsgAddAccess(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		m, admin, found := randomMarkerWithAdmin(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeAddAccessRequest, "no marker available to administer"), nil, nil
+		}
+		grantee, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewAddAccessRequest(m.GetDenom(), admin.Address, *types.NewAccessGrant(grantee.Address, randomAccess(r)))
+
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, admin)
+	}
+}
+
+// SimulateMsgDeleteAccess revokes the access of an account (other than the administrator) on a marker.
+func SimulateMsgDeleteAccess(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		m, admin, found := randomMarkerWithAdmin(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeDeleteAccessRequest, "no marker available to administer"), nil, nil
+		}
+		var removable []sdk.AccAddress
+		for _, grant := range m.GetAccessList() {
+			if grant.Address != admin.Address.String() {
+				removable = append(removable, grant.GetAddress())
+			}
+		}
+		if len(removable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeDeleteAccessRequest, "no access grant to remove"), nil, nil
+		}
+
+		removed := removable[r.Intn(len(removable))]
+		// the marker must remain valid without the removed grant (i.e. someone is left with mint access)
+		if err := m.RevokeAccess(removed); err != nil || m.Validate() != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeDeleteAccessRequest, "marker requires the access grant"), nil, nil
+		}
+
+		msg := types.NewDeleteAccessRequest(m.GetDenom(), admin.Address, removed)
+
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, admin)
+	}
+}
+
+// SimulateMsgFinalize finalizes a proposed marker by its manager.
+func SimulateMsgFinalize(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		m, manager, found := randomManagedMarker(r, ctx, k, accs, types.StatusProposed)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeFinalizeRequest, "no proposed marker available"), nil, nil
+		}
+
+		msg := types.NewFinalizeRequest(m.GetDenom(), manager.Address)
+
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, manager)
+	}
+}
+
+// SimulateMsgActivate activates a finalized marker by its manager.
+func SimulateMsgActivate(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		m, manager, found := randomManagedMarker(r, ctx, k, accs, types.StatusFinalized)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeActivateRequest, "no finalized marker available"), nil, nil
+		}
+
+		msg := types.NewActivateRequest(m.GetDenom(), manager.Address)
+
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, manager)
+	}
+}
+
+// SimulateMsgCancel cancels a marker that holds its entire supply using an account with delete access.
+func SimulateMsgCancel(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+			switch m.GetStatus() {
+			case types.StatusProposed:
+				return true
+			case types.StatusFinalized, types.StatusActive:
+				return holdsEntireSupply(ctx, bk, m)
+			default:
+				return false
+			}
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeCancelRequest, "no marker available to cancel"), nil, nil
+		}
+		admin, found := randomAccountWithAccess(r, accs, m, types.Access_Delete)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeCancelRequest, "no account with delete access"), nil, nil
+		}
+
+		msg := types.NewCancelRequest(m.GetDenom(), admin.Address)
+
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, admin)
+	}
+}
+
+// SimulateMsgDelete destroys a cancelled marker using an account with delete access.
+func SimulateMsgDelete(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+			// a marker without mint access can not be left with the zero supply remaining after the burn
+			return m.GetStatus() == types.StatusCancelled && holdsEntireSupply(ctx, bk, m) &&
+				len(m.AddressListForPermission(types.Access_Mint)) > 0
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeDeleteRequest, "no cancelled marker available"), nil, nil
+		}
+		admin, found := randomAccountWithAccess(r, accs, m, types.Access_Delete)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeDeleteRequest, "no account with delete access"), nil, nil
+		}
+
+		msg := types.NewDeleteRequest(m.GetDenom(), admin.Address)
+
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, admin)
+	}
+}
+
+// SimulateMsgMint mints a random amount of a marker's coin using an account with mint access.
+func SimulateMsgMint(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+			return m.GetStatus() <= types.StatusActive
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMintRequest, "no marker available to mint"), nil, nil
+		}
+		admin, found := randomAccountWithAccess(r, accs, m, types.Access_Mint)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMintRequest, "no account with mint access"), nil, nil
+		}
+
+		msg := types.NewMintRequest(admin.Address, sdk.NewInt64Coin(m.GetDenom(), int64(simtypes.RandIntBetween(r, 1, 1000))))
+
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, admin)
+	}
+}
+
+// SimulateMsgBurn burns a random amount of the coin held by an active marker using an account with burn access.
+func SimulateMsgBurn(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+			return m.GetStatus() == types.StatusActive
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeBurnRequest, "no active marker available"), nil, nil
+		}
+		admin, found := randomAccountWithAccess(r, accs, m, types.Access_Burn)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeBurnRequest, "no account with burn access"), nil, nil
+		}
+		amount, err := simtypes.RandPositiveInt(r, bk.GetBalance(ctx, m.GetAddress(), m.GetDenom()).Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeBurnRequest, "marker does not hold any coin to burn"), nil, nil
+		}
+
+		msg := types.NewBurnRequest(admin.Address, sdk.NewCoin(m.GetDenom(), amount))
+
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, admin)
+	}
+}
+
+// SimulateMsgWithdraw withdraws a random amount of an active marker's coin to a random account.
+func SimulateMsgWithdraw(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+			return m.GetStatus() == types.StatusActive
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeWithdrawRequest, "no active marker available"), nil, nil
+		}
+		admin, found := randomAccountWithAccess(r, accs, m, types.Access_Withdraw)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeWithdrawRequest, "no account with withdraw access"), nil, nil
+		}
+		amount, err := simtypes.RandPositiveInt(r, bk.GetBalance(ctx, m.GetAddress(), m.GetDenom()).Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeWithdrawRequest, "marker does not hold any coin"), nil, nil
+		}
+		recipient, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewWithdrawRequest(admin.Address, recipient.Address, m.GetDenom(), sdk.NewCoins(sdk.NewCoin(m.GetDenom(), amount)))
+
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, admin)
+	}
+}
+
+// SimulateMsgTransfer moves restricted coin between two accounts using an account with transfer access.
+func SimulateMsgTransfer(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+			return m.GetStatus() == types.StatusActive && m.GetMarkerType() == types.MarkerType_RestrictedCoin
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeTransferRequest, "no active restricted marker available"), nil, nil
+		}
+		admin, found := randomAccountWithAccess(r, accs, m, types.Access_Transfer)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeTransferRequest, "no account with transfer access"), nil, nil
+		}
+		// the administrator pays the fees, possibly in the restricted coin, so it is never the source of the transfer
+		var holders []simtypes.Account
+		for _, acc := range accs {
+			if !acc.Address.Equals(admin.Address) && bk.GetBalance(ctx, acc.Address, m.GetDenom()).IsPositive() {
+				holders = append(holders, acc)
+			}
+		}
+		if len(holders) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeTransferRequest, "no account holds the restricted coin"), nil, nil
+		}
+		from := holders[r.Intn(len(holders))]
+		amount, err := simtypes.RandPositiveInt(r, bk.GetBalance(ctx, from.Address, m.GetDenom()).Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeTransferRequest, err.Error()), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewTransferRequest(admin.Address, from.Address, to.Address, sdk.NewCoin(m.GetDenom(), amount))
+
+		// the source account must sign the transfer as well as the administrator
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, admin, from)
+	}
+}
+
+// SimulateMsgSetDenomMetadata sets random denom metadata on a proposed marker by its manager.
+func SimulateMsgSetDenomMetadata(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		m, manager, found := randomManagedMarker(r, ctx, k, accs, types.StatusProposed)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeSetMetadataRequest, "no proposed marker available"), nil, nil
+		}
+		display := "m" + m.GetDenom()
+
+		msg := types.NewSetDenomMetadataRequest(
+			banktypes.Metadata{
+				Description: simtypes.RandStringOfLength(r, 50),
+				Base:        m.GetDenom(),
+				Display:     display,
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: m.GetDenom(), Exponent: 0},
+					{Denom: display, Exponent: uint32(r.Intn(18) + 1)},
+				},
+			},
+			manager.Address,
+		)
+
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, manager)
+	}
+}
+
+// SimulateMsgFreezeHolder freezes an account outside of the simulation for an active restricted marker using an
+// account with freeze access.  A frozen simulation account would fail the bank sends and fees of other operations.
+func SimulateMsgFreezeHolder(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+			return m.GetStatus() == types.StatusActive && m.GetMarkerType() == types.MarkerType_RestrictedCoin
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeFreezeHolderRequest, "no active restricted marker available"), nil, nil
+		}
+		admin, found := randomAccountWithAccess(r, accs, m, types.Access_Freeze)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeFreezeHolderRequest, "no account with freeze access"), nil, nil
+		}
+		holder := simtypes.RandomAccounts(r, 1)[0]
+
+		msg := types.NewFreezeHolderRequest(m.GetDenom(), admin.Address, holder.Address)
+
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, admin)
+	}
+}
+
+// SimulateMsgUnfreezeHolder unfreezes a random frozen holder using an account with freeze access on the marker.
+func SimulateMsgUnfreezeHolder(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var denoms []string
+		var holders []sdk.AccAddress
+		k.IterateFrozenHolders(ctx, func(denom string, addr sdk.AccAddress) bool {
+			denoms = append(denoms, denom)
+			holders = append(holders, addr)
+			return false
+		})
+		if len(holders) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeUnfreezeHolderRequest, "no frozen holder available"), nil, nil
+		}
+		i := r.Intn(len(holders))
+		m, err := k.GetMarkerByDenom(ctx, denoms[i])
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeUnfreezeHolderRequest, err.Error()), nil, nil
+		}
+		admin, found := randomAccountWithAccess(r, accs, m, types.Access_Freeze)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeUnfreezeHolderRequest, "no account with freeze access"), nil, nil
+		}
+
+		msg := types.NewUnfreezeHolderRequest(m.GetDenom(), admin.Address, holders[i])
+
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, admin)
+	}
+}
+
+// SimulateMsgForceTransfer recovers restricted coin from a holder without its signature using an account with force
+// transfer access.
+func SimulateMsgForceTransfer(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+			return m.GetStatus() == types.StatusActive && m.AllowsForcedTransfer()
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeForceTransferRequest, "no marker allows forced transfers"), nil, nil
+		}
+		admin, found := randomAccountWithAccess(r, accs, m, types.Access_ForceTransfer)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeForceTransferRequest, "no account with force transfer access"), nil, nil
+		}
+		// the administrator pays the fees, possibly in the restricted coin, so it is never the source of the transfer
+		var holders []simtypes.Account
+		for _, acc := range accs {
+			if !acc.Address.Equals(admin.Address) && bk.GetBalance(ctx, acc.Address, m.GetDenom()).IsPositive() {
+				holders = append(holders, acc)
+			}
+		}
+		if len(holders) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeForceTransferRequest, "no account holds the restricted coin"), nil, nil
+		}
+		from := holders[r.Intn(len(holders))]
+		amount, err := simtypes.RandPositiveInt(r, bk.GetBalance(ctx, from.Address, m.GetDenom()).Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeForceTransferRequest, err.Error()), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewForceTransferRequest(
+			admin.Address, from.Address, to.Address, sdk.NewCoin(m.GetDenom(), amount), simtypes.RandStringOfLength(r, 20),
+		)
+
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, admin)
+	}
+}
+
+// SimulateMsgSetMintSchedule schedules a few supply increases of an active marker using an account with mint access,
+// sending the coin to random accounts when it also holds withdraw access.
+func SimulateMsgSetMintSchedule(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+			return m.GetStatus() == types.StatusActive
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeSetMintScheduleRequest, "no active marker available"), nil, nil
+		}
+		admin, found := randomAccountWithAccess(r, accs, m, types.Access_Mint)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeSetMintScheduleRequest, "no account with mint access"), nil, nil
+		}
+		entries := make([]types.MintScheduleEntry, simtypes.RandIntBetween(r, 1, 4))
+		for i := range entries {
+			var toAddress string
+			if m.AddressHasAccess(admin.Address, types.Access_Withdraw) && r.Intn(2) == 0 {
+				to, _ := simtypes.RandomAcc(r, accs)
+				toAddress = to.Address.String()
+			}
+			entries[i] = types.NewMintScheduleEntry(
+				ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 1, 3600))*time.Second),
+				sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1000))),
+				toAddress,
+			)
+		}
+
+		msg := types.NewSetMintScheduleRequest(m.GetDenom(), admin.Address, entries)
+
+		return Dispatch(r, app, ctx, ak, bk, chainID, msg, admin)
+	}
+}
+
+// Dispatch sends an operation to the chain signed by the given accounts, the first of which pays a random fee.
+func Dispatch(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak authkeeper.AccountKeeper,
+	bk bankkeeper.Keeper,
+	chainID string,
+	msg sdk.Msg,
+	signers ...simtypes.Account,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	accountNumbers := make([]uint64, len(signers))
+	sequences := make([]uint64, len(signers))
+	privKeys := make([]cryptotypes.PrivKey, len(signers))
+	for i, signer := range signers {
+		account := ak.GetAccount(ctx, signer.Address)
+		if account == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "signer account not found"), nil, nil
+		}
+		accountNumbers[i] = account.GetAccountNumber()
+		sequences[i] = account.GetSequence()
+		privKeys[i] = signer.PrivKey
+	}
+
+	fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, signers[0].Address))
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		accountNumbers,
+		sequences,
+		privKeys...,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
+
+// randomMarker returns a random marker matching the filter.
+func randomMarker(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, filter func(types.MarkerAccountI) bool,
+) (types.MarkerAccountI, bool) {
+	var markers []types.MarkerAccountI
+	k.IterateMarkers(ctx, func(m types.MarkerAccountI) bool {
+		if filter(m) {
+			markers = append(markers, m)
+		}
+		return false
+	})
+	if len(markers) == 0 {
+		return nil, false
+	}
+	return markers[r.Intn(len(markers))], true
+}
+
+// randomManagedMarker returns a random marker in the given status along with its manager's simulation account.
+func randomManagedMarker(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, status types.MarkerStatus,
+) (types.MarkerAccountI, simtypes.Account, bool) {
+	m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+		if m.GetStatus() != status {
+			return false
+		}
+		_, managed := simtypes.FindAccount(accs, m.GetManager())
+		return managed
+	})
+	if !found {
+		return nil, simtypes.Account{}, false
+	}
+	manager, _ := simtypes.FindAccount(accs, m.GetManager())
+	return m, manager, true
+}
+
+// randomMarkerWithAdmin returns a random marker along with an account allowed to change its access list.
+func randomMarkerWithAdmin(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account,
+) (types.MarkerAccountI, simtypes.Account, bool) {
+	m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+		return m.GetStatus() <= types.StatusActive
+	})
+	if !found {
+		return nil, simtypes.Account{}, false
+	}
+	if m.GetStatus() == types.StatusProposed {
+		manager, found := simtypes.FindAccount(accs, m.GetManager())
+		return m, manager, found
+	}
+	admin, found := randomAccountWithAccess(r, accs, m, types.Access_Admin)
+	return m, admin, found
+}
+
+// randomAccountWithAccess returns a random simulation account that holds the access on the marker.
+func randomAccountWithAccess(
+	r *rand.Rand, accs []simtypes.Account, m types.MarkerAccountI, access types.Access,
+) (simtypes.Account, bool) {
+	var granted []simtypes.Account
+	for _, addr := range m.AddressListForPermission(access) {
+		if acc, found := simtypes.FindAccount(accs, addr); found {
+			granted = append(granted, acc)
+		}
+	}
+	if len(granted) == 0 {
+		return simtypes.Account{}, false
+	}
+	return granted[r.Intn(len(granted))], true
+}
+
+// holdsEntireSupply returns true if no coin of the marker is in circulation outside of the marker account.
+func holdsEntireSupply(ctx sdk.Context, bk bankkeeper.Keeper, m types.MarkerAccountI) bool {
+	total := bk.GetSupply(ctx).GetTotal().AmountOf(m.GetDenom())
+	return bk.GetBalance(ctx, m.GetAddress(), m.GetDenom()).Amount.Equal(total)
+}
+
+// randomMarkerType returns either the coin or restricted coin marker type.
+func randomMarkerType(r *rand.Rand) types.MarkerType {
+	if r.Intn(2) == 0 {
+		return types.MarkerType_RestrictedCoin
+	}
+	return types.MarkerType_Coin
+}
+
+// randomAccess returns a random non-empty set of access types.
+func randomAccess(r *rand.Rand) types.AccessList {
+	access := allAccess()
+	r.Shuffle(len(access), func(i, j int) { access[i], access[j] = access[j], access[i] })
+	return access[:r.Intn(len(access))+1]
+}
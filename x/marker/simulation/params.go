@@ -0,0 +1,36 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyEnableGovernance),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%v", GenEnableGovernance(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyUnrestrictedDenomRegex),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenUnrestrictedDenomRegex(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyMaxSupply),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMaxSupply(r))
+			},
+		),
+	}
+}
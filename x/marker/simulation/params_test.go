@@ -0,0 +1,37 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/provenance-io/provenance/x/marker/simulation"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestParamChanges(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	expected := []struct {
+		composedKey string
+		key         string
+		subspace    string
+	}{
+		{"marker/EnableGovernance", "EnableGovernance", types.ModuleName},
+		{"marker/UnrestrictedDenomRegex", "UnrestrictedDenomRegex", types.ModuleName},
+		{"marker/MaxSupply", "MaxSupply", types.ModuleName},
+	}
+
+	paramChanges := simulation.ParamChanges(r)
+
+	require.Len(t, paramChanges, 3)
+
+	for i, p := range paramChanges {
+		require.Equal(t, expected[i].composedKey, p.ComposedKey())
+		require.Equal(t, expected[i].key, p.Key())
+		require.Equal(t, expected[i].subspace, p.Subspace())
+		require.NotEmpty(t, p.SimValue()(r))
+	}
+}
@@ -0,0 +1,233 @@
+package simulation
+
+import (
+	"math/rand"
+
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightSubmitAddMarkerProposal           = "op_weight_submit_add_marker_proposal"
+	OpWeightSubmitSupplyIncreaseProposal      = "op_weight_submit_supply_increase_proposal"
+	OpWeightSubmitSupplyDecreaseProposal      = "op_weight_submit_supply_decrease_proposal"
+	OpWeightSubmitSetAdministratorProposal    = "op_weight_submit_set_administrator_proposal"
+	OpWeightSubmitRemoveAdministratorProposal = "op_weight_submit_remove_administrator_proposal"
+	OpWeightSubmitChangeStatusProposal        = "op_weight_submit_change_status_proposal"
+	OpWeightSubmitWithdrawEscrowProposal      = "op_weight_submit_withdraw_escrow_proposal"
+)
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper, bk bankkeeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitAddMarkerProposal,
+			simappparams.DefaultWeightTextProposal,
+			SimulateAddMarkerProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitSupplyIncreaseProposal,
+			simappparams.DefaultWeightTextProposal,
+			SimulateSupplyIncreaseProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitSupplyDecreaseProposal,
+			simappparams.DefaultWeightTextProposal,
+			SimulateSupplyDecreaseProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitSetAdministratorProposal,
+			simappparams.DefaultWeightTextProposal,
+			SimulateSetAdministratorProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitRemoveAdministratorProposal,
+			simappparams.DefaultWeightTextProposal,
+			SimulateRemoveAdministratorProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitChangeStatusProposal,
+			simappparams.DefaultWeightTextProposal,
+			SimulateChangeStatusProposalContent(k, bk),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitWithdrawEscrowProposal,
+			simappparams.DefaultWeightTextProposal,
+			SimulateWithdrawEscrowProposalContent(k),
+		),
+	}
+}
+
+// SimulateAddMarkerProposalContent generates random add-marker proposal content
+func SimulateAddMarkerProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		return types.NewAddMarkerProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			GenMarkerDenom(r),
+			sdk.NewInt(int64(simtypes.RandIntBetween(r, 1000, 1000000))),
+			simAccount.Address,
+			types.MarkerStatus(simtypes.RandIntBetween(r, int(types.StatusProposed), int(types.StatusActive)+1)),
+			randomMarkerType(r),
+			[]types.AccessGrant{*types.NewAccessGrant(simAccount.Address, allAccess())},
+			r.Intn(2) == 0,
+			true,
+		)
+	}
+}
+
+// SimulateSupplyIncreaseProposalContent generates random supply-increase proposal content
+func SimulateSupplyIncreaseProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+			return governedMarker(m) && m.GetStatus() <= types.StatusActive
+		})
+		if !found {
+			return nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		return types.NewSupplyIncreaseProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			sdk.NewInt64Coin(m.GetDenom(), int64(simtypes.RandIntBetween(r, 1, 1000))),
+			simAccount.Address.String(),
+		)
+	}
+}
+
+// SimulateSupplyDecreaseProposalContent generates random supply-decrease proposal content
+func SimulateSupplyDecreaseProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		// without mint access the marker can not be left with a zero supply
+		m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+			return governedMarker(m) && m.GetStatus() == types.StatusActive &&
+				len(m.AddressListForPermission(types.Access_Mint)) > 0
+		})
+		if !found {
+			return nil
+		}
+		amount, err := simtypes.RandPositiveInt(r, k.GetEscrow(ctx, m).AmountOf(m.GetDenom()))
+		if err != nil {
+			return nil
+		}
+
+		return types.NewSupplyDecreaseProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			sdk.NewCoin(m.GetDenom(), amount),
+		)
+	}
+}
+
+// SimulateSetAdministratorProposalContent generates random set-administrator proposal content
+func SimulateSetAdministratorProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		m, found := randomMarker(r, ctx, k, governedMarker)
+		if !found {
+			return nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		return types.NewSetAdministratorProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			m.GetDenom(),
+			[]types.AccessGrant{*types.NewAccessGrant(simAccount.Address, randomAccess(r))},
+		)
+	}
+}
+
+// SimulateRemoveAdministratorProposalContent generates random remove-administrator proposal content
+func SimulateRemoveAdministratorProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+			return governedMarker(m) && len(m.GetAccessList()) > 0
+		})
+		if !found {
+			return nil
+		}
+		grants := m.GetAccessList()
+		removed := grants[r.Intn(len(grants))].GetAddress()
+		if err := m.RevokeAccess(removed); err != nil || m.Validate() != nil {
+			return nil
+		}
+
+		return types.NewRemoveAdministratorProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			m.GetDenom(),
+			[]string{removed.String()},
+		)
+	}
+}
+
+// SimulateChangeStatusProposalContent generates random change-status proposal content moving a marker to its next status
+func SimulateChangeStatusProposalContent(k keeper.Keeper, bk bankkeeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+			switch {
+			case !governedMarker(m):
+				return false
+			case m.GetStatus() == types.StatusCancelled:
+				// the supply is burned when destroyed so all of it must be held by the marker
+				return holdsEntireSupply(ctx, bk, m)
+			default:
+				return m.GetStatus() < types.StatusCancelled
+			}
+		})
+		if !found {
+			return nil
+		}
+		status := m.GetStatus() + 1
+		if err := m.SetStatus(status); err != nil || m.Validate() != nil {
+			return nil
+		}
+
+		return types.NewChangeStatusProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			m.GetDenom(),
+			status,
+		)
+	}
+}
+
+// SimulateWithdrawEscrowProposalContent generates random withdraw-escrow proposal content
+func SimulateWithdrawEscrowProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		m, found := randomMarker(r, ctx, k, func(m types.MarkerAccountI) bool {
+			return governedMarker(m) && m.GetStatus() == types.StatusActive
+		})
+		if !found {
+			return nil
+		}
+		amount, err := simtypes.RandPositiveInt(r, k.GetEscrow(ctx, m).AmountOf(m.GetDenom()))
+		if err != nil {
+			return nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		return types.NewWithdrawEscrowProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			m.GetDenom(),
+			sdk.NewCoins(sdk.NewCoin(m.GetDenom(), amount)),
+			simAccount.Address.String(),
+		)
+	}
+}
+
+// governedMarker returns true for markers that allow governance control
+func governedMarker(m types.MarkerAccountI) bool {
+	return m.HasGovernanceEnabled()
+}
@@ -0,0 +1,81 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker"
+	"github.com/provenance-io/provenance/x/marker/simulation"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestProposalContents(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// initialize parameters
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := simtypes.RandomAccounts(r, 3)
+
+	// execute ProposalContents function
+	weightedProposalContent := simulation.ProposalContents(app.MarkerKeeper, app.BankKeeper)
+	require.Len(t, weightedProposalContent, 7)
+
+	expected := []struct {
+		appParamsKey string
+		proposalType string
+	}{
+		{simulation.OpWeightSubmitAddMarkerProposal, types.ProposalTypeAddMarker},
+		{simulation.OpWeightSubmitSupplyIncreaseProposal, types.ProposalTypeIncreaseSupply},
+		{simulation.OpWeightSubmitSupplyDecreaseProposal, types.ProposalTypeDecreaseSupply},
+		{simulation.OpWeightSubmitSetAdministratorProposal, types.ProposalTypeSetAdministrator},
+		{simulation.OpWeightSubmitRemoveAdministratorProposal, types.ProposalTypeRemoveAdministrator},
+		{simulation.OpWeightSubmitChangeStatusProposal, types.ProposalTypeChangeStatus},
+		{simulation.OpWeightSubmitWithdrawEscrowProposal, types.ProposalTypeWithdrawEscrow},
+	}
+
+	// without any markers only a new marker can be proposed
+	for i, w := range weightedProposalContent {
+		require.Equal(t, expected[i].appParamsKey, w.AppParamsKey())
+		require.Equal(t, simappparams.DefaultWeightTextProposal, w.DefaultWeight())
+
+		content := w.ContentSimulatorFn()(r, ctx, accounts)
+		if expected[i].proposalType == types.ProposalTypeAddMarker {
+			require.NotNil(t, content)
+		} else {
+			require.Nil(t, content, expected[i].proposalType)
+		}
+	}
+
+	mac := types.NewEmptyMarkerAccount("simcoin", accounts[0].Address.String(), []types.AccessGrant{
+		*types.NewAccessGrant(accounts[0].Address, []types.Access{types.Access_Mint}),
+		*types.NewAccessGrant(accounts[1].Address, []types.Access{types.Access_Admin}),
+	})
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin("simcoin", 1000)))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, accounts[0].Address, "simcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, accounts[0].Address, "simcoin"))
+
+	// with an active marker every proposal must be accepted by the handler as it is executed when submitted
+	handler := marker.NewProposalHandler(app.MarkerKeeper)
+	for i, w := range weightedProposalContent {
+		content := w.ContentSimulatorFn()(r, ctx, accounts)
+		require.NotNil(t, content, expected[i].proposalType)
+		require.Equal(t, types.RouterKey, content.ProposalRoute())
+		require.Equal(t, expected[i].proposalType, content.ProposalType())
+		require.NoError(t, content.ValidateBasic(), expected[i].proposalType)
+
+		cacheCtx, _ := ctx.CacheContext()
+		require.NoError(t, handler(cacheCtx, content), expected[i].proposalType)
+	}
+}
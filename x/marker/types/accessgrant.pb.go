@@ -47,6 +47,12 @@ const (
 	// ACCESS_TRANSFER is the ability to invoke a send operation using the marker module to facilitate exchange.
 	// This capability is useful when the marker denomination has "send enabled = false" preventing normal bank transfer
 	Access_Transfer Access = 7
+	// ACCESS_FREEZE is the ability to freeze and unfreeze the balance of an individual holder of a restricted marker
+	// coin, a frozen balance can not be moved by a transfer or a bank send.
+	Access_Freeze Access = 8
+	// ACCESS_FORCE_TRANSFER is the ability to move the balance of a restricted marker coin out of any holder account
+	// without the signature of the holder.  This access is only usable on markers that allow forced transfers.
+	Access_ForceTransfer Access = 9
 )
 
 var Access_name = map[int32]string{
@@ -58,17 +64,21 @@ var Access_name = map[int32]string{
 	5: "ACCESS_DELETE",
 	6: "ACCESS_ADMIN",
 	7: "ACCESS_TRANSFER",
+	8: "ACCESS_FREEZE",
+	9: "ACCESS_FORCE_TRANSFER",
 }
 
 var Access_value = map[string]int32{
-	"ACCESS_UNSPECIFIED": 0,
-	"ACCESS_MINT":        1,
-	"ACCESS_BURN":        2,
-	"ACCESS_DEPOSIT":     3,
-	"ACCESS_WITHDRAW":    4,
-	"ACCESS_DELETE":      5,
-	"ACCESS_ADMIN":       6,
-	"ACCESS_TRANSFER":    7,
+	"ACCESS_UNSPECIFIED":    0,
+	"ACCESS_MINT":           1,
+	"ACCESS_BURN":           2,
+	"ACCESS_DEPOSIT":        3,
+	"ACCESS_WITHDRAW":       4,
+	"ACCESS_DELETE":         5,
+	"ACCESS_ADMIN":          6,
+	"ACCESS_TRANSFER":       7,
+	"ACCESS_FREEZE":         8,
+	"ACCESS_FORCE_TRANSFER": 9,
 }
 
 func (x Access) String() string {
@@ -127,37 +137,39 @@ func init() {
 }
 
 var fileDescriptor_7242c30a84644575 = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xed, 0xb4, 0x4d, 0xda, 0x4b, 0x08, 0xd6, 0xa9, 0x12, 0xa9, 0x29, 0x8e, 0x01, 0x09,
-	0x55, 0x48, 0xb5, 0xd5, 0xb2, 0xb1, 0x39, 0xb1, 0x0b, 0x96, 0x1a, 0x13, 0xd9, 0x8e, 0x22, 0xb1,
-	0x54, 0xae, 0x73, 0xa4, 0xa7, 0xe2, 0x3b, 0xeb, 0xce, 0x4d, 0xe9, 0x17, 0x40, 0xc8, 0x13, 0x0b,
-	0x12, 0x8b, 0xa5, 0xcc, 0xcc, 0x7c, 0x08, 0xc6, 0x8a, 0x89, 0x0d, 0x94, 0x2c, 0x7c, 0x0c, 0x94,
-	0x5c, 0x4a, 0x3d, 0x74, 0x7b, 0xcf, 0xff, 0x9f, 0x7f, 0x7a, 0x7a, 0xf7, 0xc0, 0xb3, 0x94, 0xd1,
-	0x09, 0x22, 0x11, 0x89, 0x91, 0x99, 0x44, 0xec, 0x1c, 0x31, 0x73, 0x72, 0x60, 0x46, 0x71, 0x8c,
-	0x38, 0x1f, 0xb3, 0x88, 0x64, 0x46, 0xca, 0x68, 0x46, 0xe1, 0xf6, 0x2d, 0x67, 0x08, 0xce, 0x98,
-	0x1c, 0xa8, 0xdb, 0x63, 0x3a, 0xa6, 0x4b, 0xc0, 0x5c, 0x54, 0x82, 0x55, 0x77, 0x62, 0xca, 0x13,
-	0xca, 0x4f, 0x44, 0x20, 0x1a, 0x11, 0x3d, 0xf9, 0x22, 0x83, 0xba, 0xb5, 0x94, 0xbf, 0x5a, 0xc8,
-	0x61, 0x0b, 0xd4, 0xa2, 0xd1, 0x88, 0x21, 0xce, 0x5b, 0xb2, 0x2e, 0xef, 0x6d, 0xf9, 0x37, 0x2d,
-	0xf4, 0x40, 0x3d, 0x45, 0x2c, 0xc1, 0x9c, 0x63, 0x4a, 0x78, 0xab, 0xa2, 0xaf, 0xed, 0x35, 0x0f,
-	0x77, 0x8d, 0xbb, 0xc6, 0x30, 0x84, 0xb1, 0xd3, 0xfc, 0xf6, 0xbb, 0x0d, 0x44, 0x7d, 0x8c, 0x79,
-	0xe6, 0x97, 0x05, 0x2f, 0x77, 0x3f, 0x4d, 0xdb, 0xd2, 0xd7, 0x69, 0x5b, 0xfa, 0x3b, 0x6d, 0xcb,
-	0x3f, 0xbf, 0xef, 0x37, 0x4a, 0x63, 0xb8, 0xcf, 0x3f, 0x56, 0x40, 0x55, 0x7c, 0x80, 0x4f, 0x01,
-	0xb4, 0xba, 0x5d, 0x27, 0x08, 0x4e, 0x06, 0x5e, 0xd0, 0x77, 0xba, 0xee, 0x91, 0xeb, 0xd8, 0x8a,
-	0xa4, 0xd6, 0xf3, 0x42, 0xaf, 0x0d, 0xc8, 0x39, 0xa1, 0x97, 0x04, 0xee, 0x80, 0xfa, 0x0a, 0xea,
-	0xb9, 0x5e, 0xa8, 0xc8, 0xea, 0x66, 0x5e, 0xe8, 0xeb, 0x3d, 0x4c, 0xb2, 0x52, 0xd4, 0x19, 0xf8,
-	0x9e, 0x52, 0x11, 0x51, 0xe7, 0x82, 0x11, 0xd8, 0x06, 0xcd, 0x55, 0x64, 0x3b, 0xfd, 0x37, 0x81,
-	0x1b, 0x2a, 0x6b, 0x42, 0x6b, 0xa3, 0x94, 0x72, 0x9c, 0xc1, 0xc7, 0xe0, 0xfe, 0x0a, 0x18, 0xba,
-	0xe1, 0x6b, 0xdb, 0xb7, 0x86, 0xca, 0xba, 0xda, 0xc8, 0x0b, 0x7d, 0x73, 0x88, 0xb3, 0xb3, 0x11,
-	0x8b, 0x2e, 0xe1, 0x23, 0x70, 0xef, 0xbf, 0xe3, 0xd8, 0x09, 0x1d, 0x65, 0x43, 0x05, 0x79, 0xa1,
-	0x57, 0x6d, 0xf4, 0x1e, 0x65, 0x08, 0x3e, 0x04, 0x8d, 0x55, 0x6c, 0xd9, 0x3d, 0xd7, 0x53, 0xaa,
-	0xea, 0x56, 0x5e, 0xe8, 0x1b, 0xd6, 0x28, 0xc1, 0xa4, 0xa4, 0x0f, 0x7d, 0xcb, 0x0b, 0x8e, 0x1c,
-	0x5f, 0xa9, 0x09, 0x7d, 0xc8, 0x22, 0xc2, 0xdf, 0x21, 0xd6, 0xb9, 0xfa, 0x31, 0xd3, 0xe4, 0xeb,
-	0x99, 0x26, 0xff, 0x99, 0x69, 0xf2, 0xe7, 0xb9, 0x26, 0x5d, 0xcf, 0x35, 0xe9, 0xd7, 0x5c, 0x93,
-	0xc0, 0x03, 0x4c, 0xef, 0xdc, 0x7e, 0x47, 0x29, 0x6d, 0xb2, 0xbf, 0x78, 0xe5, 0xbe, 0xfc, 0xf6,
-	0x70, 0x8c, 0xb3, 0xb3, 0x8b, 0x53, 0x23, 0xa6, 0x89, 0x79, 0xfb, 0xd3, 0x3e, 0xa6, 0xa5, 0xce,
-	0xfc, 0x70, 0x73, 0x71, 0xd9, 0x55, 0x8a, 0xf8, 0x69, 0x75, 0x79, 0x22, 0x2f, 0xfe, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x22, 0x3b, 0x74, 0x4e, 0x93, 0x02, 0x00, 0x00,
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc7, 0xed, 0xfe, 0x49, 0x93, 0x4b, 0x9a, 0x9f, 0x7f, 0xa7, 0x22, 0x52, 0x53, 0x1c, 0x03,
+	0x12, 0xaa, 0x10, 0xb5, 0xd5, 0xb2, 0xb1, 0x39, 0xf1, 0x05, 0x2c, 0x35, 0x6e, 0xe4, 0x38, 0x8a,
+	0xd4, 0xa5, 0x72, 0x9d, 0x23, 0xb5, 0x4a, 0xee, 0xa2, 0x3b, 0x37, 0xa5, 0xbc, 0x02, 0xe4, 0x89,
+	0x05, 0x89, 0xc5, 0x52, 0x66, 0x66, 0x5e, 0x04, 0x63, 0x05, 0x0b, 0x1b, 0x28, 0x59, 0x78, 0x19,
+	0x28, 0xb9, 0x84, 0x78, 0xe8, 0xf6, 0x3c, 0xf7, 0xf9, 0xde, 0x47, 0x8f, 0xf4, 0x3c, 0xe0, 0xe9,
+	0x90, 0xd1, 0x11, 0x26, 0x01, 0x09, 0xb1, 0x39, 0x08, 0xd8, 0x25, 0x66, 0xe6, 0xe8, 0xd0, 0x0c,
+	0xc2, 0x10, 0x73, 0xde, 0x67, 0x01, 0x89, 0x8d, 0x21, 0xa3, 0x31, 0x85, 0x3b, 0xab, 0x9c, 0x21,
+	0x72, 0xc6, 0xe8, 0x50, 0xdd, 0xe9, 0xd3, 0x3e, 0x9d, 0x07, 0xcc, 0x59, 0x25, 0xb2, 0xea, 0x6e,
+	0x48, 0xf9, 0x80, 0xf2, 0x33, 0x01, 0x44, 0x23, 0xd0, 0xe3, 0x4f, 0x32, 0x28, 0x5a, 0x73, 0xf9,
+	0xab, 0x99, 0x1c, 0x56, 0xc0, 0x56, 0xd0, 0xeb, 0x31, 0xcc, 0x79, 0x45, 0xd6, 0xe5, 0xfd, 0x82,
+	0xb7, 0x6c, 0xa1, 0x0b, 0x8a, 0x43, 0xcc, 0x06, 0x11, 0xe7, 0x11, 0x25, 0xbc, 0xb2, 0xa6, 0xaf,
+	0xef, 0x97, 0x8f, 0xf6, 0x8c, 0xbb, 0xc6, 0x30, 0x84, 0xb1, 0x56, 0xfe, 0xf2, 0xab, 0x0a, 0x44,
+	0x7d, 0x1c, 0xf1, 0xd8, 0xcb, 0x0a, 0x5e, 0xee, 0x7d, 0x18, 0x57, 0xa5, 0xcf, 0xe3, 0xaa, 0xf4,
+	0x67, 0x5c, 0x95, 0xbf, 0x7f, 0x3d, 0x28, 0x65, 0xc6, 0x70, 0x9e, 0xfd, 0x58, 0x03, 0x39, 0xf1,
+	0x00, 0x9f, 0x00, 0x68, 0xd5, 0xeb, 0xa8, 0xdd, 0x3e, 0xeb, 0xb8, 0xed, 0x16, 0xaa, 0x3b, 0x0d,
+	0x07, 0xd9, 0x8a, 0xa4, 0x16, 0x93, 0x54, 0xdf, 0xea, 0x90, 0x4b, 0x42, 0xaf, 0x09, 0xdc, 0x05,
+	0xc5, 0x45, 0xa8, 0xe9, 0xb8, 0xbe, 0x22, 0xab, 0xf9, 0x24, 0xd5, 0x37, 0x9a, 0x11, 0x89, 0x33,
+	0xa8, 0xd6, 0xf1, 0x5c, 0x65, 0x4d, 0xa0, 0xda, 0x15, 0x23, 0xb0, 0x0a, 0xca, 0x0b, 0x64, 0xa3,
+	0xd6, 0x49, 0xdb, 0xf1, 0x95, 0x75, 0xa1, 0xb5, 0xf1, 0x90, 0xf2, 0x28, 0x86, 0x8f, 0xc0, 0x7f,
+	0x8b, 0x40, 0xd7, 0xf1, 0x5f, 0xdb, 0x9e, 0xd5, 0x55, 0x36, 0xd4, 0x52, 0x92, 0xea, 0xf9, 0x6e,
+	0x14, 0x5f, 0xf4, 0x58, 0x70, 0x0d, 0x1f, 0x82, 0xed, 0x7f, 0x8e, 0x63, 0xe4, 0x23, 0x65, 0x53,
+	0x05, 0x49, 0xaa, 0xe7, 0x6c, 0xfc, 0x16, 0xc7, 0x18, 0x3e, 0x00, 0xa5, 0x05, 0xb6, 0xec, 0xa6,
+	0xe3, 0x2a, 0x39, 0xb5, 0x90, 0xa4, 0xfa, 0xa6, 0xd5, 0x1b, 0x44, 0x24, 0xa3, 0xf7, 0x3d, 0xcb,
+	0x6d, 0x37, 0x90, 0xa7, 0x6c, 0x09, 0xbd, 0xcf, 0x02, 0xc2, 0xdf, 0x60, 0x96, 0xd1, 0x37, 0x3c,
+	0x84, 0x4e, 0x91, 0x92, 0x17, 0xfa, 0x06, 0xc3, 0xf8, 0x3d, 0x86, 0xcf, 0xc1, 0xbd, 0x25, 0x3e,
+	0xf1, 0xea, 0x68, 0xe5, 0x29, 0xa8, 0xff, 0x27, 0xa9, 0xbe, 0xdd, 0xa0, 0x2c, 0xc4, 0x4b, 0x59,
+	0xed, 0xe6, 0xdb, 0x44, 0x93, 0x6f, 0x27, 0x9a, 0xfc, 0x7b, 0xa2, 0xc9, 0x1f, 0xa7, 0x9a, 0x74,
+	0x3b, 0xd5, 0xa4, 0x9f, 0x53, 0x4d, 0x02, 0xf7, 0x23, 0x7a, 0xe7, 0x2a, 0x6b, 0x4a, 0x66, 0x2d,
+	0xad, 0xd9, 0xc9, 0xb4, 0xe4, 0xd3, 0xa3, 0x7e, 0x14, 0x5f, 0x5c, 0x9d, 0x1b, 0x21, 0x1d, 0x98,
+	0xab, 0x4f, 0x07, 0x11, 0xcd, 0x74, 0xe6, 0xbb, 0xe5, 0xf9, 0xc6, 0x37, 0x43, 0xcc, 0xcf, 0x73,
+	0xf3, 0x7b, 0x7b, 0xf1, 0x77, 0x00, 0x9e, 0x61, 0x31, 0x6e, 0xe0, 0x02, 0x00, 0x00,
 }
 
 func (this *AccessGrant) Equal(that interface{}) bool {
@@ -56,6 +56,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBurnRequest{},
 		&MsgWithdrawRequest{},
 		&MsgTransferRequest{},
+		&MsgSetDenomMetadataRequest{},
+		&MsgFreezeHolderRequest{},
+		&MsgUnfreezeHolderRequest{},
+		&MsgForceTransferRequest{},
+		&MsgSetMintScheduleRequest{},
 	)
 
 	registry.RegisterImplementations(
@@ -66,6 +71,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&SetAdministratorProposal{},
 		&RemoveAdministratorProposal{},
 		&ChangeStatusProposal{},
+		&WithdrawEscrowProposal{},
+		&SetMintScheduleProposal{},
 	)
 
 	registry.RegisterInterface(
@@ -19,6 +19,19 @@ const (
 	// EventAttributeRevokeKey is the attribute key for a revoke event
 	EventAttributeRevokeKey string = "marker_access_revoked"
 
+	// EventAttributeHolderKey is the attribute key for the account holding a marker coin
+	EventAttributeHolderKey string = "holder"
+
+	// EventAttributeFromKey is the attribute key for the account coin is moved out of
+	EventAttributeFromKey string = "from_address"
+	// EventAttributeToKey is the attribute key for the account coin is moved into
+	EventAttributeToKey string = "to_address"
+	// EventAttributeReasonKey is the attribute key for the reason given for a compliance action
+	EventAttributeReasonKey string = "reason"
+
+	// EventAttributeErrorKey is the attribute key for the reason an action could not be completed
+	EventAttributeErrorKey string = "error"
+
 	// EventAttributeModuleNameKey is the attribute key for the entire marker module
 	EventAttributeModuleNameKey string = "module"
 
@@ -49,6 +62,18 @@ const (
 	EventTypeWithdraw string = EventAttributeMarkerKey + "_withdraw_coins"
 	// EventTypeTransfer emitted when a restricted coin marker transfer occurs
 	EventTypeTransfer string = EventAttributeMarkerKey + "_tranfer_coin"
+	// EventTypeForceTransfer emitted when restricted coin is recovered from a holder without the holder signature
+	EventTypeForceTransfer string = EventAttributeMarkerKey + "_forced_transfer"
+	// EventTypeSetMintSchedule emitted when the scheduled supply increases of a marker are replaced
+	EventTypeSetMintSchedule string = EventAttributeMarkerKey + "_set_mint_schedule"
+	// EventTypeScheduledMint emitted when a scheduled supply increase of a marker is executed
+	EventTypeScheduledMint string = EventAttributeMarkerKey + "_scheduled_mint"
+	// EventTypeScheduledMintFailed emitted when a scheduled supply increase of a marker could not be executed
+	EventTypeScheduledMintFailed string = EventAttributeMarkerKey + "_scheduled_mint_failed"
+	// EventTypeFreezeHolder emitted when the balance of a holder of a restricted coin is frozen
+	EventTypeFreezeHolder string = EventAttributeMarkerKey + "_holder_frozen"
+	// EventTypeUnfreezeHolder emitted when the balance of a holder of a restricted coin is unfrozen
+	EventTypeUnfreezeHolder string = EventAttributeMarkerKey + "_holder_unfrozen"
 
 	// EventTypeDepositAsset emitted when assets are assigned as marker collateral
 	EventTypeDepositAsset string = EventAttributeMarkerKey + "_asset_deposited"
@@ -5,6 +5,9 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 )
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -55,3 +58,13 @@ type BankKeeper interface {
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
+
+// AttrKeeper defines the expected attribute keeper used to check the attributes required by restricted markers
+type AttrKeeper interface {
+	GetAllAttributes(ctx sdk.Context, acc sdk.AccAddress) ([]attrtypes.Attribute, error)
+}
+
+// ChannelKeeper defines the expected ibc channel keeper used to find the ibc transfer escrow accounts
+type ChannelKeeper interface {
+	GetAllChannels(ctx sdk.Context) []channeltypes.IdentifiedChannel
+}
@@ -2,8 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
@@ -21,6 +23,31 @@ func (state GenesisState) Validate() error {
 			return err
 		}
 	}
+	frozen := make(map[string]bool, len(state.FrozenHolders))
+	for _, f := range state.FrozenHolders {
+		if err := sdk.ValidateDenom(f.Denom); err != nil {
+			return fmt.Errorf("invalid frozen holder denom: %w", err)
+		}
+		addr, err := sdk.AccAddressFromBech32(f.Address)
+		if err != nil {
+			return fmt.Errorf("invalid frozen holder address: %w", err)
+		}
+		key := string(FrozenKey(f.Denom, addr))
+		if frozen[key] {
+			return fmt.Errorf("duplicate frozen holder %s for %s", f.Address, f.Denom)
+		}
+		frozen[key] = true
+	}
+	scheduled := make(map[string]bool, len(state.MintSchedules))
+	for _, ms := range state.MintSchedules {
+		if err := ms.Validate(); err != nil {
+			return err
+		}
+		if scheduled[ms.Denom] {
+			return fmt.Errorf("duplicate mint schedule for %s", ms.Denom)
+		}
+		scheduled[ms.Denom] = true
+	}
 	return nil
 }
 
@@ -29,6 +29,10 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// A collection of marker accounts to create on start
 	Markers []MarkerAccount `protobuf:"bytes,2,rep,name=markers,proto3" json:"markers"`
+	// The accounts whose balance of a marker coin is frozen
+	FrozenHolders []FrozenHolder `protobuf:"bytes,3,rep,name=frozen_holders,json=frozenHolders,proto3" json:"frozen_holders"`
+	// The scheduled supply increases of markers
+	MintSchedules []MintSchedule `protobuf:"bytes,4,rep,name=mint_schedules,json=mintSchedules,proto3" json:"mint_schedules"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -64,8 +68,62 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+// FrozenHolder is an account whose balance of the marker coin with the given denom is frozen.
+type FrozenHolder struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *FrozenHolder) Reset()         { *m = FrozenHolder{} }
+func (m *FrozenHolder) String() string { return proto.CompactTextString(m) }
+func (*FrozenHolder) ProtoMessage()    {}
+func (*FrozenHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{1}
+}
+func (m *FrozenHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenHolder.Merge(m, src)
+}
+func (m *FrozenHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenHolder proto.InternalMessageInfo
+
+func (m *FrozenHolder) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FrozenHolder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.marker.v1.GenesisState")
+	proto.RegisterType((*FrozenHolder)(nil), "provenance.marker.v1.FrozenHolder")
 }
 
 func init() {
@@ -73,23 +131,30 @@ func init() {
 }
 
 var fileDescriptor_5dcc4ab7c9d2f78f = []byte{
-	// 251 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd2, 0x2f, 0x33,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x41, 0xa8, 0xd1, 0x83, 0xa8, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb1, 0x9a, 0x07, 0xd5, 0x05, 0x56, 0xa2, 0x34, 0x93,
-	0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x41, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x15, 0x17, 0x5b, 0x41,
-	0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x8c, 0x1e, 0x36, 0x0b,
-	0xf5, 0x02, 0xc0, 0x6a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea, 0x10, 0x72, 0xe6,
-	0x62, 0x87, 0xa8, 0x28, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc6, 0xae, 0xd9, 0x17,
-	0xcc, 0x72, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x81, 0x9a, 0x01, 0xd3, 0x69, 0xc5, 0xd1, 0xb1,
-	0x40, 0x9e, 0xe1, 0xc5, 0x02, 0x79, 0x06, 0xa7, 0xf4, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0xe0, 0x12, 0xcf, 0xcc, 0xc7, 0x6a, 0x72, 0x00, 0x63, 0x94, 0x51, 0x7a, 0x66, 0x49,
-	0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x42, 0x89, 0x6e, 0x66, 0x3e, 0x12, 0x4f, 0xbf,
-	0x02, 0x16, 0x1c, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xb0, 0x30, 0x06, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0xa8, 0x55, 0x2c, 0xc0, 0x80, 0x01, 0x00, 0x00,
+	// 353 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x31, 0x4f, 0xc2, 0x40,
+	0x14, 0xc7, 0x5b, 0x40, 0xd0, 0x03, 0x1d, 0x1a, 0x12, 0x1b, 0x62, 0x0a, 0xd6, 0x85, 0xc5, 0x36,
+	0xe0, 0xc6, 0x60, 0x22, 0x26, 0xea, 0x42, 0x24, 0xb0, 0xb9, 0x90, 0xd2, 0x1e, 0xa5, 0x91, 0xde,
+	0x6b, 0xee, 0x0e, 0xa2, 0x7e, 0x02, 0x47, 0x3f, 0x02, 0xdf, 0xc3, 0x2f, 0xc0, 0xc8, 0xe8, 0x64,
+	0x0c, 0x5d, 0xfc, 0x18, 0xa6, 0xd7, 0x36, 0x74, 0x68, 0xdc, 0xee, 0xbd, 0xfc, 0xfe, 0xbf, 0x77,
+	0x79, 0x0f, 0xe9, 0x01, 0x85, 0x15, 0x26, 0x16, 0xb1, 0xb1, 0xe9, 0x5b, 0xf4, 0x19, 0x53, 0x73,
+	0xd5, 0x31, 0x5d, 0x4c, 0x30, 0xf3, 0x98, 0x11, 0x50, 0xe0, 0xa0, 0xd4, 0xf7, 0x8c, 0x11, 0x33,
+	0xc6, 0xaa, 0xd3, 0xa8, 0xbb, 0xe0, 0x82, 0x00, 0xcc, 0xe8, 0x15, 0xb3, 0x8d, 0xf3, 0x5c, 0x5f,
+	0x92, 0x12, 0x88, 0xfe, 0x59, 0x40, 0xb5, 0xfb, 0x78, 0xc0, 0x98, 0x5b, 0x1c, 0x2b, 0x3d, 0x54,
+	0x0e, 0x2c, 0x6a, 0xf9, 0x4c, 0x95, 0x5b, 0x72, 0xbb, 0xda, 0x3d, 0x33, 0xf2, 0x06, 0x1a, 0x43,
+	0xc1, 0xf4, 0x4b, 0x9b, 0xef, 0xa6, 0x34, 0x4a, 0x12, 0xca, 0x2d, 0xaa, 0xc4, 0x04, 0x53, 0x0b,
+	0xad, 0x62, 0xbb, 0xda, 0xbd, 0xc8, 0x0f, 0x0f, 0xc4, 0xeb, 0xc6, 0xb6, 0x61, 0x49, 0x78, 0xe2,
+	0x48, 0x93, 0xca, 0x23, 0x3a, 0x99, 0x51, 0x78, 0xc3, 0x64, 0x32, 0x87, 0x85, 0x13, 0xb9, 0x8a,
+	0xc2, 0xa5, 0xe7, 0xbb, 0xee, 0x04, 0xfb, 0x20, 0xd0, 0x44, 0x75, 0x3c, 0xcb, 0xf4, 0x84, 0xd0,
+	0xf7, 0x08, 0x9f, 0x30, 0x7b, 0x8e, 0x9d, 0xe5, 0x02, 0x33, 0xb5, 0xf4, 0x9f, 0x70, 0xe0, 0x11,
+	0x3e, 0x4e, 0xd0, 0x54, 0xe8, 0x67, 0x7a, 0xac, 0x77, 0xf8, 0xbe, 0x6e, 0x4a, 0xbf, 0xeb, 0xa6,
+	0xa4, 0x5f, 0xa3, 0x5a, 0x76, 0xbe, 0x52, 0x47, 0x07, 0x0e, 0x26, 0xe0, 0x8b, 0xdd, 0x1d, 0x8d,
+	0xe2, 0x42, 0x51, 0x51, 0xc5, 0x72, 0x1c, 0x8a, 0x59, 0xb4, 0x96, 0xa8, 0x9f, 0x96, 0x7d, 0x77,
+	0xb3, 0xd3, 0xe4, 0xed, 0x4e, 0x93, 0x7f, 0x76, 0x9a, 0xfc, 0x11, 0x6a, 0xd2, 0x36, 0xd4, 0xa4,
+	0xaf, 0x50, 0x93, 0xd0, 0xa9, 0x07, 0xb9, 0xdf, 0x1b, 0xca, 0x4f, 0x5d, 0xd7, 0xe3, 0xf3, 0xe5,
+	0xd4, 0xb0, 0xc1, 0x37, 0xf7, 0xc8, 0xa5, 0x07, 0x99, 0xca, 0x7c, 0x49, 0x0f, 0xce, 0x5f, 0x03,
+	0xcc, 0xa6, 0x65, 0x71, 0xed, 0xab, 0xbf, 0x01, 0x00, 0xbd, 0x9c, 0x7b, 0x72, 0x62, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +177,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MintSchedules) > 0 {
+		for iNdEx := len(m.MintSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FrozenHolders) > 0 {
+		for iNdEx := len(m.FrozenHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Markers) > 0 {
 		for iNdEx := len(m.Markers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -139,6 +232,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FrozenHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrozenHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrozenHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -164,6 +294,35 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FrozenHolders) > 0 {
+		for _, e := range m.FrozenHolders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintSchedules) > 0 {
+		for _, e := range m.MintSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FrozenHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -269,6 +428,188 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenHolders = append(m.FrozenHolders, FrozenHolder{})
+			if err := m.FrozenHolders[len(m.FrozenHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintSchedules = append(m.MintSchedules, MintSchedule{})
+			if err := m.MintSchedules[len(m.MintSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrozenHolder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrozenHolder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrozenHolder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
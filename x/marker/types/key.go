@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/crypto"
@@ -30,6 +31,21 @@ const (
 var (
 	// MarkerStoreKeyPrefix prefix for marker-address reference (improves iterator performance over auth accounts)
 	MarkerStoreKeyPrefix = []byte{0x01}
+
+	// SupplyChangedKeyPrefix prefix for the set of markers with a supply change pending reconciliation
+	SupplyChangedKeyPrefix = []byte{0x02}
+
+	// HolderKeyPrefix prefix for the denom to holder address index used to list the accounts holding a marker's coin
+	HolderKeyPrefix = []byte{0x03}
+
+	// FrozenKeyPrefix prefix for the set of accounts whose balance of a marker's coin is frozen
+	FrozenKeyPrefix = []byte{0x04}
+
+	// MintScheduleKeyPrefix prefix for the scheduled supply increases of a marker
+	MintScheduleKeyPrefix = []byte{0x05}
+
+	// MintScheduleDueKeyPrefix prefix for the index of mint schedules ordered by the time their next entry is due
+	MintScheduleDueKeyPrefix = []byte{0x06}
 )
 
 // MarkerAddress returns the module account address for the given denomination
@@ -54,6 +70,50 @@ func MarkerStoreKey(addr sdk.AccAddress) []byte {
 	return append(MarkerStoreKeyPrefix, addr.Bytes()...)
 }
 
+// SupplyChangedKey turn an address to the key used to flag a marker for supply reconciliation
+func SupplyChangedKey(addr sdk.AccAddress) []byte {
+	return append(SupplyChangedKeyPrefix, addr.Bytes()...)
+}
+
+// MintScheduleKey returns the key used to store the mint schedule of the marker with the given address
+func MintScheduleKey(addr sdk.AccAddress) []byte {
+	return append(MintScheduleKeyPrefix, addr.Bytes()...)
+}
+
+// MintScheduleDueTimeKeyPrefix returns the prefix of the due index for all mint schedules next due at the given time
+func MintScheduleDueTimeKeyPrefix(due time.Time) []byte {
+	return append(MintScheduleDueKeyPrefix, sdk.FormatTimeBytes(due)...)
+}
+
+// MintScheduleDueKey returns the due index key for the mint schedule of the marker with the given address
+func MintScheduleDueKey(due time.Time, addr sdk.AccAddress) []byte {
+	return append(MintScheduleDueTimeKeyPrefix(due), addr.Bytes()...)
+}
+
+// HolderDenomPrefix returns the holder index prefix for all accounts holding the given denom
+func HolderDenomPrefix(denom string) []byte {
+	key := append([]byte{}, HolderKeyPrefix...)
+	key = append(key, byte(len(denom)))
+	return append(key, denom...)
+}
+
+// HolderKey returns the holder index key for an account holding the given denom
+func HolderKey(denom string, addr sdk.AccAddress) []byte {
+	return append(HolderDenomPrefix(denom), addr.Bytes()...)
+}
+
+// FrozenDenomPrefix returns the frozen set prefix for all accounts frozen for the given denom
+func FrozenDenomPrefix(denom string) []byte {
+	key := append([]byte{}, FrozenKeyPrefix...)
+	key = append(key, byte(len(denom)))
+	return append(key, denom...)
+}
+
+// FrozenKey returns the frozen set key for an account frozen for the given denom
+func FrozenKey(denom string, addr sdk.AccAddress) []byte {
+	return append(FrozenDenomPrefix(denom), addr.Bytes()...)
+}
+
 // SplitMarkerStoreKey returns an account address given a store key
 func SplitMarkerStoreKey(key []byte) sdk.AccAddress {
 	return sdk.AccAddress(key[1 : sdk.AddrLen+1])
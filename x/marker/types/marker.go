@@ -45,6 +45,10 @@ type MarkerAccountI interface {
 	AddressListForPermission(Access) []sdk.AccAddress
 
 	HasGovernanceEnabled() bool
+
+	GetRequiredAttributes() []string
+	AllowsBankSend() bool
+	AllowsForcedTransfer() bool
 }
 
 // NewEmptyMarkerAccount creates a new empty marker account in a Proposed state
@@ -104,6 +108,22 @@ func (ma MarkerAccount) HasFixedSupply() bool { return ma.SupplyFixed }
 // HasGovernanceEnabled returns true if this marker allows governance proposals to control this marker
 func (ma MarkerAccount) HasGovernanceEnabled() bool { return ma.AllowGovernanceControl }
 
+// GetRequiredAttributes returns the account attribute names a recipient must have to be sent this restricted coin
+func (ma MarkerAccount) GetRequiredAttributes() []string { return ma.RequiredAttributes }
+
+// AllowsBankSend returns true if the coin for this marker may be sent using the bank module.  Restricted coins may only
+// be sent with the bank when the marker lists required attributes that all recipients must have.
+func (ma MarkerAccount) AllowsBankSend() bool {
+	return ma.MarkerType == MarkerType_Coin ||
+		(ma.MarkerType == MarkerType_RestrictedCoin && len(ma.RequiredAttributes) > 0)
+}
+
+// AllowsForcedTransfer returns true if accounts with the force transfer access may move this restricted coin out of
+// any holder account.
+func (ma MarkerAccount) AllowsForcedTransfer() bool {
+	return ma.MarkerType == MarkerType_RestrictedCoin && ma.AllowForcedTransfer
+}
+
 // AddressHasAccess returns true if the provided address has been assigned the provided
 // role within the current MarkerAccount AccessControl
 func (ma *MarkerAccount) AddressHasAccess(addr sdk.AccAddress, role Access) bool {
@@ -164,6 +184,17 @@ func (ma MarkerAccount) Validate() error {
 	if ma.Status < StatusActive && ma.Manager == "" && len(ma.AddressListForPermission(Access_Admin)) == 0 {
 		return fmt.Errorf("a manager is required if there are no accounts with ACCESS_ADMIN and marker is not ACTIVE")
 	}
+	if len(ma.RequiredAttributes) > 0 && ma.MarkerType != MarkerType_RestrictedCoin {
+		return fmt.Errorf("required attributes are only supported on restricted markers")
+	}
+	for _, name := range ma.RequiredAttributes {
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("required attribute names cannot be empty")
+		}
+	}
+	if ma.AllowForcedTransfer && ma.MarkerType != MarkerType_RestrictedCoin {
+		return fmt.Errorf("forced transfers are only supported on restricted markers")
+	}
 	return ma.BaseAccount.Validate()
 }
 
@@ -9,16 +9,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -101,13 +105,15 @@ func (MarkerStatus) EnumDescriptor() ([]byte, []int) {
 
 // Params defines the set of params for the account module.
 type Params struct {
-	// maximum amount of supply to allow a marker to be created with
-	MaxTotalSupply uint64 `protobuf:"varint,1,opt,name=max_total_supply,json=maxTotalSupply,proto3,customtype=uint64" json:"max_total_supply"`
+	// maximum amount of supply to allow a marker to be created with (deprecated, limited to a uint64, see max_supply)
+	MaxTotalSupply uint64 `protobuf:"varint,1,opt,name=max_total_supply,json=maxTotalSupply,proto3,customtype=uint64" json:"max_total_supply"` // Deprecated: Do not use.
 	// indicates if governance based controls of markers is allowed.
 	EnableGovernance bool `protobuf:"varint,2,opt,name=enable_governance,json=enableGovernance,proto3" json:"enable_governance,omitempty"`
 	// a regular expression used to validate marker denom values from normal create requests (governance
 	// requests are only subject to platform coin validation denom expression)
 	UnrestrictedDenomRegex string `protobuf:"bytes,3,opt,name=unrestricted_denom_regex,json=unrestrictedDenomRegex,proto3" json:"unrestricted_denom_regex,omitempty"`
+	// maximum amount of supply to allow a marker to be created with or increased to
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -178,6 +184,12 @@ type MarkerAccount struct {
 	SupplyFixed bool `protobuf:"varint,8,opt,name=supply_fixed,json=supplyFixed,proto3" json:"supply_fixed,omitempty"`
 	// indicates that governance based control is allowed for this marker
 	AllowGovernanceControl bool `protobuf:"varint,9,opt,name=allow_governance_control,json=allowGovernanceControl,proto3" json:"allow_governance_control,omitempty"`
+	// the account attribute names a recipient must have to be sent a restricted marker coin, sends of restricted
+	// marker coins with no required attributes must be brokered by an account with the transfer access right.
+	RequiredAttributes []string `protobuf:"bytes,10,rep,name=required_attributes,json=requiredAttributes,proto3" json:"required_attributes,omitempty"`
+	// indicates that accounts with the force transfer access right may move this restricted marker coin out of any
+	// holder account without the signature of the holder.
+	AllowForcedTransfer bool `protobuf:"varint,11,opt,name=allow_forced_transfer,json=allowForcedTransfer,proto3" json:"allow_forced_transfer,omitempty"`
 }
 
 func (m *MarkerAccount) Reset()      { *m = MarkerAccount{} }
@@ -212,76 +224,245 @@ func (m *MarkerAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarkerAccount proto.InternalMessageInfo
 
+// MintSchedule is the set of scheduled supply increases of a marker
+type MintSchedule struct {
+	// the denom of the marker to mint coin for
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the pending supply increases ordered by the time they are due
+	Entries []MintScheduleEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+	// the account that set the schedule, its access to the marker is checked when each entry is executed.  Empty for a
+	// schedule set by governance.
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *MintSchedule) Reset()         { *m = MintSchedule{} }
+func (m *MintSchedule) String() string { return proto.CompactTextString(m) }
+func (*MintSchedule) ProtoMessage()    {}
+func (*MintSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{2}
+}
+func (m *MintSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintSchedule.Merge(m, src)
+}
+func (m *MintSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintSchedule proto.InternalMessageInfo
+
+func (m *MintSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MintSchedule) GetEntries() []MintScheduleEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *MintSchedule) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// MintScheduleEntry is a single scheduled supply increase of a marker
+type MintScheduleEntry struct {
+	// the block time at or after which the coin is minted
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// the amount of coin to mint
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// an optional address to withdraw the minted coin to, the minted coin stays in the marker account when empty
+	ToAddress string `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+}
+
+func (m *MintScheduleEntry) Reset()         { *m = MintScheduleEntry{} }
+func (m *MintScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*MintScheduleEntry) ProtoMessage()    {}
+func (*MintScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{3}
+}
+func (m *MintScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintScheduleEntry.Merge(m, src)
+}
+func (m *MintScheduleEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintScheduleEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintScheduleEntry proto.InternalMessageInfo
+
+func (m *MintScheduleEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *MintScheduleEntry) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("provenance.marker.v1.MarkerType", MarkerType_name, MarkerType_value)
 	proto.RegisterEnum("provenance.marker.v1.MarkerStatus", MarkerStatus_name, MarkerStatus_value)
 	proto.RegisterType((*Params)(nil), "provenance.marker.v1.Params")
 	proto.RegisterType((*MarkerAccount)(nil), "provenance.marker.v1.MarkerAccount")
+	proto.RegisterType((*MintSchedule)(nil), "provenance.marker.v1.MintSchedule")
+	proto.RegisterType((*MintScheduleEntry)(nil), "provenance.marker.v1.MintScheduleEntry")
 }
 
 func init() { proto.RegisterFile("provenance/marker/v1/marker.proto", fileDescriptor_f7e2c25c71db7f99) }
 
 var fileDescriptor_f7e2c25c71db7f99 = []byte{
-	// 908 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4f, 0x6f, 0xe3, 0x44,
-	0x1c, 0xb5, 0xdb, 0x34, 0x4d, 0x27, 0xdd, 0x60, 0xbc, 0x55, 0xd7, 0x1b, 0x56, 0xb6, 0x9b, 0xc3,
-	0x12, 0x15, 0x9a, 0xd0, 0x80, 0x56, 0xab, 0xdc, 0xf2, 0xc7, 0x5d, 0x45, 0x74, 0xd3, 0xc8, 0x49,
-	0x91, 0xba, 0x17, 0x33, 0x71, 0x66, 0xb3, 0xa6, 0xf6, 0x4c, 0xb0, 0x27, 0xd9, 0x84, 0x0f, 0x80,
-	0x56, 0x39, 0x71, 0xe4, 0x40, 0xa4, 0x4a, 0x70, 0xe0, 0x0e, 0x67, 0xce, 0x3d, 0x56, 0x9c, 0x10,
-	0x87, 0x08, 0xb5, 0x17, 0xce, 0xfd, 0x04, 0xc8, 0x33, 0x6e, 0xe2, 0x42, 0xc4, 0x29, 0x99, 0x79,
-	0xef, 0xf7, 0xef, 0xfd, 0xde, 0x18, 0xec, 0x0d, 0x7c, 0x32, 0x42, 0x18, 0x62, 0x1b, 0x15, 0x3d,
-	0xe8, 0x9f, 0x23, 0xbf, 0x38, 0x3a, 0x8c, 0xfe, 0x15, 0x06, 0x3e, 0xa1, 0x44, 0xde, 0x59, 0x52,
-	0x0a, 0x11, 0x30, 0x3a, 0xcc, 0xee, 0xf4, 0x49, 0x9f, 0x30, 0x42, 0x31, 0xfc, 0xc7, 0xb9, 0x59,
-	0xd5, 0x26, 0x81, 0x47, 0x82, 0x22, 0x1c, 0xd2, 0x37, 0xc5, 0xd1, 0x61, 0x17, 0x51, 0x78, 0xc8,
-	0x0e, 0x11, 0xfe, 0x98, 0xe3, 0x16, 0x0f, 0xe4, 0x87, 0x08, 0x7a, 0xba, 0xb2, 0x13, 0x68, 0xdb,
-	0x28, 0x08, 0xfa, 0x3e, 0xc4, 0x94, 0xf3, 0x72, 0xbf, 0x88, 0x20, 0xd9, 0x82, 0x3e, 0xf4, 0x02,
-	0xf9, 0x39, 0x90, 0x3c, 0x38, 0xb6, 0x28, 0xa1, 0xd0, 0xb5, 0x82, 0xe1, 0x60, 0xe0, 0x4e, 0x14,
-	0x51, 0x17, 0xf3, 0x89, 0x6a, 0xe6, 0x72, 0xae, 0x09, 0x7f, 0xce, 0xb5, 0xe4, 0xd0, 0xc1, 0xf4,
-	0xd9, 0x67, 0x66, 0xc6, 0x83, 0xe3, 0x4e, 0x48, 0x6b, 0x33, 0x96, 0xfc, 0x11, 0x78, 0x1f, 0x61,
-	0xd8, 0x75, 0x91, 0xd5, 0x27, 0x23, 0xe4, 0xb3, 0xaa, 0xca, 0x9a, 0x2e, 0xe6, 0x53, 0xa6, 0xc4,
-	0x81, 0x17, 0x8b, 0x7b, 0xf9, 0x39, 0x50, 0x86, 0xd8, 0x47, 0x01, 0xf5, 0x1d, 0x9b, 0xa2, 0x9e,
-	0xd5, 0x43, 0x98, 0x78, 0x96, 0x8f, 0xfa, 0x68, 0xac, 0xac, 0xeb, 0x62, 0x7e, 0xcb, 0xdc, 0x8d,
-	0xe3, 0xf5, 0x10, 0x36, 0x43, 0xb4, 0x9c, 0xfa, 0xfe, 0x42, 0x13, 0xfe, 0xbe, 0xd0, 0x84, 0xdc,
-	0x0f, 0x1b, 0xe0, 0xc1, 0x4b, 0x36, 0x55, 0xc5, 0xb6, 0xc9, 0x10, 0x53, 0xf9, 0x4b, 0xb0, 0xdd,
-	0x85, 0x01, 0xb2, 0x20, 0x3f, 0xb3, 0xc6, 0xd3, 0x25, 0xbd, 0x10, 0x89, 0xc2, 0x44, 0x8b, 0x14,
-	0x2c, 0x54, 0x61, 0x80, 0xa2, 0xb8, 0xea, 0x07, 0x57, 0x73, 0x4d, 0xbc, 0x9d, 0x6b, 0x0f, 0x27,
-	0xd0, 0x73, 0xcb, 0xb9, 0x78, 0x8e, 0x9c, 0x99, 0xee, 0x2e, 0x99, 0xf2, 0x33, 0xb0, 0xe9, 0x41,
-	0x0c, 0xfb, 0xc8, 0x67, 0xa3, 0x6d, 0x55, 0x9f, 0xdc, 0xce, 0x35, 0xe5, 0xab, 0x80, 0xe0, 0x72,
-	0x2e, 0x02, 0x3e, 0x26, 0x9e, 0x43, 0x91, 0x37, 0xa0, 0x93, 0x9c, 0x79, 0x47, 0x96, 0x9b, 0x20,
-	0xc3, 0x65, 0xb7, 0x6c, 0x82, 0xa9, 0x4f, 0x5c, 0x65, 0x5d, 0x5f, 0xcf, 0xa7, 0x4b, 0x7b, 0x85,
-	0x55, 0x4e, 0x28, 0x54, 0x18, 0xf7, 0x45, 0xb8, 0xa2, 0x6a, 0x22, 0xd4, 0xdd, 0x7c, 0xc0, 0xc3,
-	0x6b, 0x3c, 0x5a, 0x2e, 0x83, 0x64, 0x40, 0x21, 0x1d, 0x06, 0x4a, 0x42, 0x17, 0xf3, 0x99, 0x52,
-	0x6e, 0x75, 0x1e, 0x2e, 0x4f, 0x9b, 0x31, 0xcd, 0x28, 0x42, 0xde, 0x01, 0x1b, 0x4c, 0x6e, 0x65,
-	0x83, 0x09, 0xcd, 0x0f, 0xf2, 0xd7, 0x20, 0x19, 0xad, 0x3b, 0xc9, 0x06, 0x3b, 0x8b, 0xd6, 0xfd,
-	0xb4, 0xef, 0xd0, 0x37, 0xc3, 0x6e, 0xc1, 0x26, 0x5e, 0x64, 0xae, 0xe8, 0xe7, 0x20, 0xe8, 0x9d,
-	0x17, 0xe9, 0x64, 0x80, 0x82, 0x42, 0x03, 0xd3, 0xdb, 0xb9, 0xf6, 0x21, 0x97, 0x21, 0x6e, 0x9d,
-	0x9c, 0xce, 0x15, 0xbd, 0x77, 0x67, 0x46, 0x85, 0x64, 0x1b, 0xa4, 0x79, 0xab, 0x56, 0x98, 0x46,
-	0xd9, 0x64, 0x93, 0xe8, 0xff, 0x37, 0x49, 0x67, 0x32, 0x40, 0x55, 0xfd, 0x76, 0xae, 0x3d, 0xb9,
-	0x93, 0x7c, 0x11, 0x1e, 0x97, 0x1d, 0x78, 0x0b, 0xb6, 0xbc, 0x07, 0xb6, 0x79, 0x39, 0xeb, 0xb5,
-	0x33, 0x46, 0x3d, 0x25, 0xc5, 0x1c, 0x99, 0xe6, 0x77, 0x47, 0xe1, 0x55, 0x68, 0x46, 0xe8, 0xba,
-	0xe4, 0x6d, 0xcc, 0xb8, 0x8b, 0x35, 0x6d, 0x31, 0xfa, 0x2e, 0xc3, 0x97, 0xfe, 0x8d, 0xd6, 0x50,
-	0xce, 0xbe, 0xbb, 0xd0, 0x84, 0xd0, 0x90, 0xbf, 0xff, 0x7a, 0x90, 0xb9, 0xe7, 0xc5, 0xc6, 0xfe,
-	0xb7, 0x22, 0x00, 0xcb, 0xae, 0xe5, 0x3c, 0x78, 0xf4, 0xb2, 0x62, 0x7e, 0x6e, 0x98, 0x56, 0xe7,
-	0xac, 0x65, 0x58, 0xa7, 0xcd, 0x76, 0xcb, 0xa8, 0x35, 0x8e, 0x1a, 0x46, 0x5d, 0x12, 0xb2, 0xe9,
-	0xe9, 0x4c, 0xdf, 0x3c, 0xc5, 0xe7, 0x98, 0xbc, 0xc5, 0xb2, 0x0a, 0xa4, 0x38, 0xb3, 0x76, 0xd2,
-	0x68, 0x4a, 0x62, 0x36, 0x35, 0x9d, 0xe9, 0x89, 0x1a, 0x71, 0xb0, 0x5c, 0x00, 0xbb, 0x71, 0xdc,
-	0x34, 0xda, 0x1d, 0xb3, 0x51, 0xeb, 0x18, 0x75, 0x69, 0x2d, 0x2b, 0x4f, 0x67, 0x7a, 0xc6, 0x5c,
-	0xbc, 0x9b, 0x90, 0xbf, 0xff, 0xdb, 0x1a, 0xd8, 0x8e, 0x1b, 0x41, 0x2e, 0x81, 0xc7, 0x51, 0x82,
-	0x76, 0xa7, 0xd2, 0x39, 0x6d, 0xff, 0xab, 0x99, 0x87, 0xd3, 0x99, 0xfe, 0x1e, 0xa7, 0x9e, 0xe2,
-	0x1e, 0x7a, 0xed, 0x60, 0xd4, 0x8b, 0x15, 0x8d, 0x62, 0x5a, 0xe6, 0x49, 0xeb, 0xa4, 0x6d, 0xd4,
-	0x25, 0x91, 0x17, 0xe5, 0x01, 0x2d, 0x9f, 0x0c, 0x48, 0x80, 0x7a, 0xf2, 0x27, 0x8b, 0x71, 0x23,
-	0xfe, 0x51, 0xa3, 0x59, 0x39, 0x6e, 0xbc, 0x62, 0x5d, 0xc6, 0x2a, 0x1c, 0x39, 0x18, 0xba, 0xce,
-	0x37, 0xa8, 0x27, 0xef, 0x83, 0x9d, 0xfb, 0x11, 0x95, 0x5a, 0xa7, 0xf1, 0x85, 0x21, 0xad, 0x67,
-	0xa5, 0xe9, 0x4c, 0xdf, 0xe6, 0xf4, 0x8a, 0x4d, 0x9d, 0x11, 0xfa, 0x6f, 0xf6, 0x5a, 0xa5, 0x59,
-	0x33, 0x8e, 0x8f, 0x8d, 0xba, 0x94, 0x88, 0x67, 0xaf, 0x85, 0xcb, 0x72, 0xdd, 0x55, 0xfd, 0xd4,
-	0x43, 0xd9, 0x4e, 0xce, 0x8c, 0xba, 0xb4, 0x11, 0x8f, 0xa8, 0x87, 0xda, 0x91, 0x09, 0xea, 0x65,
-	0x53, 0xef, 0x7e, 0x54, 0x85, 0x9f, 0x7f, 0x52, 0x85, 0x6a, 0xff, 0xf2, 0x5a, 0x15, 0xaf, 0xae,
-	0x55, 0xf1, 0xaf, 0x6b, 0x55, 0xfc, 0xee, 0x46, 0x15, 0xae, 0x6e, 0x54, 0xe1, 0x8f, 0x1b, 0x55,
-	0x00, 0x8f, 0x1c, 0xb2, 0xd2, 0xae, 0x2d, 0xf1, 0x55, 0x29, 0xf6, 0x6e, 0x96, 0x94, 0x03, 0x87,
-	0xc4, 0x4e, 0xc5, 0xf1, 0xdd, 0x67, 0x99, 0xbd, 0xa3, 0x6e, 0x92, 0x7d, 0x8e, 0x3f, 0xfd, 0x27,
-	0x00, 0x00, 0xff, 0xff, 0xbb, 0x98, 0x63, 0x30, 0x42, 0x06, 0x00, 0x00,
+	// 1142 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xf6, 0x26, 0xae, 0x93, 0x8c, 0xd3, 0xfc, 0xb6, 0x93, 0xfc, 0xda, 0xad, 0x29, 0xde, 0xad,
+	0x85, 0xda, 0xa8, 0xd0, 0x35, 0x09, 0xa8, 0xaa, 0x7c, 0xf3, 0x9f, 0x4d, 0x65, 0xd1, 0x24, 0xd6,
+	0xda, 0x41, 0x6a, 0x2f, 0xcb, 0x78, 0x77, 0xe2, 0x2e, 0xdd, 0x9d, 0x71, 0x67, 0xc7, 0x69, 0xcc,
+	0x0d, 0x09, 0xa1, 0x2a, 0xa7, 0x4a, 0x5c, 0xb8, 0x44, 0x8a, 0x04, 0x07, 0x3e, 0x00, 0x27, 0x0e,
+	0x9c, 0x7b, 0xac, 0x38, 0x21, 0x0e, 0x06, 0xb5, 0x97, 0x9e, 0xf3, 0x09, 0xd0, 0xce, 0x8c, 0x93,
+	0x0d, 0x0d, 0x48, 0x70, 0x8a, 0x67, 0x9e, 0xe7, 0xfd, 0xb3, 0xcf, 0xfb, 0xbc, 0x13, 0x70, 0x7d,
+	0xc8, 0xe8, 0x1e, 0x26, 0x88, 0xf8, 0xb8, 0x1a, 0x23, 0xf6, 0x18, 0xb3, 0xea, 0xde, 0x9a, 0xfa,
+	0x65, 0x0f, 0x19, 0xe5, 0x14, 0xae, 0x9c, 0x52, 0x6c, 0x05, 0xec, 0xad, 0x95, 0x56, 0x06, 0x74,
+	0x40, 0x05, 0xa1, 0x9a, 0xfe, 0x92, 0xdc, 0x92, 0x39, 0xa0, 0x74, 0x10, 0xe1, 0xaa, 0x38, 0xf5,
+	0x47, 0xbb, 0x55, 0x1e, 0xc6, 0x38, 0xe1, 0x28, 0x1e, 0x2a, 0x42, 0xd9, 0xa7, 0x49, 0x4c, 0x93,
+	0x2a, 0x1a, 0xf1, 0x47, 0xd5, 0xbd, 0xb5, 0x3e, 0xe6, 0x68, 0x4d, 0x1c, 0x14, 0x7e, 0x55, 0xe2,
+	0x9e, 0xcc, 0x2c, 0x0f, 0x0a, 0xba, 0x71, 0x6e, 0xab, 0xc8, 0xf7, 0x71, 0x92, 0x0c, 0x18, 0x22,
+	0x5c, 0xf2, 0x2a, 0x5f, 0xcd, 0x80, 0x42, 0x07, 0x31, 0x14, 0x27, 0xb0, 0x06, 0xf4, 0x18, 0xed,
+	0x7b, 0x9c, 0x72, 0x14, 0x79, 0xc9, 0x68, 0x38, 0x8c, 0xc6, 0x86, 0x66, 0x69, 0xab, 0xf9, 0x86,
+	0xfe, 0x62, 0x62, 0xe6, 0x7e, 0x9b, 0x98, 0x85, 0x51, 0x48, 0xf8, 0x9d, 0x8f, 0x0d, 0xcd, 0x5d,
+	0x8a, 0xd1, 0x7e, 0x2f, 0x25, 0x76, 0x05, 0x0f, 0xbe, 0x0f, 0x2e, 0x61, 0x82, 0xfa, 0x11, 0xf6,
+	0x06, 0x74, 0x0f, 0x33, 0x51, 0xd7, 0x98, 0xb1, 0xb4, 0xd5, 0x79, 0x57, 0x97, 0xc0, 0xbd, 0x93,
+	0x7b, 0x78, 0x17, 0x18, 0x23, 0xc2, 0x70, 0xc2, 0x59, 0xe8, 0x73, 0x1c, 0x78, 0x01, 0x26, 0x34,
+	0xf6, 0x18, 0x1e, 0xe0, 0x7d, 0x63, 0xd6, 0xd2, 0x56, 0x17, 0xdc, 0xcb, 0x59, 0xbc, 0x95, 0xc2,
+	0x6e, 0x8a, 0xc2, 0x4d, 0x00, 0xd2, 0x16, 0x55, 0x73, 0xf9, 0x94, 0xdb, 0xb0, 0x55, 0x73, 0x37,
+	0x06, 0x21, 0x7f, 0x34, 0xea, 0xdb, 0x3e, 0x8d, 0x95, 0x14, 0xea, 0xcf, 0xed, 0x24, 0x78, 0x5c,
+	0xe5, 0xe3, 0x21, 0x4e, 0xec, 0x36, 0xe1, 0xee, 0x42, 0x8c, 0xf6, 0x65, 0xd7, 0xb5, 0xf9, 0x6f,
+	0x8f, 0xcc, 0xdc, 0x9b, 0x23, 0x33, 0x57, 0xf9, 0xb2, 0x00, 0x2e, 0x6e, 0x0a, 0x99, 0xea, 0xbe,
+	0x4f, 0x47, 0x84, 0xc3, 0xcf, 0xc0, 0x62, 0x1f, 0x25, 0xd8, 0x43, 0xf2, 0x2c, 0x94, 0x28, 0xae,
+	0x5b, 0xb6, 0x52, 0x59, 0x4c, 0x41, 0x8d, 0xc4, 0x6e, 0xa0, 0x04, 0xab, 0xb8, 0xc6, 0x3b, 0x2f,
+	0x27, 0xa6, 0x76, 0x3c, 0x31, 0x97, 0xc7, 0x28, 0x8e, 0x6a, 0x95, 0x6c, 0x8e, 0x8a, 0x5b, 0xec,
+	0x9f, 0x32, 0xe1, 0x1d, 0x30, 0x17, 0x23, 0x82, 0x06, 0x98, 0x09, 0xa5, 0x16, 0x1a, 0xd7, 0x8e,
+	0x27, 0xa6, 0xf1, 0x79, 0x42, 0x49, 0xad, 0xa2, 0x80, 0x0f, 0x68, 0x1c, 0x72, 0x1c, 0x0f, 0xf9,
+	0xb8, 0xe2, 0x4e, 0xc9, 0x70, 0x0b, 0x2c, 0xc9, 0x39, 0x7a, 0x3e, 0x25, 0x9c, 0xd1, 0xc8, 0x98,
+	0xb5, 0x66, 0x57, 0x8b, 0xeb, 0xd7, 0xed, 0xf3, 0xbc, 0x67, 0xd7, 0x05, 0xf7, 0x5e, 0x3a, 0xf3,
+	0x46, 0x3e, 0xd5, 0xca, 0xbd, 0x28, 0xc3, 0x9b, 0x32, 0x1a, 0xd6, 0x40, 0x21, 0xe1, 0x88, 0x8f,
+	0x12, 0x21, 0xe8, 0xd2, 0x7a, 0xe5, 0xfc, 0x3c, 0x52, 0x9e, 0xae, 0x60, 0xba, 0x2a, 0x02, 0xae,
+	0x80, 0x0b, 0x62, 0x7a, 0xc6, 0x05, 0x31, 0x37, 0x79, 0x80, 0x4f, 0x40, 0x41, 0x8d, 0xa8, 0x20,
+	0x3e, 0xec, 0xc1, 0xbf, 0x1b, 0xd1, 0xf1, 0xc4, 0xbc, 0x29, 0x65, 0xc8, 0x7a, 0xb1, 0x62, 0x49,
+	0x45, 0xcf, 0xdc, 0xb9, 0xaa, 0x10, 0xf4, 0x41, 0x51, 0xb6, 0xea, 0xa5, 0x69, 0x8c, 0x39, 0xf1,
+	0x25, 0xd6, 0x3f, 0x7d, 0x49, 0x6f, 0x3c, 0xc4, 0x0d, 0xeb, 0x78, 0x62, 0x5e, 0x9b, 0x4a, 0x7e,
+	0x12, 0x9e, 0x95, 0x1d, 0xc4, 0x27, 0x6c, 0x78, 0x1d, 0x2c, 0xca, 0x72, 0xde, 0x6e, 0xb8, 0x8f,
+	0x03, 0x63, 0x5e, 0x18, 0xbc, 0x28, 0xef, 0x36, 0xd2, 0xab, 0xd4, 0xdb, 0x28, 0x8a, 0xe8, 0xd3,
+	0xcc, 0x1e, 0x9c, 0x8c, 0x69, 0x41, 0xd0, 0x2f, 0x0b, 0xfc, 0x74, 0x1d, 0xa6, 0x63, 0xa8, 0x82,
+	0x65, 0x86, 0x9f, 0x8c, 0x42, 0x86, 0x03, 0x0f, 0x71, 0xce, 0xc2, 0xfe, 0x88, 0xe3, 0xc4, 0x00,
+	0xd6, 0xec, 0xea, 0x82, 0x0b, 0xa7, 0x50, 0xfd, 0x04, 0x81, 0xeb, 0xe0, 0xff, 0xb2, 0xd4, 0x2e,
+	0x65, 0x3e, 0x0e, 0x3c, 0xce, 0x10, 0x49, 0x76, 0x31, 0x33, 0x8a, 0xa2, 0xce, 0xb2, 0x00, 0x37,
+	0x04, 0xd6, 0x53, 0x50, 0xad, 0xf4, 0xec, 0xc8, 0xcc, 0xa5, 0xae, 0xff, 0xe5, 0xc7, 0xdb, 0x4b,
+	0x67, 0x0c, 0xdf, 0xae, 0x7c, 0xa3, 0x81, 0xc5, 0xcd, 0x90, 0xf0, 0xae, 0xff, 0x08, 0x07, 0xa3,
+	0x08, 0x9f, 0x0e, 0x57, 0xcb, 0x0e, 0xf7, 0x1e, 0x98, 0xc3, 0x84, 0xb3, 0x10, 0x27, 0xc6, 0x8c,
+	0xf0, 0xdd, 0xcd, 0xbf, 0x51, 0x39, 0x93, 0xca, 0x21, 0x9c, 0x8d, 0x95, 0xfb, 0xa6, 0xd1, 0xf0,
+	0x3d, 0x70, 0x11, 0x05, 0x71, 0x48, 0xc2, 0x84, 0x33, 0xc4, 0x29, 0x53, 0xbb, 0x7f, 0xf6, 0xb2,
+	0xf2, 0x93, 0x06, 0x2e, 0xbd, 0x95, 0x0a, 0xde, 0x05, 0xf9, 0xf4, 0xb1, 0x54, 0x5b, 0x59, 0xb2,
+	0xe5, 0x4b, 0x6a, 0x4f, 0x5f, 0x52, 0xbb, 0x37, 0x7d, 0x49, 0x1b, 0xf3, 0x69, 0xd1, 0xe7, 0xbf,
+	0x9b, 0x9a, 0x2b, 0x22, 0xe0, 0x06, 0x28, 0xa0, 0x58, 0x6c, 0xf4, 0xcc, 0x7f, 0x7a, 0x3e, 0x54,
+	0x34, 0x7c, 0x17, 0x00, 0x4e, 0x3d, 0x14, 0x04, 0x0c, 0x27, 0x89, 0x6a, 0x7d, 0x81, 0xd3, 0xba,
+	0xbc, 0xa8, 0xe5, 0xdf, 0x1c, 0x99, 0xda, 0xad, 0xaf, 0x35, 0x00, 0x4e, 0xdd, 0x06, 0x57, 0xc1,
+	0x95, 0xcd, 0xba, 0xfb, 0x89, 0xe3, 0x7a, 0xbd, 0x07, 0x1d, 0xc7, 0xdb, 0xd9, 0xea, 0x76, 0x9c,
+	0x66, 0x7b, 0xa3, 0xed, 0xb4, 0xf4, 0x5c, 0xa9, 0x78, 0x70, 0x68, 0xcd, 0xed, 0x90, 0xc7, 0x84,
+	0x3e, 0x25, 0xb0, 0x0c, 0xf4, 0x2c, 0xb3, 0xb9, 0xdd, 0xde, 0xd2, 0xb5, 0xd2, 0xfc, 0xc1, 0xa1,
+	0x95, 0x6f, 0xd2, 0x90, 0x40, 0x1b, 0x5c, 0xce, 0xe2, 0xae, 0xd3, 0xed, 0xb9, 0xed, 0x66, 0xcf,
+	0x69, 0xe9, 0x33, 0x25, 0x78, 0x70, 0x68, 0x2d, 0xb9, 0x27, 0xcf, 0x67, 0xca, 0xbf, 0xf5, 0xf3,
+	0x0c, 0x58, 0xcc, 0x2e, 0x30, 0x5c, 0x07, 0x57, 0x55, 0x82, 0x6e, 0xaf, 0xde, 0xdb, 0xe9, 0xfe,
+	0xa5, 0x99, 0xe5, 0x83, 0x43, 0xeb, 0x7f, 0x92, 0xba, 0x43, 0x02, 0xbc, 0x1b, 0x12, 0x1c, 0x64,
+	0x8a, 0xaa, 0x98, 0x8e, 0xbb, 0xdd, 0xd9, 0xee, 0x3a, 0x2d, 0x5d, 0x93, 0x45, 0x65, 0x40, 0x87,
+	0xd1, 0x21, 0x4d, 0x70, 0x00, 0x3f, 0x04, 0x57, 0xce, 0xf2, 0x37, 0xda, 0x5b, 0xf5, 0xfb, 0xed,
+	0x87, 0xa2, 0xcb, 0x4c, 0x85, 0x8d, 0x90, 0xa0, 0x28, 0xfc, 0x02, 0x07, 0xf0, 0x16, 0x58, 0x39,
+	0x1b, 0x51, 0x6f, 0xf6, 0xda, 0x9f, 0x3a, 0xfa, 0x6c, 0x49, 0x3f, 0x38, 0xb4, 0x16, 0x25, 0xbd,
+	0xee, 0xf3, 0x70, 0x0f, 0xbf, 0x9d, 0xbd, 0x59, 0xdf, 0x6a, 0x3a, 0xf7, 0xef, 0x3b, 0x2d, 0x3d,
+	0x9f, 0xcd, 0xde, 0x4c, 0x7d, 0x19, 0x45, 0xe7, 0xf5, 0xd3, 0x4a, 0x65, 0xdb, 0x7e, 0xe0, 0xb4,
+	0xf4, 0x0b, 0xd9, 0x88, 0x56, 0xaa, 0x1d, 0x1d, 0xe3, 0xa0, 0x34, 0xff, 0xec, 0xbb, 0x72, 0xee,
+	0x87, 0xef, 0xcb, 0xb9, 0xc6, 0xe0, 0xc5, 0xab, 0xb2, 0xf6, 0xf2, 0x55, 0x59, 0xfb, 0xe3, 0x55,
+	0x59, 0x7b, 0xfe, 0xba, 0x9c, 0x7b, 0xf9, 0xba, 0x9c, 0xfb, 0xf5, 0x75, 0x39, 0x07, 0xae, 0x84,
+	0xf4, 0xdc, 0x05, 0xe8, 0x68, 0x0f, 0xd7, 0x33, 0x9e, 0x3a, 0xa5, 0xdc, 0x0e, 0x69, 0xe6, 0x54,
+	0xdd, 0x9f, 0xfe, 0x7f, 0x16, 0x1e, 0xeb, 0x17, 0x84, 0x87, 0x3f, 0xfa, 0x73, 0x00, 0x34, 0xea,
+	0x94, 0xbc, 0x6c, 0x08, 0x00, 0x00,
 }
 
+func (this *MintScheduleEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintScheduleEntry)
+	if !ok {
+		that2, ok := that.(MintScheduleEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.ToAddress != that1.ToAddress {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -302,6 +483,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.UnrestrictedDenomRegex) > 0 {
 		i -= len(m.UnrestrictedDenomRegex)
 		copy(dAtA[i:], m.UnrestrictedDenomRegex)
@@ -347,6 +538,25 @@ func (m *MarkerAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllowForcedTransfer {
+		i--
+		if m.AllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.AllowGovernanceControl {
 		i--
 		if m.AllowGovernanceControl {
@@ -430,6 +640,105 @@ func (m *MarkerAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MintSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMarker(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMarker(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMarker(v)
 	base := offset
@@ -457,6 +766,8 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMarker(uint64(l))
 	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMarker(uint64(l))
 	return n
 }
 
@@ -498,6 +809,55 @@ func (m *MarkerAccount) Size() (n int) {
 	if m.AllowGovernanceControl {
 		n += 2
 	}
+	if len(m.RequiredAttributes) > 0 {
+		for _, s := range m.RequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	if m.AllowForcedTransfer {
+		n += 2
+	}
+	return n
+}
+
+func (m *MintSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *MintScheduleEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMarker(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
 	return n
 }
 
@@ -607,6 +967,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.UnrestrictedDenomRegex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarker(dAtA[iNdEx:])
@@ -903,6 +1297,355 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowGovernanceControl = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowForcedTransfer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, MintScheduleEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintScheduleEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintScheduleEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarker(dAtA[iNdEx:])
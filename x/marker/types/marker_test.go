@@ -133,6 +133,24 @@ func TestNewMarkerValidate(t *testing.T) {
 				Permissions: []Access{Access_Mint, Access_Admin}}}, StatusProposed, MarkerType_Coin),
 			fmt.Errorf("permissions cannot be granted to 'test' marker account: [ACCESS_MINT ACCESS_ADMIN]"),
 		},
+		{
+			"required attributes on a coin marker",
+			&MarkerAccount{BaseAccount: baseAcc, Manager: manager.String(), Status: StatusProposed, Denom: "test",
+				Supply: sdk.OneInt(), MarkerType: MarkerType_Coin, RequiredAttributes: []string{"kyc.provenance.io"}},
+			fmt.Errorf("required attributes are only supported on restricted markers"),
+		},
+		{
+			"empty required attribute name",
+			&MarkerAccount{BaseAccount: baseAcc, Manager: manager.String(), Status: StatusProposed, Denom: "test",
+				Supply: sdk.OneInt(), MarkerType: MarkerType_RestrictedCoin, RequiredAttributes: []string{" "}},
+			fmt.Errorf("required attribute names cannot be empty"),
+		},
+		{
+			"valid restricted marker account with required attributes",
+			&MarkerAccount{BaseAccount: baseAcc, Manager: manager.String(), Status: StatusProposed, Denom: "test",
+				Supply: sdk.OneInt(), MarkerType: MarkerType_RestrictedCoin, RequiredAttributes: []string{"kyc.provenance.io"}},
+			nil,
+		},
 		{
 			"valid marker account",
 			NewMarkerAccount(baseAcc, sdk.NewCoin("test", sdk.OneInt()), manager, nil, StatusProposed, MarkerType_Coin),
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxMintScheduleEntries is the largest number of scheduled supply increases a marker may have pending.
+const MaxMintScheduleEntries = 100
+
+// NewMintScheduleEntry creates a new scheduled supply increase, the to address is optional.
+func NewMintScheduleEntry(at time.Time, amount sdk.Int, toAddress string) MintScheduleEntry {
+	return MintScheduleEntry{
+		Time:      at,
+		Amount:    amount,
+		ToAddress: toAddress,
+	}
+}
+
+// Validate performs stateless checks of the scheduled supply increase.
+func (e MintScheduleEntry) Validate() error {
+	if e.Time.IsZero() {
+		return fmt.Errorf("mint schedule entry time cannot be empty")
+	}
+	if e.Amount.IsNil() || !e.Amount.IsPositive() {
+		return fmt.Errorf("mint schedule entry amount must be positive")
+	}
+	if len(e.ToAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(e.ToAddress); err != nil {
+			return fmt.Errorf("invalid mint schedule entry to address: %w", err)
+		}
+	}
+	return nil
+}
+
+// ValidateMintScheduleEntries checks the number of scheduled supply increases and each of the entries.
+func ValidateMintScheduleEntries(entries []MintScheduleEntry) error {
+	if len(entries) > MaxMintScheduleEntries {
+		return fmt.Errorf("mint schedule cannot have more than %d entries", MaxMintScheduleEntries)
+	}
+	for _, e := range entries {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SortMintScheduleEntries orders the scheduled supply increases by the time they are due.
+func SortMintScheduleEntries(entries []MintScheduleEntry) {
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Time.Before(entries[j].Time) })
+}
+
+// Validate performs stateless checks of the mint schedule of a marker.
+func (s MintSchedule) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return fmt.Errorf("invalid mint schedule denom: %w", err)
+	}
+	if len(s.Entries) == 0 {
+		return fmt.Errorf("mint schedule for %s has no entries", s.Denom)
+	}
+	if len(s.Administrator) > 0 {
+		if _, err := sdk.AccAddressFromBech32(s.Administrator); err != nil {
+			return fmt.Errorf("invalid mint schedule administrator: %w", err)
+		}
+	}
+	return ValidateMintScheduleEntries(s.Entries)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -9,18 +10,22 @@ import (
 )
 
 const (
-	TypeAddMarkerRequest    = "addmarker"
-	TypeAddAccessRequest    = "addaccess"
-	TypeDeleteAccessRequest = "deleteaccess"
-	TypeFinalizeRequest     = "finalize"
-	TypeActivateRequest     = "activate"
-	TypeCancelRequest       = "cancel"
-	TypeDeleteRequest       = "delete"
-	TypeMintRequest         = "mint"
-	TypeBurnRequest         = "burn"
-	TypeWithdrawRequest     = "withdraw"
-	TypeTransferRequest     = "transfer"
-	TypeSetMetadataRequest  = "setmetadata"
+	TypeAddMarkerRequest       = "addmarker"
+	TypeAddAccessRequest       = "addaccess"
+	TypeDeleteAccessRequest    = "deleteaccess"
+	TypeFinalizeRequest        = "finalize"
+	TypeActivateRequest        = "activate"
+	TypeCancelRequest          = "cancel"
+	TypeDeleteRequest          = "delete"
+	TypeMintRequest            = "mint"
+	TypeBurnRequest            = "burn"
+	TypeWithdrawRequest        = "withdraw"
+	TypeTransferRequest        = "transfer"
+	TypeSetMetadataRequest     = "setmetadata"
+	TypeFreezeHolderRequest    = "freezeholder"
+	TypeUnfreezeHolderRequest  = "unfreezeholder"
+	TypeForceTransferRequest   = "forcetransfer"
+	TypeSetMintScheduleRequest = "setmintschedule"
 )
 
 // Compile time interface check.
@@ -36,6 +41,10 @@ var (
 	_ sdk.Msg = &MsgBurnRequest{}
 	_ sdk.Msg = &MsgWithdrawRequest{}
 	_ sdk.Msg = &MsgTransferRequest{}
+	_ sdk.Msg = &MsgFreezeHolderRequest{}
+	_ sdk.Msg = &MsgUnfreezeHolderRequest{}
+	_ sdk.Msg = &MsgForceTransferRequest{}
+	_ sdk.Msg = &MsgSetMintScheduleRequest{}
 )
 
 // Type returns the message action.
@@ -74,6 +83,18 @@ func (msg MsgTransferRequest) Type() string { return TypeTransferRequest }
 // Type returns the message action.
 func (msg MsgSetDenomMetadataRequest) Type() string { return TypeSetMetadataRequest }
 
+// Type returns the message action.
+func (msg MsgFreezeHolderRequest) Type() string { return TypeFreezeHolderRequest }
+
+// Type returns the message action.
+func (msg MsgUnfreezeHolderRequest) Type() string { return TypeUnfreezeHolderRequest }
+
+// Type returns the message action.
+func (msg MsgForceTransferRequest) Type() string { return TypeForceTransferRequest }
+
+// Type returns the message action.
+func (msg MsgSetMintScheduleRequest) Type() string { return TypeSetMintScheduleRequest }
+
 // NewAddMarkerRequest creates a new marker in a proposed state with a given total supply a denomination
 func NewAddMarkerRequest(
 	denom string, totalSupply sdk.Int, fromAddress sdk.AccAddress, manager sdk.AccAddress, markerType MarkerType, // nolint:interfacer
@@ -109,6 +130,12 @@ func (msg MsgAddMarkerRequest) ValidateBasic() error {
 	if !testCoin.IsValid() {
 		return fmt.Errorf("invalid marker denom/total supply: %w", sdkerrors.ErrInvalidCoins)
 	}
+	if len(msg.RequiredAttributes) > 0 && msg.MarkerType != MarkerType_RestrictedCoin {
+		return fmt.Errorf("required attributes are only supported on restricted markers")
+	}
+	if msg.AllowForcedTransfer && msg.MarkerType != MarkerType_RestrictedCoin {
+		return fmt.Errorf("forced transfers are only supported on restricted markers")
+	}
 
 	return nil
 }
@@ -565,3 +592,170 @@ func (msg MsgSetDenomMetadataRequest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// NewFreezeHolderRequest creates a request to freeze the balance of the marker coin held by an account
+func NewFreezeHolderRequest(denom string, admin sdk.AccAddress, holder sdk.AccAddress) *MsgFreezeHolderRequest { // nolint:interfacer
+	return &MsgFreezeHolderRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Holder:        holder.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgFreezeHolderRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgFreezeHolderRequest) ValidateBasic() error {
+	return validateHolderRequest(msg.Denom, msg.Administrator, msg.Holder)
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgFreezeHolderRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgFreezeHolderRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewUnfreezeHolderRequest creates a request to unfreeze the balance of the marker coin held by an account
+func NewUnfreezeHolderRequest(denom string, admin sdk.AccAddress, holder sdk.AccAddress) *MsgUnfreezeHolderRequest { // nolint:interfacer
+	return &MsgUnfreezeHolderRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Holder:        holder.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgUnfreezeHolderRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgUnfreezeHolderRequest) ValidateBasic() error {
+	return validateHolderRequest(msg.Denom, msg.Administrator, msg.Holder)
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgUnfreezeHolderRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgUnfreezeHolderRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// validateHolderRequest checks the denom, administrator, and holder of a freeze or unfreeze request.
+func validateHolderRequest(denom, administrator, holder string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(administrator); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(holder); err != nil {
+		return fmt.Errorf("invalid holder address: %w", err)
+	}
+	return nil
+}
+
+// NewForceTransferRequest creates a request to recover restricted coin from a holder without the holder signature
+func NewForceTransferRequest(
+	admin, fromAddress, toAddress sdk.AccAddress, amount sdk.Coin, reason string, // nolint:interfacer
+) *MsgForceTransferRequest {
+	return &MsgForceTransferRequest{
+		Administrator: admin.String(),
+		FromAddress:   fromAddress.String(),
+		ToAddress:     toAddress.String(),
+		Amount:        amount,
+		Reason:        reason,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgForceTransferRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgForceTransferRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return err
+	}
+	if strings.TrimSpace(msg.Reason) == "" {
+		return fmt.Errorf("a reason is required for a forced transfer")
+	}
+	if !msg.Amount.IsPositive() {
+		return fmt.Errorf("forced transfer amount must be positive")
+	}
+	return msg.Amount.Validate()
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgForceTransferRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the administrator alone.
+func (msg MsgForceTransferRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewSetMintScheduleRequest creates a request to replace the scheduled supply increases of a marker
+func NewSetMintScheduleRequest(denom string, admin sdk.AccAddress, entries []MintScheduleEntry) *MsgSetMintScheduleRequest { // nolint:interfacer
+	return &MsgSetMintScheduleRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Entries:       entries,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgSetMintScheduleRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetMintScheduleRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	return ValidateMintScheduleEntries(msg.Entries)
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgSetMintScheduleRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgSetMintScheduleRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -2,10 +2,12 @@ package types
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 
 	yaml "gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -21,8 +23,10 @@ const (
 var (
 	// ParamStoreKeyEnableGovernance indicates if governance proposal management of markers is enabled
 	ParamStoreKeyEnableGovernance = []byte("EnableGovernance")
-	// ParamStoreKeyMaxTotalSupply is maximum supply to allow a marker to create
+	// ParamStoreKeyMaxTotalSupply is maximum supply to allow a marker to create (deprecated, see ParamStoreKeyMaxSupply)
 	ParamStoreKeyMaxTotalSupply = []byte("MaxTotalSupply")
+	// ParamStoreKeyMaxSupply is maximum supply to allow a marker to create or increase to
+	ParamStoreKeyMaxSupply = []byte("MaxSupply")
 	// ParamStoreKeyUnrestrictedDenomRegex is the validation regex for validating denoms supplied by users.
 	ParamStoreKeyUnrestrictedDenomRegex = []byte("UnrestrictedDenomRegex")
 )
@@ -34,30 +38,51 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // NewParams creates a new parameter object
 func NewParams(
-	maxTotalSupply uint64,
+	maxSupply sdk.Int,
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
 ) Params {
 	return Params{
 		EnableGovernance:       enableGovernance,
-		MaxTotalSupply:         maxTotalSupply,
+		MaxTotalSupply:         legacyMaxTotalSupply(maxSupply),
 		UnrestrictedDenomRegex: unrestrictedDenomRegex,
+		MaxSupply:              maxSupply,
 	}
 }
 
-// ParamSetPairs - Implements params.ParamSet
+// legacyMaxTotalSupply returns the max supply limited to a uint64 for the deprecated max total supply param.
+func legacyMaxTotalSupply(maxSupply sdk.Int) uint64 {
+	if maxSupply.IsNil() || maxSupply.IsNegative() {
+		return 0
+	}
+	if !maxSupply.IsUint64() {
+		return math.MaxUint64
+	}
+	return maxSupply.Uint64()
+}
+
+// MigrateMaxSupply sets the max supply from the deprecated max total supply when the max supply has not been set, as
+// is the case for param stores and genesis files created before the max supply param existed.
+func (p *Params) MigrateMaxSupply() {
+	if p.MaxSupply.IsNil() {
+		p.MaxSupply = sdk.NewIntFromUint64(p.MaxTotalSupply) // nolint:staticcheck
+	}
+}
+
+// ParamSetPairs - Implements params.ParamSet.  The deprecated max total supply is not part of the param set so it can
+// not be changed, it is only read when the params are migrated.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableGovernance, &p.EnableGovernance, validateEnableGovernance),
-		paramtypes.NewParamSetPair(ParamStoreKeyMaxTotalSupply, &p.MaxTotalSupply, validateIntParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyUnrestrictedDenomRegex, &p.UnrestrictedDenomRegex, validateRegexParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxSupply, &p.MaxSupply, validateBigIntParam),
 	}
 }
 
 // DefaultParams is the default parameter configuration for the bank module
 func DefaultParams() Params {
 	return NewParams(
-		DefaultMaxTotalSupply,
+		sdk.NewInt(DefaultMaxTotalSupply),
 		DefaultEnableGovernance,
 		DefaultUnrestrictedDenomRegex,
 	)
@@ -89,7 +114,14 @@ func (p *Params) Equal(that interface{}) bool {
 	} else if p == nil {
 		return false
 	}
-	if p.MaxTotalSupply != that1.MaxTotalSupply {
+	if p.MaxTotalSupply != that1.MaxTotalSupply { // nolint:staticcheck
+		return false
+	}
+	if p.MaxSupply.IsNil() || that1.MaxSupply.IsNil() {
+		if p.MaxSupply.IsNil() != that1.MaxSupply.IsNil() {
+			return false
+		}
+	} else if !p.MaxSupply.Equal(that1.MaxSupply) {
 		return false
 	}
 	if p.EnableGovernance != that1.EnableGovernance {
@@ -101,12 +133,17 @@ func (p *Params) Equal(that interface{}) bool {
 	return true
 }
 
-func validateIntParam(i interface{}) error {
-	_, ok := i.(uint64)
+func validateBigIntParam(i interface{}) error {
+	v, ok := i.(sdk.Int)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-
+	if v.IsNil() {
+		return fmt.Errorf("max supply cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", v)
+	}
 	return nil
 }
 
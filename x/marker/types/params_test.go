@@ -1,10 +1,13 @@
 package types
 
 import (
+	"math"
 	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestDefaultParams(t *testing.T) {
@@ -16,11 +19,13 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultUnrestrictedDenomRegex, p.UnrestrictedDenomRegex)
 	require.Equal(t, DefaultEnableGovernance, p.EnableGovernance)
 	require.Equal(t, uint64(DefaultMaxTotalSupply), p.MaxTotalSupply)
+	require.Equal(t, sdk.NewInt(DefaultMaxTotalSupply), p.MaxSupply)
 
-	require.True(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex)))
-	require.False(t, p.Equal(NewParams(1000, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex)))
-	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, false, DefaultUnrestrictedDenomRegex)))
-	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, "a-z")))
+	maxSupply := sdk.NewInt(DefaultMaxTotalSupply)
+	require.True(t, p.Equal(NewParams(maxSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex)))
+	require.False(t, p.Equal(NewParams(sdk.NewInt(1000), DefaultEnableGovernance, DefaultUnrestrictedDenomRegex)))
+	require.False(t, p.Equal(NewParams(maxSupply, false, DefaultUnrestrictedDenomRegex)))
+	require.False(t, p.Equal(NewParams(maxSupply, DefaultEnableGovernance, "a-z")))
 	require.False(t, p.Equal(nil))
 
 	var p2 *Params
@@ -39,9 +44,25 @@ func TestParamString(t *testing.T) {
 	require.Equal(t, `maxtotalsupply: 100000000000
 enablegovernance: true
 unrestricteddenomregex: '[a-zA-Z][a-zA-Z0-9/]{2,64}'
+maxsupply: "100000000000"
 `, p.String())
 }
 
+func TestMaxSupplyBeyondUint64(t *testing.T) {
+	maxSupply, ok := sdk.NewIntFromString("1000000000000000000000000000")
+	require.True(t, ok)
+	p := NewParams(maxSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex)
+	require.Equal(t, maxSupply, p.MaxSupply)
+	require.Equal(t, uint64(math.MaxUint64), p.MaxTotalSupply, "deprecated max total supply is limited to a uint64")
+
+	// params from before the max supply existed take the max supply from the max total supply.
+	legacy := Params{MaxTotalSupply: 5000, EnableGovernance: true, UnrestrictedDenomRegex: DefaultUnrestrictedDenomRegex}
+	legacy.MigrateMaxSupply()
+	require.Equal(t, sdk.NewInt(5000), legacy.MaxSupply)
+	p.MigrateMaxSupply()
+	require.Equal(t, maxSupply, p.MaxSupply, "a max supply that is set is not migrated")
+}
+
 func TestParamSetPairs(t *testing.T) {
 	p := DefaultParams()
 	pairs := p.ParamSetPairs()
@@ -52,10 +73,11 @@ func TestParamSetPairs(t *testing.T) {
 		case string(ParamStoreKeyEnableGovernance):
 			require.Error(t, pairs[i].ValidatorFn("foo"))
 			require.NoError(t, pairs[i].ValidatorFn(true))
-		case string(ParamStoreKeyMaxTotalSupply):
-			require.Error(t, pairs[i].ValidatorFn("foo"))
-			require.Error(t, pairs[i].ValidatorFn(-1000))
-			require.NoError(t, pairs[i].ValidatorFn(uint64(1000)))
+		case string(ParamStoreKeyMaxSupply):
+			require.Error(t, pairs[i].ValidatorFn(uint64(1000)))
+			require.Error(t, pairs[i].ValidatorFn(sdk.Int{}))
+			require.Error(t, pairs[i].ValidatorFn(sdk.NewInt(-1000)))
+			require.NoError(t, pairs[i].ValidatorFn(sdk.NewInt(1000)))
 		case string(ParamStoreKeyUnrestrictedDenomRegex):
 			require.Error(t, pairs[i].ValidatorFn(1))
 			require.Error(t, pairs[i].ValidatorFn("\\!(")) // invalid regex
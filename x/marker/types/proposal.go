@@ -23,6 +23,8 @@ const (
 	ProposalTypeChangeStatus string = "ChangeStatus"
 	// ProposalTypeWithdrawEscrow is a proposal to withdraw coins from marker escrow and transfer to a specified account
 	ProposalTypeWithdrawEscrow string = "WithdrawEscrow"
+	// ProposalTypeSetMintSchedule is a proposal to replace the scheduled supply increases of a marker
+	ProposalTypeSetMintSchedule string = "SetMintSchedule"
 )
 
 var (
@@ -32,6 +34,8 @@ var (
 	_ govtypes.Content = &SetAdministratorProposal{}
 	_ govtypes.Content = &RemoveAdministratorProposal{}
 	_ govtypes.Content = &ChangeStatusProposal{}
+	_ govtypes.Content = &WithdrawEscrowProposal{}
+	_ govtypes.Content = &SetMintScheduleProposal{}
 )
 
 func init() {
@@ -50,6 +54,10 @@ func init() {
 
 	govtypes.RegisterProposalType(ProposalTypeChangeStatus)
 	govtypes.RegisterProposalTypeCodec(ChangeStatusProposal{}, "provenance/marker/ChangeStatusProposal")
+	govtypes.RegisterProposalType(ProposalTypeWithdrawEscrow)
+	govtypes.RegisterProposalTypeCodec(WithdrawEscrowProposal{}, "provenance/marker/WithdrawEscrowProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetMintSchedule)
+	govtypes.RegisterProposalTypeCodec(SetMintScheduleProposal{}, "provenance/marker/SetMintScheduleProposal")
 }
 
 // NewAddMarkerProposal creates a new proposal
@@ -259,3 +267,27 @@ func (wep WithdrawEscrowProposal) String() string {
   Withdraw %s and transfer to %s
 `, wep.Denom, wep.Title, wep.Description, wep.Amount, wep.TargetAdddress)
 }
+
+func NewSetMintScheduleProposal(title, description, denom string, entries []MintScheduleEntry) *SetMintScheduleProposal {
+	return &SetMintScheduleProposal{title, description, denom, entries}
+}
+
+// Implements Proposal Interface
+
+func (msp SetMintScheduleProposal) ProposalRoute() string { return RouterKey }
+func (msp SetMintScheduleProposal) ProposalType() string  { return ProposalTypeSetMintSchedule }
+func (msp SetMintScheduleProposal) ValidateBasic() error {
+	if err := ValidateMintScheduleEntries(msp.Entries); err != nil {
+		return err
+	}
+	return govtypes.ValidateAbstract(&msp)
+}
+
+func (msp SetMintScheduleProposal) String() string {
+	return fmt.Sprintf(`MarkerAccount Set Mint Schedule Proposal:
+  Marker:      %s
+  Title:       %s
+  Description: %s
+  Scheduled Supply Increases: %d
+`, msp.Denom, msp.Title, msp.Description, len(msp.Entries))
+}
@@ -524,6 +524,74 @@ func (m *WithdrawEscrowProposal) GetTargetAdddress() string {
 	return ""
 }
 
+// SetMintScheduleProposal defines a governance proposal to replace the scheduled supply increases of a marker
+type SetMintScheduleProposal struct {
+	Title       string              `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string              `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denom       string              `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Entries     []MintScheduleEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *SetMintScheduleProposal) Reset()      { *m = SetMintScheduleProposal{} }
+func (*SetMintScheduleProposal) ProtoMessage() {}
+func (*SetMintScheduleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_345320af87f4ec37, []int{7}
+}
+func (m *SetMintScheduleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetMintScheduleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetMintScheduleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetMintScheduleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMintScheduleProposal.Merge(m, src)
+}
+func (m *SetMintScheduleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetMintScheduleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMintScheduleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMintScheduleProposal proto.InternalMessageInfo
+
+func (m *SetMintScheduleProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SetMintScheduleProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SetMintScheduleProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SetMintScheduleProposal) GetEntries() []MintScheduleEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AddMarkerProposal)(nil), "provenance.marker.v1.AddMarkerProposal")
 	proto.RegisterType((*SupplyIncreaseProposal)(nil), "provenance.marker.v1.SupplyIncreaseProposal")
@@ -532,6 +600,7 @@ func init() {
 	proto.RegisterType((*RemoveAdministratorProposal)(nil), "provenance.marker.v1.RemoveAdministratorProposal")
 	proto.RegisterType((*ChangeStatusProposal)(nil), "provenance.marker.v1.ChangeStatusProposal")
 	proto.RegisterType((*WithdrawEscrowProposal)(nil), "provenance.marker.v1.WithdrawEscrowProposal")
+	proto.RegisterType((*SetMintScheduleProposal)(nil), "provenance.marker.v1.SetMintScheduleProposal")
 }
 
 func init() {
@@ -539,51 +608,54 @@ func init() {
 }
 
 var fileDescriptor_345320af87f4ec37 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xce, 0xfc, 0x9a, 0xa4, 0xcd, 0xe4, 0x47, 0x8b, 0x4b, 0xa8, 0x6b, 0x85, 0xcd, 0xb6, 0x88,
-	0xcd, 0xa5, 0xbb, 0x26, 0x5e, 0xa4, 0x17, 0x49, 0xab, 0x56, 0x41, 0xa1, 0x6c, 0x05, 0xc1, 0xcb,
-	0x32, 0xd9, 0x1d, 0xb7, 0x43, 0x77, 0x67, 0x96, 0x99, 0x49, 0xd2, 0xfe, 0x17, 0x1e, 0x3d, 0x49,
-	0xcf, 0xde, 0xc4, 0xbb, 0xe7, 0x1e, 0x7b, 0x14, 0x0f, 0x55, 0xda, 0x83, 0xfe, 0x0b, 0x5e, 0x44,
-	0x76, 0x66, 0x93, 0x2e, 0x18, 0x42, 0x45, 0x2a, 0xf4, 0x94, 0x99, 0xf7, 0xbe, 0xf7, 0xe6, 0xfb,
-	0x5e, 0xbe, 0x99, 0x85, 0xb7, 0x52, 0xce, 0x06, 0x98, 0x22, 0x1a, 0x60, 0x37, 0x41, 0x7c, 0x0f,
-	0x73, 0x77, 0xd0, 0x76, 0x53, 0xce, 0x52, 0x26, 0x50, 0x2c, 0x9c, 0x94, 0x33, 0xc9, 0x8c, 0xc6,
-	0x39, 0xca, 0xd1, 0x28, 0x67, 0xd0, 0x5e, 0x6a, 0x44, 0x2c, 0x62, 0x0a, 0xe0, 0x66, 0x2b, 0x8d,
-	0x5d, 0xb2, 0x02, 0x26, 0x12, 0x26, 0xdc, 0x1e, 0x12, 0xd8, 0x1d, 0xb4, 0x7b, 0x58, 0xa2, 0xb6,
-	0x1b, 0x30, 0x42, 0xf3, 0xfc, 0xf2, 0xc4, 0x13, 0xf3, 0xae, 0x1a, 0x72, 0x7b, 0x22, 0x04, 0x05,
-	0x01, 0x16, 0x22, 0xe2, 0x88, 0x4a, 0x8d, 0x5b, 0xf9, 0x31, 0x03, 0xaf, 0x75, 0xc3, 0xf0, 0x99,
-	0x82, 0x6c, 0xe7, 0x9c, 0x8d, 0x06, 0xac, 0x48, 0x22, 0x63, 0x6c, 0x02, 0x1b, 0xb4, 0x6a, 0x9e,
-	0xde, 0x18, 0x36, 0xac, 0x87, 0x58, 0x04, 0x9c, 0xa4, 0x92, 0x30, 0x6a, 0xfe, 0xa7, 0x72, 0xc5,
-	0x90, 0xd1, 0x83, 0x55, 0x94, 0xb0, 0x3e, 0x95, 0xe6, 0x8c, 0x0d, 0x5a, 0xf5, 0xce, 0x0d, 0x47,
-	0x2b, 0x71, 0x32, 0x25, 0x4e, 0xae, 0xc4, 0xd9, 0x64, 0x84, 0x6e, 0xb8, 0x47, 0x27, 0xcd, 0xd2,
-	0xe7, 0x93, 0xe6, 0x6a, 0x44, 0xe4, 0x6e, 0xbf, 0xe7, 0x04, 0x2c, 0x71, 0x73, 0xd9, 0xfa, 0x67,
-	0x4d, 0x84, 0x7b, 0xae, 0x3c, 0x48, 0xb1, 0x50, 0x05, 0x5e, 0xde, 0xd9, 0x30, 0xe1, 0x6c, 0x82,
-	0x28, 0x8a, 0x30, 0x37, 0xcb, 0x8a, 0xc1, 0x68, 0x6b, 0xac, 0xc3, 0xaa, 0x90, 0x48, 0xf6, 0x85,
-	0x59, 0xb1, 0x41, 0x6b, 0xbe, 0xb3, 0xe2, 0x4c, 0x9a, 0xb9, 0xa3, 0xb5, 0xee, 0x28, 0xa4, 0x97,
-	0x57, 0x18, 0x5d, 0x58, 0xd7, 0x08, 0x3f, 0x3b, 0xd2, 0xac, 0xaa, 0x06, 0xf6, 0xb4, 0x06, 0xcf,
-	0x0f, 0x52, 0xec, 0xc1, 0x64, 0xbc, 0x36, 0x1e, 0xc3, 0xba, 0x9e, 0xaf, 0x1f, 0x13, 0x21, 0xcd,
-	0x59, 0x7b, 0xa6, 0x55, 0xef, 0x2c, 0x4f, 0x6e, 0xd1, 0x55, 0xc0, 0xad, 0xec, 0x8f, 0xd8, 0x28,
-	0x67, 0x93, 0xf0, 0xa0, 0xae, 0x7d, 0x4a, 0x84, 0x34, 0x96, 0xe1, 0xff, 0xa2, 0x9f, 0xa6, 0xf1,
-	0x81, 0xff, 0x8a, 0xec, 0xe3, 0xd0, 0x9c, 0xb3, 0x41, 0x6b, 0xce, 0xab, 0xeb, 0xd8, 0xa3, 0x2c,
-	0x64, 0xdc, 0x83, 0x26, 0x8a, 0x63, 0x36, 0xf4, 0x23, 0x36, 0xc0, 0x5c, 0xb5, 0xf7, 0x03, 0x46,
-	0x25, 0x67, 0xb1, 0x59, 0x53, 0xf0, 0x45, 0x95, 0xdf, 0x1a, 0xa7, 0x37, 0x75, 0x76, 0x7d, 0xee,
-	0xcd, 0x61, 0xb3, 0xf4, 0xfd, 0xb0, 0x09, 0x56, 0xbe, 0x01, 0xb8, 0xb8, 0xa3, 0x7a, 0x3e, 0xa1,
-	0x01, 0xc7, 0x48, 0xe0, 0x2b, 0x61, 0x80, 0x55, 0xb8, 0x20, 0x11, 0x8f, 0xb0, 0xf4, 0x51, 0x18,
-	0x86, 0x1c, 0x0b, 0x91, 0x1b, 0x61, 0x5e, 0x87, 0xbb, 0x79, 0xb4, 0xa0, 0xf4, 0xe3, 0x58, 0xe9,
-	0x03, 0x7c, 0x75, 0x94, 0x16, 0x04, 0x7c, 0x00, 0xd0, 0xdc, 0xc9, 0xa4, 0x25, 0x84, 0x12, 0x21,
-	0x39, 0x92, 0xec, 0xef, 0x6f, 0x6b, 0x03, 0x56, 0x42, 0x4c, 0x59, 0xa2, 0x14, 0xd4, 0x3c, 0xbd,
-	0x31, 0xee, 0xc3, 0xaa, 0xb6, 0xa2, 0x59, 0xfe, 0x33, 0x07, 0xe7, 0x65, 0x05, 0xd6, 0x6f, 0x01,
-	0xbc, 0xe9, 0xe1, 0x84, 0x0d, 0xf0, 0xbf, 0x20, 0xbe, 0x0a, 0x17, 0xb8, 0x3a, 0x2c, 0xcc, 0x8c,
-	0xc1, 0x47, 0x0a, 0x6a, 0xde, 0x7c, 0x1e, 0xee, 0xfe, 0xe6, 0x8b, 0xf7, 0x00, 0x36, 0x36, 0x77,
-	0x11, 0x8d, 0xb0, 0x7e, 0x0e, 0x2e, 0x89, 0x59, 0x17, 0x42, 0x8a, 0x87, 0x7e, 0xfe, 0x38, 0x95,
-	0x2f, 0xfc, 0x38, 0xd5, 0x28, 0x1e, 0xea, 0x65, 0x81, 0xf3, 0x4f, 0x00, 0x17, 0x5f, 0x10, 0xb9,
-	0x1b, 0x72, 0x34, 0x7c, 0x28, 0x02, 0xce, 0x86, 0x97, 0xc4, 0x3a, 0x18, 0x3b, 0x5c, 0x1b, 0x61,
-	0x8a, 0xc3, 0xef, 0x64, 0x06, 0x78, 0xf7, 0xa5, 0xd9, 0xba, 0xa0, 0xc3, 0xc5, 0xb4, 0xcb, 0x5c,
-	0x99, 0x7e, 0x99, 0x37, 0xa2, 0xa3, 0x53, 0x0b, 0x1c, 0x9f, 0x5a, 0xe0, 0xeb, 0xa9, 0x05, 0x5e,
-	0x9f, 0x59, 0xa5, 0xe3, 0x33, 0xab, 0xf4, 0xe9, 0xcc, 0x2a, 0xc1, 0xeb, 0x84, 0x4d, 0x9c, 0xea,
-	0x36, 0x78, 0xd9, 0x29, 0x30, 0x3b, 0x87, 0xac, 0x11, 0x56, 0xd8, 0xb9, 0xfb, 0xa3, 0x4f, 0xa5,
-	0x62, 0xda, 0xab, 0xaa, 0x4f, 0xe4, 0xdd, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc0, 0x1a, 0xf8,
-	0x0e, 0xe1, 0x07, 0x00, 0x00,
+	// 739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x31, 0x6b, 0x1b, 0x49,
+	0x14, 0xd6, 0x9c, 0x25, 0xd9, 0x1a, 0x1d, 0x36, 0xb7, 0x08, 0x7b, 0xcf, 0x07, 0x92, 0x6c, 0x8e,
+	0x93, 0x1a, 0xef, 0x9e, 0x74, 0xcd, 0xe1, 0x26, 0xc8, 0x8e, 0xe3, 0x04, 0x62, 0x30, 0xab, 0x40,
+	0x20, 0xcd, 0x32, 0xda, 0x9d, 0xac, 0x06, 0xef, 0xce, 0x2c, 0x33, 0x23, 0xc9, 0xfa, 0x17, 0x29,
+	0x53, 0x05, 0xd7, 0xe9, 0x42, 0xba, 0x14, 0xa9, 0x5d, 0xba, 0x0c, 0x29, 0x9c, 0x60, 0x17, 0xc9,
+	0x5f, 0x48, 0x13, 0xc2, 0xce, 0xac, 0xe4, 0x85, 0x28, 0x8e, 0x43, 0x70, 0xc0, 0xd5, 0xce, 0xbc,
+	0xf7, 0xbd, 0x37, 0xef, 0x7b, 0xfb, 0xbd, 0x19, 0xf8, 0x77, 0xcc, 0xd9, 0x10, 0x53, 0x44, 0x3d,
+	0x6c, 0x47, 0x88, 0x1f, 0x60, 0x6e, 0x0f, 0x5b, 0x76, 0xcc, 0x59, 0xcc, 0x04, 0x0a, 0x85, 0x15,
+	0x73, 0x26, 0x99, 0x51, 0xb9, 0x40, 0x59, 0x1a, 0x65, 0x0d, 0x5b, 0xab, 0x95, 0x80, 0x05, 0x4c,
+	0x01, 0xec, 0x64, 0xa5, 0xb1, 0xab, 0x55, 0x8f, 0x89, 0x88, 0x09, 0xbb, 0x87, 0x04, 0xb6, 0x87,
+	0xad, 0x1e, 0x96, 0xa8, 0x65, 0x7b, 0x8c, 0xd0, 0xd4, 0xbf, 0x36, 0xf3, 0xc4, 0x34, 0xab, 0x86,
+	0xfc, 0x33, 0x13, 0x82, 0x3c, 0x0f, 0x0b, 0x11, 0x70, 0x44, 0xa5, 0xc6, 0xad, 0x7f, 0x9a, 0x83,
+	0x7f, 0x74, 0x7c, 0x7f, 0x4f, 0x41, 0xf6, 0xd3, 0x9a, 0x8d, 0x0a, 0x2c, 0x48, 0x22, 0x43, 0x6c,
+	0x82, 0x3a, 0x68, 0x96, 0x1c, 0xbd, 0x31, 0xea, 0xb0, 0xec, 0x63, 0xe1, 0x71, 0x12, 0x4b, 0xc2,
+	0xa8, 0xf9, 0x9b, 0xf2, 0x65, 0x4d, 0x46, 0x0f, 0x16, 0x51, 0xc4, 0x06, 0x54, 0x9a, 0x73, 0x75,
+	0xd0, 0x2c, 0xb7, 0xff, 0xb4, 0x34, 0x13, 0x2b, 0x61, 0x62, 0xa5, 0x4c, 0xac, 0x6d, 0x46, 0xe8,
+	0x96, 0x7d, 0x7c, 0x5a, 0xcb, 0xbd, 0x3d, 0xad, 0x35, 0x02, 0x22, 0xfb, 0x83, 0x9e, 0xe5, 0xb1,
+	0xc8, 0x4e, 0x69, 0xeb, 0xcf, 0x86, 0xf0, 0x0f, 0x6c, 0x39, 0x8e, 0xb1, 0x50, 0x01, 0x4e, 0x9a,
+	0xd9, 0x30, 0xe1, 0x7c, 0x84, 0x28, 0x0a, 0x30, 0x37, 0xf3, 0xaa, 0x82, 0xc9, 0xd6, 0xd8, 0x84,
+	0x45, 0x21, 0x91, 0x1c, 0x08, 0xb3, 0x50, 0x07, 0xcd, 0xc5, 0xf6, 0xba, 0x35, 0xab, 0xe7, 0x96,
+	0xe6, 0xda, 0x55, 0x48, 0x27, 0x8d, 0x30, 0x3a, 0xb0, 0xac, 0x11, 0x6e, 0x72, 0xa4, 0x59, 0x54,
+	0x09, 0xea, 0x97, 0x25, 0x78, 0x30, 0x8e, 0xb1, 0x03, 0xa3, 0xe9, 0xda, 0xb8, 0x0b, 0xcb, 0xba,
+	0xbf, 0x6e, 0x48, 0x84, 0x34, 0xe7, 0xeb, 0x73, 0xcd, 0x72, 0x7b, 0x6d, 0x76, 0x8a, 0x8e, 0x02,
+	0xee, 0x26, 0x3f, 0x62, 0x2b, 0x9f, 0x74, 0xc2, 0x81, 0x3a, 0xf6, 0x3e, 0x11, 0xd2, 0x58, 0x83,
+	0xbf, 0x8b, 0x41, 0x1c, 0x87, 0x63, 0xf7, 0x31, 0x39, 0xc4, 0xbe, 0xb9, 0x50, 0x07, 0xcd, 0x05,
+	0xa7, 0xac, 0x6d, 0x77, 0x12, 0x93, 0xf1, 0x3f, 0x34, 0x51, 0x18, 0xb2, 0x91, 0x1b, 0xb0, 0x21,
+	0xe6, 0x2a, 0xbd, 0xeb, 0x31, 0x2a, 0x39, 0x0b, 0xcd, 0x92, 0x82, 0x2f, 0x2b, 0xff, 0xee, 0xd4,
+	0xbd, 0xad, 0xbd, 0x9b, 0x0b, 0x4f, 0x8f, 0x6a, 0xb9, 0x8f, 0x47, 0x35, 0xb0, 0xfe, 0x01, 0xc0,
+	0xe5, 0xae, 0xca, 0x79, 0x8f, 0x7a, 0x1c, 0x23, 0x81, 0x6f, 0x84, 0x00, 0x1a, 0x70, 0x49, 0x22,
+	0x1e, 0x60, 0xe9, 0x22, 0xdf, 0xf7, 0x39, 0x16, 0x22, 0x15, 0xc2, 0xa2, 0x36, 0x77, 0x52, 0x6b,
+	0x86, 0xe9, 0xeb, 0x29, 0xd3, 0xdb, 0xf8, 0xe6, 0x30, 0xcd, 0x10, 0x78, 0x09, 0xa0, 0xd9, 0x4d,
+	0xa8, 0x45, 0x84, 0x12, 0x21, 0x39, 0x92, 0xec, 0xe7, 0xa7, 0xb5, 0x02, 0x0b, 0x3e, 0xa6, 0x2c,
+	0x52, 0x0c, 0x4a, 0x8e, 0xde, 0x18, 0xb7, 0x60, 0x51, 0x4b, 0xd1, 0xcc, 0xff, 0x98, 0x82, 0xd3,
+	0xb0, 0x4c, 0xd5, 0xcf, 0x00, 0xfc, 0xcb, 0xc1, 0x11, 0x1b, 0xe2, 0x5f, 0x51, 0x78, 0x03, 0x2e,
+	0x71, 0x75, 0x98, 0xef, 0x22, 0xad, 0x00, 0xc5, 0xa0, 0xe4, 0x2c, 0xa6, 0xe6, 0xce, 0x57, 0xba,
+	0x78, 0x01, 0x60, 0x65, 0xbb, 0x8f, 0x68, 0x80, 0xf5, 0x75, 0x70, 0x4d, 0x95, 0x75, 0x20, 0xa4,
+	0x78, 0xe4, 0xa6, 0x97, 0x53, 0xfe, 0xca, 0x97, 0x53, 0x89, 0xe2, 0x91, 0x5e, 0x66, 0x6a, 0xfe,
+	0x0c, 0xe0, 0xf2, 0x43, 0x22, 0xfb, 0x3e, 0x47, 0xa3, 0x1d, 0xe1, 0x71, 0x36, 0xba, 0xa6, 0xaa,
+	0xbd, 0xa9, 0xc2, 0xb5, 0x10, 0x2e, 0x51, 0xf8, 0xbf, 0x89, 0x00, 0x9e, 0xbf, 0xab, 0x35, 0xaf,
+	0xa8, 0x70, 0x71, 0xd9, 0x30, 0x17, 0xbe, 0x33, 0xcc, 0xaf, 0x00, 0x5c, 0xe9, 0x62, 0xb9, 0x47,
+	0xa8, 0xec, 0x7a, 0x7d, 0xec, 0x0f, 0x42, 0x7c, 0x4d, 0x1d, 0xd8, 0x85, 0xf3, 0x98, 0x4a, 0x4e,
+	0xf0, 0x64, 0x16, 0x1a, 0xdf, 0xf8, 0x69, 0x99, 0x52, 0x76, 0xa8, 0xe4, 0xe3, 0x74, 0x22, 0x26,
+	0xd1, 0x17, 0xc5, 0x6f, 0x05, 0xc7, 0x67, 0x55, 0x70, 0x72, 0x56, 0x05, 0xef, 0xcf, 0xaa, 0xe0,
+	0xc9, 0x79, 0x35, 0x77, 0x72, 0x5e, 0xcd, 0xbd, 0x39, 0xaf, 0xe6, 0xe0, 0x0a, 0x61, 0x33, 0xb3,
+	0xef, 0x83, 0x47, 0xed, 0x4c, 0x5b, 0x2f, 0x20, 0x1b, 0x84, 0x65, 0x76, 0xf6, 0xe1, 0xe4, 0x9d,
+	0x57, 0x6d, 0xee, 0x15, 0xd5, 0xfb, 0xfe, 0xdf, 0x97, 0x01, 0x00, 0x0a, 0xe0, 0x5a, 0x45, 0x9e,
+	0x08, 0x00, 0x00,
 }
 
 func (this *AddMarkerProposal) Equal(that interface{}) bool {
@@ -852,6 +924,44 @@ func (this *WithdrawEscrowProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SetMintScheduleProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetMintScheduleProposal)
+	if !ok {
+		that2, ok := that.(SetMintScheduleProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(&that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *AddMarkerProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1276,6 +1386,64 @@ func (m *WithdrawEscrowProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *SetMintScheduleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetMintScheduleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetMintScheduleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposals(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposals(v)
 	base := offset
@@ -1479,6 +1647,33 @@ func (m *WithdrawEscrowProposal) Size() (n int) {
 	return n
 }
 
+func (m *SetMintScheduleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovProposals(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2837,6 +3032,186 @@ func (m *WithdrawEscrowProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetMintScheduleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetMintScheduleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetMintScheduleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, MintScheduleEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProposals(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -157,3 +158,27 @@ func TestProposalTypeWithdrawEscrow_Format(t *testing.T) {
   Withdraw 100test and transfer to %s
 `, addr), m.String())
 }
+
+func TestProposalSetMintSchedule_Format(t *testing.T) {
+	entries := []MintScheduleEntry{NewMintScheduleEntry(time.Unix(1_600_000_000, 0).UTC(), sdk.NewInt(100), "")}
+	m := NewSetMintScheduleProposal("title", "description", "test", entries)
+	require.NotNil(t, m)
+
+	require.Equal(t, RouterKey, m.ProposalRoute())
+	require.Equal(t, ProposalTypeSetMintSchedule, m.ProposalType())
+	require.NoError(t, m.ValidateBasic())
+
+	m.Entries[0].ToAddress = "invalid"
+	require.Error(t, m.ValidateBasic())
+	m.Entries[0].ToAddress = ""
+
+	m.Entries[0].Time = time.Time{}
+	require.EqualError(t, m.ValidateBasic(), "mint schedule entry time cannot be empty")
+
+	require.Equal(t, `MarkerAccount Set Mint Schedule Proposal:
+  Marker:      test
+  Title:       title
+  Description: description
+  Scheduled Supply Increases: 1
+`, m.String())
+}
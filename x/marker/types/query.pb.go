@@ -832,6 +832,406 @@ func (m *Balance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Balance proto.InternalMessageInfo
 
+// QueryFrozenHoldersRequest is the request type for the Query/FrozenHolders method.
+type QueryFrozenHoldersRequest struct {
+	// the address or denom of the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFrozenHoldersRequest) Reset()         { *m = QueryFrozenHoldersRequest{} }
+func (m *QueryFrozenHoldersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenHoldersRequest) ProtoMessage()    {}
+func (*QueryFrozenHoldersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{17}
+}
+func (m *QueryFrozenHoldersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenHoldersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenHoldersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenHoldersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenHoldersRequest.Merge(m, src)
+}
+func (m *QueryFrozenHoldersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenHoldersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenHoldersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenHoldersRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenHoldersRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *QueryFrozenHoldersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFrozenHoldersResponse is the response type for the Query/FrozenHolders method.
+type QueryFrozenHoldersResponse struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFrozenHoldersResponse) Reset()         { *m = QueryFrozenHoldersResponse{} }
+func (m *QueryFrozenHoldersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenHoldersResponse) ProtoMessage()    {}
+func (*QueryFrozenHoldersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{18}
+}
+func (m *QueryFrozenHoldersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenHoldersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenHoldersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenHoldersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenHoldersResponse.Merge(m, src)
+}
+func (m *QueryFrozenHoldersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenHoldersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenHoldersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenHoldersResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenHoldersResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QueryFrozenHoldersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFrozenStatusRequest is the request type for the Query/FrozenStatus method.
+type QueryFrozenStatusRequest struct {
+	// the address or denom of the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the address of the holder
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryFrozenStatusRequest) Reset()         { *m = QueryFrozenStatusRequest{} }
+func (m *QueryFrozenStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenStatusRequest) ProtoMessage()    {}
+func (*QueryFrozenStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{19}
+}
+func (m *QueryFrozenStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenStatusRequest.Merge(m, src)
+}
+func (m *QueryFrozenStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenStatusRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenStatusRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *QueryFrozenStatusRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryFrozenStatusResponse is the response type for the Query/FrozenStatus method.
+type QueryFrozenStatusResponse struct {
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+}
+
+func (m *QueryFrozenStatusResponse) Reset()         { *m = QueryFrozenStatusResponse{} }
+func (m *QueryFrozenStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenStatusResponse) ProtoMessage()    {}
+func (*QueryFrozenStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{20}
+}
+func (m *QueryFrozenStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenStatusResponse.Merge(m, src)
+}
+func (m *QueryFrozenStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenStatusResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenStatusResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+// QueryMintScheduleRequest is the request type for the Query/MintSchedule method.
+type QueryMintScheduleRequest struct {
+	// the address or denom of the marker
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryMintScheduleRequest) Reset()         { *m = QueryMintScheduleRequest{} }
+func (m *QueryMintScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintScheduleRequest) ProtoMessage()    {}
+func (*QueryMintScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{21}
+}
+func (m *QueryMintScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintScheduleRequest.Merge(m, src)
+}
+func (m *QueryMintScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryMintScheduleRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryMintScheduleResponse is the response type for the Query/MintSchedule method.
+type QueryMintScheduleResponse struct {
+	Schedule MintSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *QueryMintScheduleResponse) Reset()         { *m = QueryMintScheduleResponse{} }
+func (m *QueryMintScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintScheduleResponse) ProtoMessage()    {}
+func (*QueryMintScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{22}
+}
+func (m *QueryMintScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintScheduleResponse.Merge(m, src)
+}
+func (m *QueryMintScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryMintScheduleResponse) GetSchedule() MintSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return MintSchedule{}
+}
+
+// QueryAllMintSchedulesRequest is the request type for the Query/AllMintSchedules method.
+type QueryAllMintSchedulesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMintSchedulesRequest) Reset()         { *m = QueryAllMintSchedulesRequest{} }
+func (m *QueryAllMintSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMintSchedulesRequest) ProtoMessage()    {}
+func (*QueryAllMintSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{23}
+}
+func (m *QueryAllMintSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMintSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMintSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMintSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMintSchedulesRequest.Merge(m, src)
+}
+func (m *QueryAllMintSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMintSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMintSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMintSchedulesRequest proto.InternalMessageInfo
+
+func (m *QueryAllMintSchedulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllMintSchedulesResponse is the response type for the Query/AllMintSchedules method.
+type QueryAllMintSchedulesResponse struct {
+	Schedules []MintSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMintSchedulesResponse) Reset()         { *m = QueryAllMintSchedulesResponse{} }
+func (m *QueryAllMintSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMintSchedulesResponse) ProtoMessage()    {}
+func (*QueryAllMintSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{24}
+}
+func (m *QueryAllMintSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMintSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMintSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMintSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMintSchedulesResponse.Merge(m, src)
+}
+func (m *QueryAllMintSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMintSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMintSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMintSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryAllMintSchedulesResponse) GetSchedules() []MintSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *QueryAllMintSchedulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.marker.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.marker.v1.QueryParamsResponse")
@@ -850,75 +1250,99 @@ func init() {
 	proto.RegisterType((*QueryDenomMetadataRequest)(nil), "provenance.marker.v1.QueryDenomMetadataRequest")
 	proto.RegisterType((*QueryDenomMetadataResponse)(nil), "provenance.marker.v1.QueryDenomMetadataResponse")
 	proto.RegisterType((*Balance)(nil), "provenance.marker.v1.Balance")
+	proto.RegisterType((*QueryFrozenHoldersRequest)(nil), "provenance.marker.v1.QueryFrozenHoldersRequest")
+	proto.RegisterType((*QueryFrozenHoldersResponse)(nil), "provenance.marker.v1.QueryFrozenHoldersResponse")
+	proto.RegisterType((*QueryFrozenStatusRequest)(nil), "provenance.marker.v1.QueryFrozenStatusRequest")
+	proto.RegisterType((*QueryFrozenStatusResponse)(nil), "provenance.marker.v1.QueryFrozenStatusResponse")
+	proto.RegisterType((*QueryMintScheduleRequest)(nil), "provenance.marker.v1.QueryMintScheduleRequest")
+	proto.RegisterType((*QueryMintScheduleResponse)(nil), "provenance.marker.v1.QueryMintScheduleResponse")
+	proto.RegisterType((*QueryAllMintSchedulesRequest)(nil), "provenance.marker.v1.QueryAllMintSchedulesRequest")
+	proto.RegisterType((*QueryAllMintSchedulesResponse)(nil), "provenance.marker.v1.QueryAllMintSchedulesResponse")
 }
 
 func init() { proto.RegisterFile("provenance/marker/v1/query.proto", fileDescriptor_a76fb1fac8494cdc) }
 
 var fileDescriptor_a76fb1fac8494cdc = []byte{
-	// 1000 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xbd, 0x86, 0x38, 0xe9, 0x54, 0xe4, 0x30, 0x58, 0x34, 0x31, 0xa9, 0xd3, 0x2c, 0x51,
-	0x71, 0x23, 0xb2, 0x1b, 0x1b, 0x09, 0xa4, 0x5e, 0x20, 0x2e, 0x50, 0x38, 0x14, 0xa5, 0xee, 0x01,
-	0xa9, 0x12, 0x42, 0xe3, 0xdd, 0x61, 0xbb, 0xca, 0x7a, 0x66, 0xbb, 0xb3, 0x0e, 0x84, 0xaa, 0x17,
-	0xb8, 0x54, 0x02, 0x89, 0x4a, 0x5c, 0x39, 0xe4, 0xc4, 0xa1, 0x67, 0x3e, 0x44, 0xc5, 0xa9, 0x12,
-	0x17, 0x4e, 0x80, 0x12, 0x0e, 0x7c, 0x8c, 0x6a, 0xe7, 0xbd, 0xb1, 0xb3, 0xca, 0x78, 0xe3, 0x43,
-	0x4e, 0xed, 0xec, 0xfc, 0xdf, 0x7b, 0xbf, 0x79, 0x6f, 0xf2, 0x1f, 0x93, 0x6b, 0x69, 0x26, 0x0f,
-	0xb8, 0x60, 0x22, 0xe0, 0xfe, 0x88, 0x65, 0xfb, 0x3c, 0xf3, 0x0f, 0xba, 0xfe, 0xc3, 0x31, 0xcf,
-	0x0e, 0xbd, 0x34, 0x93, 0xb9, 0xa4, 0xcd, 0xa9, 0xc2, 0x03, 0x85, 0x77, 0xd0, 0x6d, 0x35, 0x23,
-	0x19, 0x49, 0x2d, 0xf0, 0x8b, 0xff, 0x81, 0xb6, 0xb5, 0x1a, 0x49, 0x19, 0x25, 0xdc, 0xd7, 0xab,
-	0xe1, 0xf8, 0x6b, 0x9f, 0x09, 0x4c, 0xd3, 0xda, 0x0a, 0xa4, 0x1a, 0x49, 0xe5, 0x0f, 0x99, 0xe2,
-	0x90, 0xdf, 0x3f, 0xe8, 0x0e, 0x79, 0xce, 0xba, 0x7e, 0xca, 0xa2, 0x58, 0xb0, 0x3c, 0x96, 0x02,
-	0xb5, 0xed, 0xd3, 0x5a, 0xa3, 0x0a, 0x64, 0x7c, 0x76, 0x5f, 0xec, 0x4f, 0xf6, 0x8b, 0x85, 0xc1,
-	0x80, 0xfd, 0xaf, 0x80, 0x0f, 0x16, 0xb8, 0xb5, 0x86, 0x84, 0x2c, 0x8d, 0x7d, 0x26, 0x84, 0xcc,
-	0x75, 0x5d, 0xb3, 0xbb, 0x61, 0xed, 0x06, 0x9e, 0x1a, 0x24, 0xd7, 0xad, 0x12, 0x16, 0x04, 0x5c,
-	0xa9, 0x28, 0x63, 0x22, 0x07, 0x9d, 0xdb, 0x24, 0xf4, 0x6e, 0x71, 0xca, 0x3d, 0x96, 0xb1, 0x91,
-	0x1a, 0xf0, 0x87, 0x63, 0xae, 0x72, 0xf7, 0x2e, 0x79, 0xbd, 0xf4, 0x55, 0xa5, 0x52, 0x28, 0x4e,
-	0x6f, 0x92, 0x46, 0xaa, 0xbf, 0xac, 0x38, 0xd7, 0x9c, 0xce, 0xe5, 0xde, 0x9a, 0x67, 0x6b, 0xba,
-	0x07, 0x51, 0xfd, 0x57, 0x9f, 0xff, 0xbd, 0x5e, 0x1b, 0x60, 0x84, 0xfb, 0xab, 0x43, 0xde, 0xd0,
-	0x39, 0x77, 0x93, 0xe4, 0x8e, 0x96, 0x9a, 0x6a, 0x45, 0x5a, 0x95, 0xb3, 0x7c, 0x0c, 0x69, 0x97,
-	0x7b, 0xae, 0x3d, 0x2d, 0x44, 0xdd, 0xd3, 0xca, 0x01, 0x46, 0xd0, 0x4f, 0x08, 0x99, 0xce, 0x65,
-	0xa5, 0xae, 0xb1, 0xae, 0x7b, 0xd8, 0xcb, 0x62, 0x30, 0x1e, 0x5c, 0x12, 0x6c, 0xbf, 0xb7, 0xc7,
-	0x22, 0x8e, 0x75, 0x07, 0xa7, 0x22, 0xdd, 0xdf, 0x1c, 0x72, 0xe5, 0x0c, 0x1e, 0x1e, 0xbb, 0x4f,
-	0x16, 0x81, 0xa2, 0x00, 0x7c, 0xa5, 0x73, 0xb9, 0xd7, 0xf4, 0x60, 0x3c, 0x9e, 0xb9, 0x40, 0xde,
-	0xae, 0x38, 0xec, 0xd3, 0x3f, 0x7e, 0xdf, 0x5e, 0x86, 0xd8, 0xdd, 0x20, 0x90, 0x63, 0x91, 0x7f,
-	0x36, 0x30, 0x81, 0xf4, 0xb6, 0x85, 0xf3, 0xed, 0x73, 0x39, 0x01, 0xa0, 0x04, 0xba, 0x89, 0x03,
-	0x83, 0x42, 0xa6, 0x85, 0xcb, 0xa4, 0x1e, 0x87, 0xba, 0x7d, 0x97, 0x06, 0xf5, 0x38, 0x74, 0xbf,
-	0xc0, 0x01, 0x1a, 0x15, 0x9e, 0xe4, 0x43, 0xd2, 0x00, 0x20, 0x1c, 0xe0, 0xfc, 0x07, 0xc1, 0x38,
-	0x77, 0x84, 0x89, 0x3f, 0x95, 0x49, 0x18, 0x8b, 0x68, 0x46, 0xfd, 0x0b, 0x1b, 0xcb, 0x91, 0x43,
-	0x9a, 0xe5, 0x7a, 0x78, 0x92, 0x0f, 0xc8, 0xd2, 0x90, 0x25, 0xc5, 0x0d, 0x31, 0x43, 0xb9, 0x6a,
-	0xbf, 0x35, 0x7d, 0x50, 0xe1, 0x6d, 0x9c, 0x04, 0x5d, 0xfc, 0x40, 0xee, 0x8d, 0xd3, 0x34, 0x39,
-	0x9c, 0x35, 0x90, 0xcf, 0xb1, 0x6f, 0x46, 0x85, 0xc7, 0x78, 0x9f, 0x34, 0xd8, 0xa8, 0xe8, 0x30,
-	0x0e, 0x64, 0xb5, 0x44, 0x60, 0x6a, 0xdf, 0x92, 0xb1, 0x30, 0x7f, 0x4e, 0x20, 0x9f, 0x54, 0xfd,
-	0x58, 0x05, 0x99, 0xfc, 0x66, 0x56, 0xd5, 0xef, 0xb0, 0xaa, 0x51, 0x61, 0xd5, 0x80, 0x34, 0xb8,
-	0xfe, 0x82, 0xad, 0xab, 0xa8, 0xba, 0x53, 0x54, 0x7d, 0xf6, 0xcf, 0x7a, 0x27, 0x8a, 0xf3, 0x07,
-	0xe3, 0xa1, 0x17, 0xc8, 0x11, 0x3a, 0x15, 0xfe, 0xb3, 0xad, 0xc2, 0x7d, 0x3f, 0x3f, 0x4c, 0xb9,
-	0xd2, 0x01, 0x6a, 0x80, 0xa9, 0x27, 0x84, 0xbb, 0xda, 0x73, 0x66, 0x11, 0xde, 0x47, 0x42, 0xa3,
-	0x42, 0xc2, 0x5b, 0x64, 0x89, 0xc1, 0xd5, 0x33, 0xe3, 0xdd, 0xb0, 0x8f, 0x17, 0xe2, 0x6e, 0x17,
-	0x8e, 0x66, 0x46, 0x6c, 0x02, 0xdd, 0x2e, 0x59, 0xd5, 0xb9, 0x3f, 0xe2, 0x42, 0x8e, 0xee, 0xf0,
-	0x9c, 0x85, 0x2c, 0x67, 0x06, 0xa4, 0x49, 0x16, 0xc2, 0xe2, 0x3b, 0xb2, 0xc0, 0xc2, 0xfd, 0x92,
-	0xb4, 0x6c, 0x21, 0xd3, 0x4b, 0x37, 0xc2, 0x6f, 0x38, 0xaf, 0xab, 0xd3, 0xce, 0x89, 0xfd, 0x49,
-	0xe7, 0x4c, 0xa0, 0x21, 0x32, 0x41, 0xee, 0x53, 0x87, 0x2c, 0xe2, 0x85, 0xa4, 0x2b, 0x64, 0x91,
-	0x85, 0x61, 0xc6, 0x95, 0x42, 0x04, 0xb3, 0xa4, 0x8c, 0x2c, 0x14, 0xaf, 0x88, 0x5a, 0xa9, 0x5f,
-	0xfc, 0x74, 0x20, 0xf3, 0xcd, 0xa5, 0x27, 0x47, 0xeb, 0xb5, 0xff, 0x8f, 0xd6, 0x6b, 0xbd, 0x1f,
-	0x2f, 0x91, 0x05, 0x7d, 0x64, 0xfa, 0x83, 0x43, 0x1a, 0x60, 0xdd, 0xb4, 0x63, 0x6f, 0xf6, 0xd9,
-	0x97, 0xa2, 0x75, 0x63, 0x0e, 0x25, 0x74, 0xcf, 0xdd, 0xfc, 0xfe, 0xcf, 0xff, 0x7e, 0xa9, 0xb7,
-	0xe9, 0x9a, 0x6f, 0x7d, 0x9b, 0xe0, 0x9d, 0xa0, 0x3f, 0x39, 0x84, 0x4c, 0x3d, 0x98, 0xbe, 0x53,
-	0x91, 0xff, 0xcc, 0x4b, 0xd2, 0xda, 0x9e, 0x53, 0x8d, 0x44, 0x1b, 0x9a, 0xe8, 0x4d, 0xba, 0x6a,
-	0x27, 0x62, 0x49, 0x42, 0x9f, 0x38, 0xa4, 0x01, 0x61, 0x95, 0x4d, 0x29, 0xb9, 0x71, 0x65, 0x53,
-	0xca, 0x8e, 0xec, 0xde, 0xd0, 0x08, 0x6f, 0xd1, 0x0d, 0x3b, 0x42, 0xc8, 0x73, 0x16, 0x27, 0xfe,
-	0xa3, 0x38, 0x7c, 0x5c, 0x74, 0x66, 0x11, 0x6d, 0x90, 0x56, 0x55, 0x28, 0x5b, 0x73, 0x6b, 0x6b,
-	0x1e, 0x29, 0xd2, 0x6c, 0x69, 0x9a, 0x4d, 0xea, 0xda, 0x69, 0x1e, 0x80, 0x1c, 0x70, 0x8a, 0xce,
-	0x80, 0x9b, 0x55, 0x76, 0xa6, 0x64, 0x8b, 0x95, 0x9d, 0x29, 0x5b, 0xe3, 0x79, 0x9d, 0x51, 0x5a,
-	0x3d, 0x45, 0x01, 0x8b, 0xab, 0x44, 0x29, 0x79, 0x65, 0x25, 0x4a, 0xd9, 0x2f, 0xcf, 0x43, 0x01,
-	0xc3, 0x03, 0x94, 0x9f, 0x1d, 0xd2, 0x00, 0x4f, 0xaa, 0x44, 0x29, 0x99, 0x62, 0x25, 0x4a, 0xd9,
-	0x18, 0xdd, 0x1d, 0x8d, 0xb2, 0x45, 0x3b, 0x7e, 0xc5, 0x0f, 0xbc, 0x40, 0x8a, 0x3c, 0x93, 0x78,
-	0x6d, 0x9e, 0x39, 0xe4, 0xb5, 0x92, 0x9d, 0x51, 0xbf, 0xa2, 0x9c, 0xcd, 0x2b, 0x5b, 0x3b, 0xf3,
-	0x07, 0x20, 0xe6, 0x7b, 0x1a, 0x73, 0x87, 0x7a, 0x76, 0xcc, 0x88, 0xe7, 0xda, 0x6f, 0x8d, 0x31,
-	0xfa, 0x8f, 0xf4, 0xf2, 0x71, 0x3f, 0x7a, 0x7e, 0xdc, 0x76, 0x5e, 0x1c, 0xb7, 0x9d, 0x7f, 0x8f,
-	0xdb, 0xce, 0xd3, 0x93, 0x76, 0xed, 0xc5, 0x49, 0xbb, 0xf6, 0xd7, 0x49, 0xbb, 0x46, 0xae, 0xc4,
-	0xd2, 0x4a, 0xb1, 0xe7, 0xdc, 0xef, 0x9d, 0x72, 0xbf, 0xa9, 0x64, 0x3b, 0x96, 0xa7, 0x8b, 0x7f,
-	0x6b, 0xca, 0x6b, 0x37, 0x1c, 0x36, 0xf4, 0x2f, 0x9e, 0x77, 0x5f, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0x5b, 0x9e, 0x22, 0x08, 0x59, 0x0c, 0x00, 0x00,
+	// 1262 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0x86, 0x3a, 0xc9, 0x6b, 0x1b, 0xa1, 0x89, 0xd5, 0xc6, 0x4b, 0xea, 0x34, 0xdb,
+	0xd0, 0xc6, 0x29, 0xd9, 0x4d, 0x1c, 0x09, 0xa4, 0x5e, 0x20, 0x69, 0x48, 0xe1, 0x10, 0x94, 0x3a,
+	0x07, 0xa4, 0x4a, 0x08, 0x8d, 0xd7, 0x13, 0x67, 0x15, 0x7b, 0xc7, 0xdd, 0x5d, 0x07, 0xd2, 0x28,
+	0x17, 0x7a, 0xe9, 0x01, 0x89, 0x4a, 0x5c, 0x41, 0xca, 0x01, 0x21, 0xd4, 0x13, 0x07, 0x3e, 0x44,
+	0xc5, 0xa9, 0x12, 0x17, 0x4e, 0x80, 0x12, 0x0e, 0x7c, 0x0c, 0xb4, 0x33, 0x6f, 0x6c, 0x2f, 0x19,
+	0x6f, 0x16, 0xe4, 0x53, 0xb2, 0xb3, 0xff, 0xf7, 0xde, 0x6f, 0xde, 0x9b, 0xd9, 0xf7, 0x0c, 0x37,
+	0x3b, 0x01, 0x3f, 0x60, 0x3e, 0xf5, 0x5d, 0xe6, 0xb4, 0x69, 0xb0, 0xcf, 0x02, 0xe7, 0x60, 0xc5,
+	0x79, 0xdc, 0x65, 0xc1, 0xa1, 0xdd, 0x09, 0x78, 0xc4, 0x49, 0xb1, 0xaf, 0xb0, 0xa5, 0xc2, 0x3e,
+	0x58, 0x31, 0x8b, 0x4d, 0xde, 0xe4, 0x42, 0xe0, 0xc4, 0xff, 0x49, 0xad, 0x59, 0x6a, 0x72, 0xde,
+	0x6c, 0x31, 0x47, 0x3c, 0xd5, 0xbb, 0xbb, 0x0e, 0xf5, 0xd1, 0x8d, 0xb9, 0xe8, 0xf2, 0xb0, 0xcd,
+	0x43, 0xa7, 0x4e, 0x43, 0x26, 0xfd, 0x3b, 0x07, 0x2b, 0x75, 0x16, 0xd1, 0x15, 0xa7, 0x43, 0x9b,
+	0x9e, 0x4f, 0x23, 0x8f, 0xfb, 0xa8, 0x2d, 0x0f, 0x6a, 0x95, 0xca, 0xe5, 0xde, 0xf9, 0xf7, 0xfe,
+	0x7e, 0xef, 0x7d, 0xfc, 0xa0, 0x30, 0xe4, 0xfb, 0xcf, 0x24, 0x9f, 0x7c, 0xc0, 0x57, 0x33, 0x48,
+	0x48, 0x3b, 0x9e, 0x43, 0x7d, 0x9f, 0x47, 0x22, 0xae, 0x7a, 0x3b, 0xa7, 0xcd, 0x06, 0xee, 0x5a,
+	0x4a, 0x6e, 0x6b, 0x25, 0xd4, 0x75, 0x59, 0x18, 0x36, 0x03, 0xea, 0x47, 0x52, 0x67, 0x15, 0x81,
+	0x3c, 0x8c, 0x77, 0xb9, 0x4d, 0x03, 0xda, 0x0e, 0x6b, 0xec, 0x71, 0x97, 0x85, 0x91, 0xf5, 0x10,
+	0xa6, 0x12, 0xab, 0x61, 0x87, 0xfb, 0x21, 0x23, 0xf7, 0xa0, 0xd0, 0x11, 0x2b, 0xd3, 0xc6, 0x4d,
+	0x63, 0xe1, 0x72, 0x75, 0xc6, 0xd6, 0x25, 0xdd, 0x96, 0x56, 0xeb, 0xaf, 0xbf, 0xfc, 0x7d, 0x36,
+	0x57, 0x43, 0x0b, 0xeb, 0x5b, 0x03, 0xae, 0x09, 0x9f, 0x6b, 0xad, 0xd6, 0x96, 0x90, 0xaa, 0x68,
+	0xb1, 0xdb, 0x30, 0xa2, 0x51, 0x57, 0xba, 0x9d, 0xac, 0x5a, 0x7a, 0xb7, 0xd2, 0x6a, 0x47, 0x28,
+	0x6b, 0x68, 0x41, 0x36, 0x01, 0xfa, 0x75, 0x99, 0xce, 0x0b, 0xac, 0xdb, 0x36, 0xe6, 0x32, 0x2e,
+	0x8c, 0x2d, 0x0f, 0x09, 0xa6, 0xdf, 0xde, 0xa6, 0x4d, 0x86, 0x71, 0x6b, 0x03, 0x96, 0xd6, 0x0f,
+	0x06, 0x5c, 0x3f, 0x87, 0x87, 0xdb, 0x5e, 0x87, 0x31, 0x49, 0x11, 0x03, 0xbe, 0xb6, 0x70, 0xb9,
+	0x5a, 0xb4, 0x65, 0x79, 0x6c, 0x75, 0x80, 0xec, 0x35, 0xff, 0x70, 0x9d, 0xfc, 0xf2, 0xf3, 0xd2,
+	0xa4, 0xb4, 0x5d, 0x73, 0x5d, 0xde, 0xf5, 0xa3, 0x8f, 0x6a, 0xca, 0x90, 0x3c, 0xd0, 0x70, 0xde,
+	0xb9, 0x90, 0x53, 0x02, 0x24, 0x40, 0xe7, 0xb1, 0x60, 0x32, 0x90, 0x4a, 0xe1, 0x24, 0xe4, 0xbd,
+	0x86, 0x48, 0xdf, 0x44, 0x2d, 0xef, 0x35, 0xac, 0x4f, 0x60, 0x2a, 0xa1, 0xc2, 0x9d, 0xbc, 0x0f,
+	0x05, 0x09, 0x84, 0x05, 0xcc, 0xbe, 0x11, 0xb4, 0xb3, 0xda, 0xe8, 0xf8, 0x43, 0xde, 0x6a, 0x78,
+	0x7e, 0x73, 0x48, 0xfc, 0x91, 0x95, 0xe5, 0xc4, 0x80, 0x62, 0x32, 0x1e, 0xee, 0xe4, 0x3d, 0x18,
+	0xaf, 0xd3, 0x56, 0x7c, 0x42, 0x54, 0x51, 0x6e, 0xe8, 0x4f, 0xcd, 0xba, 0x54, 0xe1, 0x69, 0xec,
+	0x19, 0x8d, 0xbe, 0x20, 0x3b, 0xdd, 0x4e, 0xa7, 0x75, 0x38, 0xac, 0x20, 0x1f, 0xc3, 0x54, 0x42,
+	0x85, 0xdb, 0x78, 0x17, 0x0a, 0xb4, 0x1d, 0x67, 0x18, 0x0b, 0x52, 0x4a, 0x10, 0xa8, 0xd8, 0xf7,
+	0xb9, 0xe7, 0xab, 0xeb, 0x24, 0xe5, 0xbd, 0xa8, 0x1f, 0x84, 0x6e, 0xc0, 0x3f, 0x1f, 0x16, 0xf5,
+	0x09, 0x4c, 0x25, 0x54, 0x18, 0xd5, 0x85, 0x02, 0x13, 0x2b, 0x98, 0xba, 0x94, 0xa8, 0xcb, 0x71,
+	0xd4, 0x17, 0x7f, 0xcc, 0x2e, 0x34, 0xbd, 0x68, 0xaf, 0x5b, 0xb7, 0x5d, 0xde, 0xc6, 0x2f, 0x15,
+	0xfe, 0x59, 0x0a, 0x1b, 0xfb, 0x4e, 0x74, 0xd8, 0x61, 0xa1, 0x30, 0x08, 0x6b, 0xe8, 0xba, 0x47,
+	0xb8, 0x26, 0xbe, 0x39, 0xc3, 0x08, 0x1f, 0xc1, 0x54, 0x42, 0x85, 0x84, 0xf7, 0x61, 0x9c, 0xca,
+	0xa3, 0xa7, 0xca, 0x3b, 0xa7, 0x2f, 0xaf, 0xb4, 0x7b, 0x10, 0x7f, 0xd1, 0x54, 0x89, 0x95, 0xa1,
+	0xb5, 0x02, 0x25, 0xe1, 0x7b, 0x83, 0xf9, 0xbc, 0xbd, 0xc5, 0x22, 0xda, 0xa0, 0x11, 0x55, 0x20,
+	0x45, 0xb8, 0xd4, 0x88, 0xd7, 0x91, 0x45, 0x3e, 0x58, 0x9f, 0x82, 0xa9, 0x33, 0xe9, 0x1f, 0xba,
+	0x36, 0xae, 0x61, 0xbd, 0x6e, 0xf4, 0x33, 0xe7, 0xef, 0xf7, 0x32, 0xa7, 0x0c, 0x15, 0x91, 0x32,
+	0xb2, 0x9e, 0x1b, 0x30, 0x86, 0x07, 0x92, 0x4c, 0xc3, 0x18, 0x6d, 0x34, 0x02, 0x16, 0x86, 0x88,
+	0xa0, 0x1e, 0x09, 0x85, 0x4b, 0x71, 0x17, 0x09, 0xa7, 0xf3, 0xa3, 0xaf, 0x8e, 0xf4, 0x7c, 0x6f,
+	0xfc, 0xd9, 0xc9, 0x6c, 0xee, 0xef, 0x93, 0xd9, 0x9c, 0x15, 0x62, 0x92, 0x36, 0x03, 0xfe, 0x84,
+	0xf9, 0xf1, 0x35, 0x63, 0xc1, 0xb0, 0x6a, 0x8d, 0xec, 0x5a, 0x3f, 0x35, 0xc0, 0xd4, 0x45, 0xc5,
+	0x3c, 0xcf, 0xc0, 0x04, 0xe6, 0x02, 0x6f, 0xf7, 0x44, 0xad, 0xbf, 0x30, 0xba, 0x9b, 0xbb, 0x01,
+	0xd3, 0x03, 0x10, 0xd8, 0x58, 0x86, 0xec, 0x7c, 0xa0, 0x5a, 0xf9, 0x44, 0xb5, 0xac, 0x55, 0x28,
+	0x69, 0xbc, 0xe0, 0x4e, 0xae, 0x41, 0x61, 0x57, 0xac, 0x0b, 0x57, 0xe3, 0x35, 0x7c, 0xb2, 0x16,
+	0x31, 0xf4, 0x96, 0xe7, 0x47, 0x3b, 0xee, 0x1e, 0x6b, 0x74, 0x5b, 0x6c, 0xd8, 0x15, 0xa1, 0x50,
+	0xd2, 0x68, 0x31, 0xc0, 0x06, 0x8c, 0x87, 0xb8, 0x86, 0x47, 0x72, 0x58, 0xf7, 0x1c, 0xb0, 0x56,
+	0xe7, 0x52, 0x59, 0x5a, 0xbb, 0x30, 0xd3, 0x6b, 0x7e, 0x03, 0xba, 0x5e, 0x36, 0x92, 0x75, 0x37,
+	0xfe, 0x77, 0xdd, 0x7f, 0x32, 0xe0, 0xc6, 0x90, 0x40, 0xb8, 0x9f, 0x4d, 0x98, 0x50, 0x54, 0xea,
+	0xe6, 0x67, 0xdf, 0x50, 0xdf, 0x74, 0x64, 0x87, 0xa4, 0x7a, 0x32, 0x09, 0x97, 0x04, 0x32, 0x79,
+	0x6a, 0x40, 0x41, 0x8e, 0x36, 0x64, 0x41, 0x8f, 0x74, 0x7e, 0x92, 0x32, 0x2b, 0x19, 0x94, 0x32,
+	0xaa, 0x35, 0xff, 0xe5, 0xaf, 0x7f, 0x7d, 0x93, 0x2f, 0x93, 0x19, 0x47, 0x3b, 0xbb, 0xc9, 0x39,
+	0x8a, 0x7c, 0x65, 0x00, 0xf4, 0x67, 0x14, 0xf2, 0x76, 0x8a, 0xff, 0x73, 0x93, 0x96, 0xb9, 0x94,
+	0x51, 0x8d, 0x44, 0x73, 0x82, 0xe8, 0x4d, 0x52, 0xd2, 0x13, 0xd1, 0x56, 0x8b, 0x3c, 0x33, 0xa0,
+	0x20, 0xcd, 0x52, 0x93, 0x92, 0x98, 0x56, 0xcc, 0x4a, 0x06, 0x25, 0x22, 0x54, 0x04, 0xc2, 0x2d,
+	0x32, 0xa7, 0x47, 0x68, 0xb0, 0x88, 0x7a, 0x2d, 0xe7, 0xc8, 0x6b, 0x1c, 0xc7, 0x99, 0x19, 0xc3,
+	0x31, 0x81, 0xa4, 0x45, 0x48, 0x8e, 0x2e, 0xe6, 0x62, 0x16, 0x29, 0xd2, 0x2c, 0x0a, 0x9a, 0x79,
+	0x62, 0xe9, 0x69, 0xf6, 0xa4, 0x5c, 0xe2, 0xc4, 0x99, 0x91, 0xdd, 0x3e, 0x35, 0x33, 0x89, 0xb1,
+	0xc1, 0xac, 0x64, 0x50, 0x66, 0xcb, 0x4c, 0x28, 0xd4, 0x7d, 0x14, 0x39, 0x02, 0xa4, 0xa2, 0x24,
+	0x66, 0x09, 0xb3, 0x92, 0x41, 0x99, 0x0d, 0x45, 0x0e, 0x04, 0x12, 0xe5, 0x6b, 0x03, 0x0a, 0xb2,
+	0x67, 0xa7, 0xa2, 0x24, 0x86, 0x06, 0xb3, 0x92, 0x41, 0x89, 0x28, 0xcb, 0x02, 0x65, 0x91, 0x2c,
+	0x38, 0x29, 0x3f, 0x80, 0x5c, 0xee, 0x47, 0x01, 0xc7, 0x63, 0xf3, 0xc2, 0x80, 0xab, 0x89, 0x76,
+	0x4f, 0x9c, 0x94, 0x70, 0xba, 0x59, 0xc2, 0x5c, 0xce, 0x6e, 0x80, 0x98, 0xef, 0x08, 0xcc, 0x65,
+	0x62, 0xeb, 0x31, 0x9b, 0x2c, 0x12, 0xf3, 0x88, 0x1a, 0x1c, 0x9c, 0x23, 0xf1, 0x78, 0x4c, 0xbe,
+	0x33, 0xe0, 0x6a, 0xa2, 0x67, 0xa6, 0xc2, 0xea, 0x7a, 0xba, 0xb9, 0x9c, 0xdd, 0x20, 0x5b, 0x79,
+	0x65, 0x4b, 0x93, 0xc9, 0xfc, 0xde, 0x80, 0x2b, 0x83, 0x8d, 0x90, 0xd8, 0x17, 0x46, 0x4b, 0xf4,
+	0x5d, 0xd3, 0xc9, 0xac, 0x47, 0xb8, 0x55, 0x01, 0xb7, 0x44, 0xee, 0x5e, 0x08, 0xe7, 0x1c, 0x61,
+	0xcb, 0x3e, 0x26, 0x27, 0x06, 0x5c, 0x19, 0xec, 0x1f, 0xa9, 0x98, 0x9a, 0x1e, 0x6d, 0x3a, 0x99,
+	0xf5, 0x88, 0xe9, 0x08, 0xcc, 0x0a, 0xb9, 0xa3, 0xc7, 0x6c, 0x7b, 0x7e, 0xa4, 0x9a, 0x97, 0xcc,
+	0xe4, 0x8f, 0x06, 0xbc, 0xf1, 0xef, 0x2e, 0x49, 0xaa, 0x17, 0x7c, 0xbf, 0x35, 0xbd, 0xdb, 0x5c,
+	0xfd, 0x4f, 0x36, 0x88, 0x7b, 0x57, 0xe0, 0xbe, 0x45, 0x6e, 0x5d, 0x8c, 0x1b, 0xae, 0x37, 0x5f,
+	0x9e, 0x96, 0x8d, 0x57, 0xa7, 0x65, 0xe3, 0xcf, 0xd3, 0xb2, 0xf1, 0xfc, 0xac, 0x9c, 0x7b, 0x75,
+	0x56, 0xce, 0xfd, 0x76, 0x56, 0xce, 0xc1, 0x75, 0x8f, 0x6b, 0xa3, 0x6f, 0x1b, 0x8f, 0xaa, 0x03,
+	0x23, 0x6b, 0x5f, 0xb2, 0xe4, 0xf1, 0xc1, 0x88, 0x5f, 0xa8, 0x98, 0x62, 0x84, 0xad, 0x17, 0xc4,
+	0xcf, 0xd4, 0xd5, 0x7f, 0x06, 0x00, 0x23, 0xe8, 0x31, 0x0b, 0x0e, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -949,6 +1373,14 @@ type QueryClient interface {
 	Access(ctx context.Context, in *QueryAccessRequest, opts ...grpc.CallOption) (*QueryAccessResponse, error)
 	// query for access records on an account
 	DenomMetadata(ctx context.Context, in *QueryDenomMetadataRequest, opts ...grpc.CallOption) (*QueryDenomMetadataResponse, error)
+	// query for the accounts whose balance of the marker coin is frozen
+	FrozenHolders(ctx context.Context, in *QueryFrozenHoldersRequest, opts ...grpc.CallOption) (*QueryFrozenHoldersResponse, error)
+	// query for whether the balance of the marker coin held by an account is frozen
+	FrozenStatus(ctx context.Context, in *QueryFrozenStatusRequest, opts ...grpc.CallOption) (*QueryFrozenStatusResponse, error)
+	// query for the pending scheduled supply increases of a marker
+	MintSchedule(ctx context.Context, in *QueryMintScheduleRequest, opts ...grpc.CallOption) (*QueryMintScheduleResponse, error)
+	// query for the pending scheduled supply increases of all markers
+	AllMintSchedules(ctx context.Context, in *QueryAllMintSchedulesRequest, opts ...grpc.CallOption) (*QueryAllMintSchedulesResponse, error)
 }
 
 type queryClient struct {
@@ -1031,16 +1463,52 @@ func (c *queryClient) DenomMetadata(ctx context.Context, in *QueryDenomMetadataR
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of x/bank module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Returns a list of all markers on the blockchain
-	AllMarkers(context.Context, *QueryAllMarkersRequest) (*QueryAllMarkersResponse, error)
-	// query for a single marker by denom or address
-	Marker(context.Context, *QueryMarkerRequest) (*QueryMarkerResponse, error)
-	// query for all accounts holding the given marker coins
-	Holding(context.Context, *QueryHoldingRequest) (*QueryHoldingResponse, error)
+func (c *queryClient) FrozenHolders(ctx context.Context, in *QueryFrozenHoldersRequest, opts ...grpc.CallOption) (*QueryFrozenHoldersResponse, error) {
+	out := new(QueryFrozenHoldersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/FrozenHolders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenStatus(ctx context.Context, in *QueryFrozenStatusRequest, opts ...grpc.CallOption) (*QueryFrozenStatusResponse, error) {
+	out := new(QueryFrozenStatusResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/FrozenStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintSchedule(ctx context.Context, in *QueryMintScheduleRequest, opts ...grpc.CallOption) (*QueryMintScheduleResponse, error) {
+	out := new(QueryMintScheduleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/MintSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllMintSchedules(ctx context.Context, in *QueryAllMintSchedulesRequest, opts ...grpc.CallOption) (*QueryAllMintSchedulesResponse, error) {
+	out := new(QueryAllMintSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/AllMintSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of x/bank module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Returns a list of all markers on the blockchain
+	AllMarkers(context.Context, *QueryAllMarkersRequest) (*QueryAllMarkersResponse, error)
+	// query for a single marker by denom or address
+	Marker(context.Context, *QueryMarkerRequest) (*QueryMarkerResponse, error)
+	// query for all accounts holding the given marker coins
+	Holding(context.Context, *QueryHoldingRequest) (*QueryHoldingResponse, error)
 	// query for supply of coin on a marker account
 	Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error)
 	// query for coins on a marker account
@@ -1049,6 +1517,14 @@ type QueryServer interface {
 	Access(context.Context, *QueryAccessRequest) (*QueryAccessResponse, error)
 	// query for access records on an account
 	DenomMetadata(context.Context, *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error)
+	// query for the accounts whose balance of the marker coin is frozen
+	FrozenHolders(context.Context, *QueryFrozenHoldersRequest) (*QueryFrozenHoldersResponse, error)
+	// query for whether the balance of the marker coin held by an account is frozen
+	FrozenStatus(context.Context, *QueryFrozenStatusRequest) (*QueryFrozenStatusResponse, error)
+	// query for the pending scheduled supply increases of a marker
+	MintSchedule(context.Context, *QueryMintScheduleRequest) (*QueryMintScheduleResponse, error)
+	// query for the pending scheduled supply increases of all markers
+	AllMintSchedules(context.Context, *QueryAllMintSchedulesRequest) (*QueryAllMintSchedulesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1079,6 +1555,18 @@ func (*UnimplementedQueryServer) Access(ctx context.Context, req *QueryAccessReq
 func (*UnimplementedQueryServer) DenomMetadata(ctx context.Context, req *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomMetadata not implemented")
 }
+func (*UnimplementedQueryServer) FrozenHolders(ctx context.Context, req *QueryFrozenHoldersRequest) (*QueryFrozenHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenHolders not implemented")
+}
+func (*UnimplementedQueryServer) FrozenStatus(ctx context.Context, req *QueryFrozenStatusRequest) (*QueryFrozenStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenStatus not implemented")
+}
+func (*UnimplementedQueryServer) MintSchedule(ctx context.Context, req *QueryMintScheduleRequest) (*QueryMintScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintSchedule not implemented")
+}
+func (*UnimplementedQueryServer) AllMintSchedules(ctx context.Context, req *QueryAllMintSchedulesRequest) (*QueryAllMintSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllMintSchedules not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1228,6 +1716,78 @@ func _Query_DenomMetadata_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FrozenHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/FrozenHolders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenHolders(ctx, req.(*QueryFrozenHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/FrozenStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenStatus(ctx, req.(*QueryFrozenStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/MintSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintSchedule(ctx, req.(*QueryMintScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllMintSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMintSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllMintSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/AllMintSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllMintSchedules(ctx, req.(*QueryAllMintSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.marker.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1264,6 +1824,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomMetadata",
 			Handler:    _Query_DenomMetadata_Handler,
 		},
+		{
+			MethodName: "FrozenHolders",
+			Handler:    _Query_FrozenHolders_Handler,
+		},
+		{
+			MethodName: "FrozenStatus",
+			Handler:    _Query_FrozenStatus_Handler,
+		},
+		{
+			MethodName: "MintSchedule",
+			Handler:    _Query_MintSchedule_Handler,
+		},
+		{
+			MethodName: "AllMintSchedules",
+			Handler:    _Query_AllMintSchedules_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/marker/v1/query.proto",
@@ -1874,159 +2450,376 @@ func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFrozenHoldersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryFrozenHoldersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllMarkersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFrozenHoldersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllMarkersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFrozenHoldersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenHoldersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenHoldersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Markers) > 0 {
-		for _, e := range m.Markers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMarkerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFrozenStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryMarkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Marker != nil {
-		l = m.Marker.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryFrozenStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHoldingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFrozenStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHoldingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFrozenStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMintSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMintSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMintSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMintSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMintSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMintSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	return n
 }
 
-func (m *QuerySupplyRequest) Size() (n int) {
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMarkersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QuerySupplyResponse) Size() (n int) {
+func (m *QueryAllMarkersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Markers) > 0 {
+		for _, e := range m.Markers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryEscrowRequest) Size() (n int) {
+func (m *QueryMarkerRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2039,22 +2832,20 @@ func (m *QueryEscrowRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryEscrowResponse) Size() (n int) {
+func (m *QueryMarkerResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Escrow) > 0 {
-		for _, e := range m.Escrow {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Marker != nil {
+		l = m.Marker.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryAccessRequest) Size() (n int) {
+func (m *QueryHoldingRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2064,74 +2855,1047 @@ func (m *QueryAccessRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryAccessResponse) Size() (n int) {
+func (m *QueryHoldingResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryDenomMetadataRequest) Size() (n int) {
+func (m *QuerySupplyRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryDenomMetadataResponse) Size() (n int) {
+func (m *QuerySupplyResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Metadata.Size()
+	l = m.Amount.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *Balance) Size() (n int) {
+func (m *QueryEscrowRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Escrow) > 0 {
+		for _, e := range m.Escrow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *Balance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFrozenHoldersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenHoldersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryMintScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMintSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMintSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMarkersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMarkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMarkersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMarkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markers = append(m.Markers, &types.Any{})
+			if err := m.Markers[len(m.Markers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarkerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarkerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Marker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Marker == nil {
+				m.Marker = &types.Any{}
+			}
+			if err := m.Marker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHoldingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHoldingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHoldingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHoldingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2154,12 +3918,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2181,7 +3977,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2204,15 +4000,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2239,7 +4035,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2264,7 +4060,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2287,17 +4083,17 @@ func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMarkersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMarkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2307,14 +4103,77 @@ func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= MarkerStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2341,10 +4200,8 @@ func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Escrow = append(m.Escrow, types1.Coin{})
+			if err := m.Escrow[len(m.Escrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2369,7 +4226,7 @@ func (m *QueryAllMarkersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2392,17 +4249,17 @@ func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMarkersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMarkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2412,29 +4269,77 @@ func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markers = append(m.Markers, &types.Any{})
-			if err := m.Markers[len(m.Markers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2461,10 +4366,8 @@ func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accounts = append(m.Accounts, AccessGrant{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2489,7 +4392,7 @@ func (m *QueryAllMarkersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarkerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2512,15 +4415,15 @@ func (m *QueryMarkerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarkerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2548,7 +4451,7 @@ func (m *QueryMarkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2571,7 +4474,7 @@ func (m *QueryMarkerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2594,15 +4497,15 @@ func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Marker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2629,10 +4532,7 @@ func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Marker == nil {
-				m.Marker = &types.Any{}
-			}
-			if err := m.Marker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2657,7 +4557,7 @@ func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
+func (m *Balance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2680,15 +4580,15 @@ func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHoldingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHoldingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2716,11 +4616,11 @@ func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2747,10 +4647,8 @@ func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2775,7 +4673,7 @@ func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenHoldersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2798,17 +4696,17 @@ func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHoldingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenHoldersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHoldingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenHoldersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2818,25 +4716,23 @@ func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, Balance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2868,7 +4764,7 @@ func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2895,7 +4791,7 @@ func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenHoldersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2918,15 +4814,15 @@ func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenHoldersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenHoldersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2954,61 +4850,11 @@ func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3035,7 +4881,10 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3060,7 +4909,7 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3083,10 +4932,10 @@ func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3121,61 +4970,11 @@ func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3185,25 +4984,23 @@ func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Escrow = append(m.Escrow, types1.Coin{})
-			if err := m.Escrow[len(m.Escrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3226,7 +5023,7 @@ func (m *QueryEscrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3249,17 +5046,17 @@ func (m *QueryAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3269,24 +5066,12 @@ func (m *QueryAccessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Frozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3308,7 +5093,7 @@ func (m *QueryAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3331,17 +5116,17 @@ func (m *QueryAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3351,25 +5136,23 @@ func (m *QueryAccessResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, AccessGrant{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3392,7 +5175,7 @@ func (m *QueryAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3415,17 +5198,17 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3435,23 +5218,24 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3474,7 +5258,7 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllMintSchedulesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3497,15 +5281,15 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllMintSchedulesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllMintSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3532,7 +5316,10 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3557,7 +5344,7 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Balance) Unmarshal(dAtA []byte) error {
+func (m *QueryAllMintSchedulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3580,17 +5367,17 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllMintSchedulesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllMintSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3600,27 +5387,29 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Schedules = append(m.Schedules, MintSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3647,8 +5436,10 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
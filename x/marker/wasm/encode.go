@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/provenance-io/provenance/x/marker/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // MarkerMsgParams are params for encoding []sdk.Msg types from the marker module.
@@ -36,6 +38,16 @@ type MarkerMsgParams struct {
 	Withdraw *WithdrawParams `json:"withdraw_marker_coins,omitempty"`
 	// Params for encoding a MsgTransferRequest
 	Transfer *TransferParams `json:"transfer_marker_coins,omitempty"`
+	// Params for encoding a MsgForceTransferRequest
+	ForceTransfer *ForceTransferParams `json:"force_transfer_marker_coins,omitempty"`
+	// Params for encoding a MsgSetDenomMetadataRequest
+	SetDenomMetadata *SetDenomMetadataParams `json:"set_marker_denom_metadata,omitempty"`
+	// Params for encoding a MsgFreezeHolderRequest
+	Freeze *FreezeHolderParams `json:"freeze_marker_holder,omitempty"`
+	// Params for encoding a MsgUnfreezeHolderRequest
+	Unfreeze *UnfreezeHolderParams `json:"unfreeze_marker_holder,omitempty"`
+	// Params for encoding a MsgSetMintScheduleRequest
+	SetMintSchedule *SetMintScheduleParams `json:"set_marker_mint_schedule,omitempty"`
 }
 
 // CreateMarkerParams are params for encoding a MsgAddMarkerRequest.
@@ -44,6 +56,14 @@ type CreateMarkerParams struct {
 	Coin sdk.Coin `json:"coin"`
 	// The marker type
 	Type string `json:"marker_type,omitempty"`
+	// Whether the marker supply is fixed
+	SupplyFixed bool `json:"supply_fixed,omitempty"`
+	// Whether governance proposals may control the marker
+	AllowGovernanceControl bool `json:"allow_governance_control,omitempty"`
+	// The account attributes recipients of a restricted marker coin must have
+	RequiredAttributes []string `json:"required_attributes,omitempty"`
+	// Whether restricted marker coin may be recovered from any holder
+	AllowForcedTransfer bool `json:"allow_forced_transfer,omitempty"`
 }
 
 // GrantAccessParams are params for encoding a MsgAddAccessRequest.
@@ -118,6 +138,74 @@ type TransferParams struct {
 	From string `json:"from"`
 }
 
+// ForceTransferParams are params for encoding a MsgForceTransferRequest.
+type ForceTransferParams struct {
+	// The denomination and amount to transfer
+	Coin sdk.Coin `json:"coin"`
+	// The recipient of the transfer
+	To string `json:"to"`
+	// The holder the coin is recovered from
+	From string `json:"from"`
+	// The reason recorded with the transfer
+	Reason string `json:"reason"`
+}
+
+// SetDenomMetadataParams are params for encoding a MsgSetDenomMetadataRequest.
+type SetDenomMetadataParams struct {
+	// The marker denomination the metadata describes
+	Denom string `json:"denom"`
+	// The denomination shown to users
+	Display string `json:"display,omitempty"`
+	// A description of the coin
+	Description string `json:"description,omitempty"`
+	// The units of the coin
+	DenomUnits []DenomUnit `json:"denom_units,omitempty"`
+}
+
+// DenomUnit is a unit of a marker coin in bank denom metadata.
+type DenomUnit struct {
+	// The name of the unit
+	Denom string `json:"denom"`
+	// The power of ten of the base denomination equal to one of this unit
+	Exponent uint32 `json:"exponent"`
+	// Other names of the unit
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+// FreezeHolderParams are params for encoding a MsgFreezeHolderRequest.
+type FreezeHolderParams struct {
+	// The marker denomination
+	Denom string `json:"denom"`
+	// The holder to freeze
+	Holder string `json:"holder"`
+}
+
+// UnfreezeHolderParams are params for encoding a MsgUnfreezeHolderRequest.
+type UnfreezeHolderParams struct {
+	// The marker denomination
+	Denom string `json:"denom"`
+	// The holder to unfreeze
+	Holder string `json:"holder"`
+}
+
+// SetMintScheduleParams are params for encoding a MsgSetMintScheduleRequest.
+type SetMintScheduleParams struct {
+	// The marker denomination
+	Denom string `json:"denom"`
+	// The scheduled supply increases, an empty list removes the schedule
+	Entries []MintScheduleEntry `json:"entries"`
+}
+
+// MintScheduleEntry is a scheduled supply increase of a marker.
+type MintScheduleEntry struct {
+	// The block time (RFC 3339) the supply increase is due
+	Time time.Time `json:"time"`
+	// The amount of coin to mint
+	Amount sdk.Int `json:"amount"`
+	// The optional address the new coin is withdrawn to
+	ToAddress string `json:"to_address,omitempty"`
+}
+
 // Encoder returns a smart contract message encoder for the name module.
 func Encoder(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sdk.Msg, error) {
 	wrapper := struct {
@@ -153,6 +241,16 @@ func Encoder(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sd
 		return params.Withdraw.Encode(contract)
 	case params.Transfer != nil:
 		return params.Transfer.Encode(contract)
+	case params.ForceTransfer != nil:
+		return params.ForceTransfer.Encode(contract)
+	case params.SetDenomMetadata != nil:
+		return params.SetDenomMetadata.Encode(contract)
+	case params.Freeze != nil:
+		return params.Freeze.Encode(contract)
+	case params.Unfreeze != nil:
+		return params.Unfreeze.Encode(contract)
+	case params.SetMintSchedule != nil:
+		return params.SetMintSchedule.Encode(contract)
 	default:
 		return nil, fmt.Errorf("wasm: invalid marker encode request: %s", string(msg))
 	}
@@ -174,6 +272,10 @@ func (params *CreateMarkerParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, er
 	msg := types.NewAddMarkerRequest(
 		params.Coin.Denom, params.Coin.Amount, contract, contract, markerType,
 	)
+	msg.SupplyFixed = params.SupplyFixed
+	msg.AllowGovernanceControl = params.AllowGovernanceControl
+	msg.RequiredAttributes = params.RequiredAttributes
+	msg.AllowForcedTransfer = params.AllowForcedTransfer
 	return []sdk.Msg{msg}, nil
 }
 
@@ -305,3 +407,92 @@ func (params *TransferParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error)
 	msg := types.NewTransferRequest(contract, from, to, params.Coin)
 	return []sdk.Msg{msg}, nil
 }
+
+// Encode creates a MsgForceTransferRequest.
+// The contract must hold the force transfer access on the marker.
+func (params *ForceTransferParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if !params.Coin.IsValid() {
+		return nil, fmt.Errorf("wasm: invalid ForceTransferParams: coin is invalid")
+	}
+	to, err := sdk.AccAddressFromBech32(params.To)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'to' address in ForceTransferParams: %w", err)
+	}
+	from, err := sdk.AccAddressFromBech32(params.From)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'from' address in ForceTransferParams: %w", err)
+	}
+	if strings.TrimSpace(params.Reason) == "" {
+		return nil, fmt.Errorf("wasm: empty reason in ForceTransferParams")
+	}
+	msg := types.NewForceTransferRequest(contract, from, to, params.Coin, params.Reason)
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgSetDenomMetadataRequest.
+// The contract must be the manager or an administrator of the marker.
+func (params *SetDenomMetadataParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if strings.TrimSpace(params.Denom) == "" {
+		return nil, fmt.Errorf("wasm: empty denomination in SetDenomMetadataParams")
+	}
+	units := make([]*banktypes.DenomUnit, len(params.DenomUnits))
+	for i, u := range params.DenomUnits {
+		units[i] = &banktypes.DenomUnit{Denom: u.Denom, Exponent: u.Exponent, Aliases: u.Aliases}
+	}
+	metadata := banktypes.Metadata{
+		Description: params.Description,
+		DenomUnits:  units,
+		Base:        params.Denom,
+		Display:     params.Display,
+	}
+	msg := types.NewSetDenomMetadataRequest(metadata, contract)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("wasm: invalid SetDenomMetadataParams: %w", err)
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgFreezeHolderRequest.
+// The contract must hold the freeze access on the marker.
+func (params *FreezeHolderParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if strings.TrimSpace(params.Denom) == "" {
+		return nil, fmt.Errorf("wasm: empty denomination in FreezeHolderParams")
+	}
+	holder, err := sdk.AccAddressFromBech32(params.Holder)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid holder address in FreezeHolderParams: %w", err)
+	}
+	msg := types.NewFreezeHolderRequest(params.Denom, contract, holder)
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgUnfreezeHolderRequest.
+// The contract must hold the freeze access on the marker.
+func (params *UnfreezeHolderParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if strings.TrimSpace(params.Denom) == "" {
+		return nil, fmt.Errorf("wasm: empty denomination in UnfreezeHolderParams")
+	}
+	holder, err := sdk.AccAddressFromBech32(params.Holder)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid holder address in UnfreezeHolderParams: %w", err)
+	}
+	msg := types.NewUnfreezeHolderRequest(params.Denom, contract, holder)
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgSetMintScheduleRequest.
+// The contract must hold the mint access on the marker.
+func (params *SetMintScheduleParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if strings.TrimSpace(params.Denom) == "" {
+		return nil, fmt.Errorf("wasm: empty denomination in SetMintScheduleParams")
+	}
+	entries := make([]types.MintScheduleEntry, len(params.Entries))
+	for i, e := range params.Entries {
+		entries[i] = types.NewMintScheduleEntry(e.Time.UTC(), e.Amount, e.ToAddress)
+	}
+	if err := types.ValidateMintScheduleEntries(entries); err != nil {
+		return nil, fmt.Errorf("wasm: invalid SetMintScheduleParams: %w", err)
+	}
+	msg := types.NewSetMintScheduleRequest(params.Denom, contract, entries)
+	return []sdk.Msg{msg}, nil
+}
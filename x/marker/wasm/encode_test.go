@@ -0,0 +1,252 @@
+package wasm
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestEncoder(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	holder := sdk.AccAddress("holder______________")
+	recipient := sdk.AccAddress("recipient___________")
+	at := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	restricted := types.NewAddMarkerRequest("rcoin", sdk.NewInt(1000), contract, contract, types.MarkerType_RestrictedCoin)
+	restricted.SupplyFixed = true
+	restricted.AllowGovernanceControl = true
+	restricted.RequiredAttributes = []string{"kyc.provenance.io", "accredited.provenance.io"}
+	restricted.AllowForcedTransfer = true
+
+	tests := []struct {
+		name string
+		msg  string
+		want []sdk.Msg
+		err  string
+	}{
+		{
+			"create marker with send restrictions",
+			`{"marker":{"create_marker":{"coin":{"denom":"rcoin","amount":"1000"},"marker_type":"restricted",
+				"supply_fixed":true,"allow_governance_control":true,
+				"required_attributes":["kyc.provenance.io","accredited.provenance.io"],"allow_forced_transfer":true}}}`,
+			[]sdk.Msg{restricted},
+			"",
+		},
+		{
+			"create marker without send restrictions",
+			`{"marker":{"create_marker":{"coin":{"denom":"ccoin","amount":"1000"},"marker_type":"coin"}}}`,
+			[]sdk.Msg{types.NewAddMarkerRequest("ccoin", sdk.NewInt(1000), contract, contract, types.MarkerType_Coin)},
+			"",
+		},
+		{
+			"create marker missing type",
+			`{"marker":{"create_marker":{"coin":{"denom":"ccoin","amount":"1000"},"allow_forced_transfer":true}}}`,
+			nil,
+			"wasm: missing marker type in CreateMarkerParams",
+		},
+		{
+			"create marker invalid type",
+			`{"marker":{"create_marker":{"coin":{"denom":"ccoin","amount":"1000"},"marker_type":"other"}}}`,
+			nil,
+			"wasm: invalid marker type in CreateMarkerParams: 'other' is not a valid marker status",
+		},
+		{
+			"force transfer",
+			fmt.Sprintf(`{"marker":{"force_transfer_marker_coins":{"coin":{"denom":"rcoin","amount":"10"},
+				"from":"%s","to":"%s","reason":"lost key"}}}`, holder, recipient),
+			[]sdk.Msg{types.NewForceTransferRequest(contract, holder, recipient, sdk.NewInt64Coin("rcoin", 10), "lost key")},
+			"",
+		},
+		{
+			"force transfer invalid coin",
+			fmt.Sprintf(`{"marker":{"force_transfer_marker_coins":{"coin":{"denom":"rcoin","amount":"-10"},
+				"from":"%s","to":"%s","reason":"lost key"}}}`, holder, recipient),
+			nil,
+			"wasm: invalid ForceTransferParams: coin is invalid",
+		},
+		{
+			"force transfer invalid to",
+			fmt.Sprintf(`{"marker":{"force_transfer_marker_coins":{"coin":{"denom":"rcoin","amount":"10"},
+				"from":"%s","to":"invalid","reason":"lost key"}}}`, holder),
+			nil,
+			"wasm: invalid 'to' address in ForceTransferParams: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"force transfer invalid from",
+			fmt.Sprintf(`{"marker":{"force_transfer_marker_coins":{"coin":{"denom":"rcoin","amount":"10"},
+				"from":"","to":"%s","reason":"lost key"}}}`, recipient),
+			nil,
+			"wasm: invalid 'from' address in ForceTransferParams: empty address string is not allowed",
+		},
+		{
+			"force transfer missing reason",
+			fmt.Sprintf(`{"marker":{"force_transfer_marker_coins":{"coin":{"denom":"rcoin","amount":"10"},
+				"from":"%s","to":"%s","reason":" "}}}`, holder, recipient),
+			nil,
+			"wasm: empty reason in ForceTransferParams",
+		},
+		{
+			"set denom metadata",
+			`{"marker":{"set_marker_denom_metadata":{"denom":"rcoin","display":"mrcoin","description":"a coin",
+				"denom_units":[{"denom":"rcoin","exponent":0},{"denom":"mrcoin","exponent":3,"aliases":["millircoin"]}]}}}`,
+			[]sdk.Msg{types.NewSetDenomMetadataRequest(banktypes.Metadata{
+				Description: "a coin",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: "rcoin", Exponent: 0},
+					{Denom: "mrcoin", Exponent: 3, Aliases: []string{"millircoin"}},
+				},
+				Base:    "rcoin",
+				Display: "mrcoin",
+			}, contract)},
+			"",
+		},
+		{
+			"set denom metadata missing denom",
+			`{"marker":{"set_marker_denom_metadata":{"denom":"","display":"mrcoin"}}}`,
+			nil,
+			"wasm: empty denomination in SetDenomMetadataParams",
+		},
+		{
+			"set denom metadata missing base unit",
+			`{"marker":{"set_marker_denom_metadata":{"denom":"rcoin","denom_units":[{"denom":"mrcoin","exponent":3}]}}}`,
+			nil,
+			"wasm: invalid SetDenomMetadataParams: invalid metadata, denom units does not contain record for marker denom",
+		},
+		{
+			"set denom metadata missing display unit",
+			`{"marker":{"set_marker_denom_metadata":{"denom":"rcoin","display":"mrcoin",
+				"denom_units":[{"denom":"rcoin","exponent":0}]}}}`,
+			nil,
+			"wasm: invalid SetDenomMetadataParams: missing denom unit definition for selected display",
+		},
+		{
+			"freeze holder",
+			fmt.Sprintf(`{"marker":{"freeze_marker_holder":{"denom":"rcoin","holder":"%s"}}}`, holder),
+			[]sdk.Msg{types.NewFreezeHolderRequest("rcoin", contract, holder)},
+			"",
+		},
+		{
+			"freeze holder missing denom",
+			fmt.Sprintf(`{"marker":{"freeze_marker_holder":{"denom":" ","holder":"%s"}}}`, holder),
+			nil,
+			"wasm: empty denomination in FreezeHolderParams",
+		},
+		{
+			"freeze holder invalid holder",
+			`{"marker":{"freeze_marker_holder":{"denom":"rcoin","holder":""}}}`,
+			nil,
+			"wasm: invalid holder address in FreezeHolderParams: empty address string is not allowed",
+		},
+		{
+			"unfreeze holder",
+			fmt.Sprintf(`{"marker":{"unfreeze_marker_holder":{"denom":"rcoin","holder":"%s"}}}`, holder),
+			[]sdk.Msg{types.NewUnfreezeHolderRequest("rcoin", contract, holder)},
+			"",
+		},
+		{
+			"unfreeze holder missing denom",
+			fmt.Sprintf(`{"marker":{"unfreeze_marker_holder":{"denom":"","holder":"%s"}}}`, holder),
+			nil,
+			"wasm: empty denomination in UnfreezeHolderParams",
+		},
+		{
+			"unfreeze holder invalid holder",
+			`{"marker":{"unfreeze_marker_holder":{"denom":"rcoin","holder":"invalid"}}}`,
+			nil,
+			"wasm: invalid holder address in UnfreezeHolderParams: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"set mint schedule",
+			fmt.Sprintf(`{"marker":{"set_marker_mint_schedule":{"denom":"rcoin","entries":[
+				{"time":"2021-03-01T12:00:00Z","amount":"100"},
+				{"time":"2021-03-01T14:00:00+02:00","amount":"200","to_address":"%s"}]}}}`, recipient),
+			[]sdk.Msg{types.NewSetMintScheduleRequest("rcoin", contract, []types.MintScheduleEntry{
+				types.NewMintScheduleEntry(at, sdk.NewInt(100), ""),
+				types.NewMintScheduleEntry(at, sdk.NewInt(200), recipient.String()),
+			})},
+			"",
+		},
+		{
+			"remove mint schedule",
+			`{"marker":{"set_marker_mint_schedule":{"denom":"rcoin","entries":[]}}}`,
+			[]sdk.Msg{types.NewSetMintScheduleRequest("rcoin", contract, []types.MintScheduleEntry{})},
+			"",
+		},
+		{
+			"set mint schedule missing denom",
+			`{"marker":{"set_marker_mint_schedule":{"denom":"","entries":[]}}}`,
+			nil,
+			"wasm: empty denomination in SetMintScheduleParams",
+		},
+		{
+			"set mint schedule missing time",
+			`{"marker":{"set_marker_mint_schedule":{"denom":"rcoin","entries":[{"time":"0001-01-01T00:00:00Z","amount":"100"}]}}}`,
+			nil,
+			"wasm: invalid SetMintScheduleParams: mint schedule entry time cannot be empty",
+		},
+		{
+			"set mint schedule zero amount",
+			`{"marker":{"set_marker_mint_schedule":{"denom":"rcoin","entries":[{"time":"2021-03-01T12:00:00Z","amount":"0"}]}}}`,
+			nil,
+			"wasm: invalid SetMintScheduleParams: mint schedule entry amount must be positive",
+		},
+		{
+			"set mint schedule invalid to address",
+			`{"marker":{"set_marker_mint_schedule":{"denom":"rcoin","entries":[
+				{"time":"2021-03-01T12:00:00Z","amount":"100","to_address":"invalid"}]}}}`,
+			nil,
+			"wasm: invalid SetMintScheduleParams: invalid mint schedule entry to address: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"empty params",
+			`{"marker":{}}`,
+			nil,
+			`wasm: invalid marker encode request: {"marker":{}}`,
+		},
+		{
+			"missing params",
+			`{}`,
+			nil,
+			"wasm: nil marker encode params",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := Encoder(contract, []byte(tc.msg), "")
+			if len(tc.err) > 0 {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, msgs)
+		})
+	}
+}
+
+func TestEncodeMintScheduleLimit(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	params := SetMintScheduleParams{Denom: "rcoin"}
+	for i := 0; i <= types.MaxMintScheduleEntries; i++ {
+		params.Entries = append(params.Entries, MintScheduleEntry{
+			Time:   time.Unix(int64(1_600_000_000+i), 0),
+			Amount: sdk.NewInt(100),
+		})
+	}
+	_, err := params.Encode(contract)
+	require.EqualError(t, err, fmt.Sprintf("wasm: invalid SetMintScheduleParams: mint schedule cannot have more than %d entries",
+		types.MaxMintScheduleEntries))
+
+	params.Entries = params.Entries[1:]
+	msgs, err := params.Encode(contract)
+	require.NoError(t, err)
+	require.Len(t, msgs[0].(*types.MsgSetMintScheduleRequest).Entries, types.MaxMintScheduleEntries)
+}
@@ -13,17 +13,19 @@ import (
 
 // Marker represents a marker account in provwasm supported format.
 type Marker struct {
-	AccountNumber uint64         `json:"account_number"`
-	Address       string         `json:"address"`
-	Coins         sdk.Coins      `json:"coins"`
-	Denom         string         `json:"denom"`
-	Manager       string         `json:"manager"`
-	MarkerType    MarkerType     `json:"marker_type"`
-	Permissions   []*AccessGrant `json:"permissions,omitempty"`
-	Sequence      uint64         `json:"sequence"`
-	Status        MarkerStatus   `json:"status"`
-	TotalSupply   string         `json:"total_supply"`
-	SupplyFixed   bool           `json:"supply_fixed"`
+	AccountNumber       uint64         `json:"account_number"`
+	Address             string         `json:"address"`
+	Coins               sdk.Coins      `json:"coins"`
+	Denom               string         `json:"denom"`
+	Manager             string         `json:"manager"`
+	MarkerType          MarkerType     `json:"marker_type"`
+	Permissions         []*AccessGrant `json:"permissions,omitempty"`
+	Sequence            uint64         `json:"sequence"`
+	Status              MarkerStatus   `json:"status"`
+	TotalSupply         string         `json:"total_supply"`
+	SupplyFixed         bool           `json:"supply_fixed"`
+	RequiredAttributes  []string       `json:"required_attributes,omitempty"`
+	AllowForcedTransfer bool           `json:"allow_forced_transfer"`
 }
 
 // AccessGrant are marker permissions granted to an account.
@@ -56,6 +58,10 @@ const (
 	MarkerPermissionDelete MarkerPermission = "delete"
 	// MarkerPermissionDeposit is a concrete marker permission type
 	MarkerPermissionDeposit MarkerPermission = "deposit"
+	// MarkerPermissionFreeze is a concrete marker permission type
+	MarkerPermissionFreeze MarkerPermission = "freeze"
+	// MarkerPermissionForceTransfer is a concrete marker permission type
+	MarkerPermissionForceTransfer MarkerPermission = "force_transfer"
 	// MarkerPermissionMint is a concrete marker permission type
 	MarkerPermissionMint MarkerPermission = "mint"
 	// MarkerPermissionTransfer is a concrete marker permission type
@@ -87,16 +93,18 @@ const (
 // Convert a core marker type to provwasm supported format.
 func createResponseType(input *types.MarkerAccount, balance sdk.Coins) *Marker {
 	marker := &Marker{
-		AccountNumber: input.GetAccountNumber(),
-		Address:       input.GetAddress().String(),
-		Coins:         balance,
-		Denom:         input.GetDenom(),
-		Manager:       input.GetManager().String(),
-		MarkerType:    markerTypeFor(input.GetMarkerType()),
-		Sequence:      input.GetSequence(),
-		Status:        markerStatusFor(input.GetStatus()),
-		TotalSupply:   input.GetSupply().Amount.String(),
-		SupplyFixed:   input.SupplyFixed,
+		AccountNumber:       input.GetAccountNumber(),
+		Address:             input.GetAddress().String(),
+		Coins:               balance,
+		Denom:               input.GetDenom(),
+		Manager:             input.GetManager().String(),
+		MarkerType:          markerTypeFor(input.GetMarkerType()),
+		Sequence:            input.GetSequence(),
+		Status:              markerStatusFor(input.GetStatus()),
+		TotalSupply:         input.GetSupply().Amount.String(),
+		SupplyFixed:         input.SupplyFixed,
+		RequiredAttributes:  input.RequiredAttributes,
+		AllowForcedTransfer: input.AllowForcedTransfer,
 	}
 	for _, ag := range input.GetAccessList() {
 		marker.Permissions = append(marker.Permissions, accessGrantFor(ag))
@@ -156,6 +164,10 @@ func permissionFor(input types.Access) MarkerPermission {
 		return MarkerPermissionDelete
 	case types.Access_Deposit:
 		return MarkerPermissionDeposit
+	case types.Access_Freeze:
+		return MarkerPermissionFreeze
+	case types.Access_ForceTransfer:
+		return MarkerPermissionForceTransfer
 	case types.Access_Mint:
 		return MarkerPermissionMint
 	case types.Access_Transfer:
@@ -178,7 +178,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 				"inputname",
 				&metadatatypes.RecordInput_Hash{Hash: "notarealrecordinputhash"},
 				"inputtypename",
-				metadatatypes.RecordInputStatus_Record,
+				metadatatypes.RecordInputStatus_Proposed,
 			),
 		},
 		[]metadatatypes.RecordOutput{
@@ -268,13 +268,13 @@ specification_id: %s`,
 		s.contractSpecID,
 	)
 
-	s.recordAsJson = fmt.Sprintf("{\"name\":\"recordname\",\"session_id\":\"%s\",\"process\":{\"hash\":\"notarealprocesshash\",\"name\":\"record process\",\"method\":\"myMethod\"},\"inputs\":[{\"name\":\"inputname\",\"hash\":\"notarealrecordinputhash\",\"type_name\":\"inputtypename\",\"status\":\"RECORD_INPUT_STATUS_RECORD\"}],\"outputs\":[{\"hash\":\"notarealrecordoutputhash\",\"status\":\"RESULT_STATUS_PASS\"}]}",
+	s.recordAsJson = fmt.Sprintf("{\"name\":\"recordname\",\"session_id\":\"%s\",\"process\":{\"hash\":\"notarealprocesshash\",\"name\":\"record process\",\"method\":\"myMethod\"},\"inputs\":[{\"name\":\"inputname\",\"hash\":\"notarealrecordinputhash\",\"type_name\":\"inputtypename\",\"status\":\"RECORD_INPUT_STATUS_PROPOSED\"}],\"outputs\":[{\"hash\":\"notarealrecordoutputhash\",\"status\":\"RESULT_STATUS_PASS\"}]}",
 		s.sessionID,
 	)
 	s.recordAsText = fmt.Sprintf(`inputs:
 - hash: notarealrecordinputhash
   name: inputname
-  status: RECORD_INPUT_STATUS_RECORD
+  status: RECORD_INPUT_STATUS_PROPOSED
   type_name: inputtypename
 name: recordname
 outputs:
@@ -1441,6 +1441,169 @@ scope_uuids:
 	runQueryCmdTestCases(s, cmd, testCases)
 }
 
+func (s *IntegrationTestSuite) TestGetRecordHistoryCmd() {
+	cmd := cli.GetRecordHistoryCmd()
+
+	noHistoryAsJson := fmt.Sprintf("{\"scope_id\":\"%s\",\"name\":\"%s\",\"versions\":[],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+		s.scopeID, s.recordName,
+	)
+
+	testCases := []queryCmdTestCase{
+		{
+			"scope id and name as json",
+			[]string{s.scopeID.String(), s.recordName, s.asJson},
+			"",
+			noHistoryAsJson,
+		},
+		{
+			"scope uuid and name as json",
+			[]string{s.scopeUUID.String(), s.recordName, s.asJson},
+			"",
+			noHistoryAsJson,
+		},
+		{
+			"session id",
+			[]string{s.sessionID.String(), s.recordName},
+			fmt.Sprintf("id %s is not a scope metadata address", s.sessionID),
+			"",
+		},
+		{
+			"bad arg",
+			[]string{"not-an-id", s.recordName},
+			"argument not-an-id is neither a metadata address (decoding bech32 failed: invalid index of 1) nor uuid (invalid UUID length: 9)",
+			"",
+		},
+		{
+			"no name",
+			[]string{s.scopeID.String()},
+			"requires at least 2 arg(s), only received 1",
+			"",
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, testCases)
+}
+
+func (s *IntegrationTestSuite) TestGetSessionHistoryCmd() {
+	cmd := cli.GetSessionHistoryCmd()
+
+	noHistoryAsJson := fmt.Sprintf("{\"session_id\":\"%s\",\"versions\":[],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+		s.sessionID,
+	)
+
+	testCases := []queryCmdTestCase{
+		{
+			"session id as json",
+			[]string{s.sessionID.String(), s.asJson},
+			"",
+			noHistoryAsJson,
+		},
+		{
+			"scope uuid and session uuid as json",
+			[]string{s.scopeUUID.String(), s.sessionUUID.String(), s.asJson},
+			"",
+			noHistoryAsJson,
+		},
+		{
+			"scope id",
+			[]string{s.scopeID.String()},
+			fmt.Sprintf("id %s is not a session metadata address", s.scopeID),
+			"",
+		},
+		{
+			"no args",
+			[]string{},
+			"accepts between 1 and 2 arg(s), received 0",
+			"",
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, testCases)
+}
+
+func (s *IntegrationTestSuite) TestGetDataAccessCmd() {
+	cmd := cli.GetDataAccessCmd()
+
+	stranger := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	dataAccessAsJson := fmt.Sprintf("{\"scope_uuids\":[\"%s\"],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+		s.scopeUUID,
+	)
+
+	testCases := []queryCmdTestCase{
+		{
+			"as json",
+			[]string{s.user1, s.asJson},
+			"",
+			dataAccessAsJson,
+		},
+		{
+			"value owner as json",
+			[]string{s.user2, s.asJson},
+			"",
+			dataAccessAsJson,
+		},
+		{
+			"no result",
+			[]string{stranger},
+			fmt.Sprintf("address %s may not read the data of any scopes", stranger),
+			"",
+		},
+		{
+			"no args",
+			[]string{},
+			"accepts 1 arg(s), received 0",
+			"",
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, testCases)
+}
+
+func (s *IntegrationTestSuite) TestGetCanAccessCmd() {
+	cmd := cli.GetCanAccessCmd()
+
+	stranger := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	canAccessAsJson := func(address string, allowed, owner, dataAccess, valueOwner bool) string {
+		return fmt.Sprintf("{\"scope_id\":\"%s\",\"address\":\"%s\",\"allowed\":%t,\"owner\":%t,\"data_access\":%t,\"value_owner\":%t,\"marker_access\":false}",
+			s.scopeID, address, allowed, owner, dataAccess, valueOwner)
+	}
+
+	testCases := []queryCmdTestCase{
+		{
+			"owner and data access by scope id",
+			[]string{s.scopeID.String(), s.user1, s.asJson},
+			"",
+			canAccessAsJson(s.user1, true, true, true, false),
+		},
+		{
+			"value owner by scope uuid",
+			[]string{s.scopeUUID.String(), s.user2, s.asJson},
+			"",
+			canAccessAsJson(s.user2, true, false, false, true),
+		},
+		{
+			"no access by record id",
+			[]string{s.recordID.String(), stranger, s.asJson},
+			"",
+			canAccessAsJson(stranger, false, false, false, false),
+		},
+		{
+			"bad arg",
+			[]string{"not-an-id", s.user1},
+			"argument not-an-id is neither a metadata address (decoding bech32 failed: invalid index of 1) nor uuid (invalid UUID length: 9)",
+			"",
+		},
+		{
+			"one arg",
+			[]string{s.scopeID.String()},
+			"accepts 2 arg(s), received 1",
+			"",
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, testCases)
+}
+
 // ---------- tx cmd tests ----------
 
 func (s *IntegrationTestSuite) TestAddMetadataScopeCmd() {
@@ -1463,7 +1626,7 @@ func (s *IntegrationTestSuite) TestAddMetadataScopeCmd() {
 			cli.AddMetadataScopeCmd(),
 			[]string{
 				scopeUUID,
-				uuid.New().String(),
+				s.scopeSpecUUID.String(),
 				user,
 				user,
 				user,
@@ -1475,6 +1638,23 @@ func (s *IntegrationTestSuite) TestAddMetadataScopeCmd() {
 			},
 			false, &sdk.TxResponse{}, 0,
 		},
+		{
+			"Should fail to add metadata scope, scope specification does not exist",
+			cli.AddMetadataScopeCmd(),
+			[]string{
+				uuid.New().String(),
+				uuid.New().String(),
+				user,
+				user,
+				user,
+				s.testnet.Validators[0].Address.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, metadatatypes.ErrScopeSpecificationNotFound.ABCICode(),
+		},
 		{
 			"Should fail to add metadata scope, incorrect scope uuid",
 			cli.AddMetadataScopeCmd(),
@@ -1616,7 +1796,7 @@ func (s *IntegrationTestSuite) TestRemoveMetadataScopeCmd() {
 			cli.AddMetadataScopeCmd(),
 			[]string{
 				scopeUUID,
-				uuid.New().String(),
+				s.scopeSpecUUID.String(),
 				userId,
 				userId,
 				userId,
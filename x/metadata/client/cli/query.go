@@ -45,6 +45,10 @@ func GetQueryCmd() *cobra.Command {
 		GetMetadataRecordSpecCmd(),
 		GetOwnershipCmd(),
 		GetValueOwnershipCmd(),
+		GetRecordHistoryCmd(),
+		GetSessionHistoryCmd(),
+		GetDataAccessCmd(),
+		GetCanAccessCmd(),
 	)
 	return queryCmd
 }
@@ -474,6 +478,144 @@ func GetValueOwnershipCmd() *cobra.Command {
 	return cmd
 }
 
+// GetRecordHistoryCmd returns the command handler for querying the prior versions of a record.
+func GetRecordHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "record-history {scope_id|scope_uuid} {record_name}",
+		Aliases: []string{"rh", "recordhistory"},
+		Short:   "Query the prior versions of a record",
+		Long: fmt.Sprintf(`%[1]s record-history {scope_id} {record_name} - gets the prior versions of the record with the given name in a scope.
+%[1]s record-history {scope_uuid} {record_name} - gets the prior versions of the record with the given name in a scope.`, cmdStart),
+		Args: cobra.MinimumNArgs(2),
+		Example: fmt.Sprintf(`%[1]s record-history scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel recordname
+%[1]s record-history 91978ba2-5f35-459a-86a7-feca1b0512e0 recordname`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			arg0 := strings.TrimSpace(args[0])
+			name := trimSpaceAndJoin(args[1:], " ")
+			if len(name) == 0 {
+				return fmt.Errorf("empty record name")
+			}
+			id, idErr := types.MetadataAddressFromBech32(arg0)
+			if idErr == nil {
+				if !id.IsScopeAddress() {
+					return fmt.Errorf("id %s is not a scope metadata address", id)
+				}
+				return recordHistory(cmd, id, name)
+			}
+			scopeUUID, uuidErr := uuid.Parse(arg0)
+			if uuidErr != nil {
+				return fmt.Errorf("argument %s is neither a metadata address (%s) nor uuid (%s)", arg0, idErr.Error(), uuidErr.Error())
+			}
+			return recordHistory(cmd, types.ScopeMetadataAddress(scopeUUID), name)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "record history")
+
+	return cmd
+}
+
+// GetSessionHistoryCmd returns the command handler for querying the prior versions of a session.
+func GetSessionHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "session-history {session_id|scope_uuid session_uuid}",
+		Aliases: []string{"sh", "sessionhistory"},
+		Short:   "Query the prior versions of a session",
+		Long: fmt.Sprintf(`%[1]s session-history {session_id} - gets the prior versions of the session with the given id.
+%[1]s session-history {scope_uuid} {session_uuid} - gets the prior versions of the session with the given scope uuid and session uuid.`, cmdStart),
+		Args: cobra.RangeArgs(1, 2),
+		Example: fmt.Sprintf(`%[1]s session-history session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr
+%[1]s session-history 91978ba2-5f35-459a-86a7-feca1b0512e0 5803f8bc-6067-4eb5-951f-2121671c2ec0`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			arg0 := strings.TrimSpace(args[0])
+			if len(args) == 1 {
+				id, err := types.MetadataAddressFromBech32(arg0)
+				if err != nil {
+					return err
+				}
+				if !id.IsSessionAddress() {
+					return fmt.Errorf("id %s is not a session metadata address", id)
+				}
+				return sessionHistory(cmd, id)
+			}
+			scopeUUID, err := uuid.Parse(arg0)
+			if err != nil {
+				return err
+			}
+			sessionUUID, err := uuid.Parse(strings.TrimSpace(args[1]))
+			if err != nil {
+				return err
+			}
+			return sessionHistory(cmd, types.SessionMetadataAddress(scopeUUID, sessionUUID))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "session history")
+
+	return cmd
+}
+
+// GetDataAccessCmd returns the command handler for querying the scopes an address may read the data of.
+func GetDataAccessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "data-access {address}",
+		Aliases: []string{"da", "dataaccess"},
+		Short:   "Query the current metadata for scopes the provided address may read the data of",
+		Long: fmt.Sprintf(`%[1]s data-access {address} - gets a list of scope uuids listing the provided address as an owner, data access party, or value owner.
+Scopes readable through an access grant on a marker value owner are listed by querying the marker address.`, cmdStart),
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s data-access cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address := strings.TrimSpace(args[0])
+			if len(address) == 0 {
+				return fmt.Errorf("empty address")
+			}
+			return scopesWithDataAccessAddress(cmd, address)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "data access")
+
+	return cmd
+}
+
+// GetCanAccessCmd returns the command handler for checking whether an address may receive the data of a scope.
+func GetCanAccessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "can-access {scope_id|session_id|record_id|scope_uuid} {address}",
+		Aliases: []string{"ca", "canaccess"},
+		Short:   "Query whether an address may receive the data of a scope",
+		Long: fmt.Sprintf(`%[1]s can-access {scope_id|session_id|record_id} {address} - checks the access of an address to the scope with, or containing, the given id.
+%[1]s can-access {scope_uuid} {address} - checks the access of an address to the scope with the given uuid.`, cmdStart),
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`%[1]s can-access scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck
+%[1]s can-access 91978ba2-5f35-459a-86a7-feca1b0512e0 cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			arg0 := strings.TrimSpace(args[0])
+			address := strings.TrimSpace(args[1])
+			if len(address) == 0 {
+				return fmt.Errorf("empty address")
+			}
+			id, idErr := types.MetadataAddressFromBech32(arg0)
+			if idErr == nil {
+				return canAccess(cmd, id, address)
+			}
+			scopeUUID, uuidErr := uuid.Parse(arg0)
+			if uuidErr != nil {
+				return fmt.Errorf("argument %s is neither a metadata address (%s) nor uuid (%s)", arg0, idErr.Error(), uuidErr.Error())
+			}
+			return canAccess(cmd, types.ScopeMetadataAddress(scopeUUID), address)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // scopeByUUID outputs a scope looked up by scope UUID.
 func scopeByUUID(cmd *cobra.Command, scopeUUID string) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -870,6 +1012,94 @@ func scopesValueOwnedByAddress(cmd *cobra.Command, address string) error {
 	return clientCtx.PrintProto(res)
 }
 
+// recordHistory outputs the prior versions of the record with the given name in a scope.
+func recordHistory(cmd *cobra.Command, scopeID types.MetadataAddress, name string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, err := client.ReadPageRequest(cmd.Flags())
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.RecordHistory(
+		context.Background(),
+		&types.RecordHistoryRequest{ScopeId: scopeID.String(), Name: name, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// sessionHistory outputs the prior versions of the session with the given id.
+func sessionHistory(cmd *cobra.Command, sessionID types.MetadataAddress) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, err := client.ReadPageRequest(cmd.Flags())
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.SessionHistory(
+		context.Background(),
+		&types.SessionHistoryRequest{SessionId: sessionID.String(), Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// scopesWithDataAccessAddress outputs the scope uuids that list the provided address as an owner, data access party, or
+// value owner.
+func scopesWithDataAccessAddress(cmd *cobra.Command, address string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, err := client.ReadPageRequest(cmd.Flags())
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.DataAccess(
+		context.Background(),
+		&types.DataAccessRequest{Address: address, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+	if res == nil || len(res.ScopeUuids) == 0 {
+		return fmt.Errorf("address %s may not read the data of any scopes", address)
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// canAccess outputs whether the provided address may receive the data of the scope with, or containing, the given id.
+func canAccess(cmd *cobra.Command, id types.MetadataAddress, address string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.CanAccess(
+		context.Background(),
+		&types.CanAccessRequest{Id: id.String(), Address: address},
+	)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // trimSpaceAndJoin trims leading and trailing whitespace from each arg,
 // then joins them using the provided sep string,
 // then lastly trims any left over leading and trailing whitespace from that result.
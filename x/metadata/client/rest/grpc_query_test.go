@@ -101,6 +101,9 @@ func (suite *IntegrationTestSuite) SetupSuite() {
 	var metadataData metadatatypes.GenesisState
 	metadataData.Params = metadatatypes.DefaultParams()
 	metadataData.Scopes = append(metadataData.Scopes, suite.scope)
+	metadataData.ScopeSpecifications = append(metadataData.ScopeSpecifications,
+		*metadatatypes.NewScopeSpecification(suite.specID, nil, []string{suite.user1},
+			[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{}))
 	metadataDataBz, err := cfg.Codec.MarshalJSON(&metadataData)
 	suite.Require().NoError(err)
 
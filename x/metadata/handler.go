@@ -14,6 +14,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		switch msg := msg.(type) {
+		case *types.MsgMemorializeContractRequest:
+			res, err := msgServer.MemorializeContract(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgChangeOwnershipRequest:
+			res, err := msgServer.ChangeOwnership(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgAddScopeRequest:
 			res, err := msgServer.AddScope(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -51,6 +57,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgAddP8EContractSpecRequest:
 			res, err := msgServer.AddP8EContractSpec(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgP8EMemorializeContractRequest:
+			res, err := msgServer.P8EMemorializeContract(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown message type: %v", msg.Type())
 		}
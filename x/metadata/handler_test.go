@@ -1,6 +1,8 @@
 package metadata_test
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"testing"
 
@@ -9,9 +11,14 @@ import (
 	"github.com/provenance-io/provenance/app"
 	simapp "github.com/provenance-io/provenance/app"
 
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 
 	testnet "github.com/cosmos/cosmos-sdk/testutil/network"
@@ -90,6 +97,13 @@ func (s HandlerTestSuite) TestAddContractSpecMsg() {
 	validDefSpec := createDefinitionSpec("perform_input_checks", "io.provenance.loan.LoanProtos$PartiesList", p8e.ProvenanceReference{Hash: "Adv+huolGTKofYCR0dw5GHm/R7sUWOwF32XR8r8r9kDy4il5U/LApxOWYHb05jhK4+eY4YzRMRiWcxU3Lx0+Mw=="}, 1)
 	invalidDefSpec := createDefinitionSpec("perform_action", "", p8e.ProvenanceReference{Hash: "Adv+huolGTKofYCR0dw5GHm/R7sUWOwF32XR8r8r9kDy4il5U/LApxOWYHb05jhK4+eY4YzRMRiWcxU3Lx0+Mw=="}, 1)
 
+	// the cases run in random order, make sure there is an existing spec owned by user1 for the signer checks.
+	_, err := s.handler(s.ctx, &types.MsgAddP8EContractSpecRequest{
+		Contractspec: createContractSpec([]*p8e.DefinitionSpec{&validDefSpec}, p8e.OutputSpec{Spec: &validDefSpec}, validDefSpec),
+		Signers:      []string{s.user1},
+	})
+	s.Require().NoError(err)
+
 	cases := map[string]struct {
 		v39CSpec p8e.ContractSpec
 		signers  []string
@@ -136,3 +150,502 @@ func (s HandlerTestSuite) TestAddContractSpecMsg() {
 	}
 
 }
+
+// signatures creates raw signature descriptors over the marshalled message for each of the keys.
+func (s HandlerTestSuite) signatures(msg codec.ProtoMarshaler, keys ...*secp256k1.PrivKey) signing.SignatureDescriptors {
+	bz, err := s.app.AppCodec().MarshalBinaryBare(msg)
+	s.Require().NoError(err)
+	descriptors := signing.SignatureDescriptors{}
+	for _, key := range keys {
+		sig, err := key.Sign(bz)
+		s.Require().NoError(err)
+		pubKey, err := codectypes.NewAnyWithValue(key.PubKey())
+		s.Require().NoError(err)
+		descriptors.Signatures = append(descriptors.Signatures, &signing.SignatureDescriptor{
+			PublicKey: pubKey,
+			Data:      signing.SignatureDataToProto(&signing.SingleSignatureData{Signature: sig}),
+		})
+	}
+	return descriptors
+}
+
+func (s HandlerTestSuite) TestMemorializeContractMsg() {
+	ownerKey := secp256k1.GenPrivKey()
+	owner := sdk.AccAddress(ownerKey.PubKey().Address()).String()
+	otherKey := secp256k1.GenPrivKey()
+
+	specHash := "Adv+huolGTKofYCR0dw5GHm/R7sUWOwF32XR8r8r9kDy4il5U/LApxOWYHb05jhK4+eY4YzRMRiWcxU3Lx0+Mw=="
+	contractSpecID, err := types.ConvertHashToAddress(types.ContractSpecificationKeyPrefix, specHash)
+	s.Require().NoError(err)
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *types.NewContractSpecification(
+		contractSpecID,
+		types.NewDescription("ExampleContract", "description", "", ""),
+		[]string{owner},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		&types.ContractSpecification_Hash{Hash: specHash},
+		"io.provenance.contracts.ExampleContract",
+	))
+	recordSpecID, err := contractSpecID.AsRecordSpecAddress("additional_parties")
+	s.Require().NoError(err)
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *types.NewRecordSpecification(
+		recordSpecID,
+		"additional_parties",
+		[]*types.InputSpecification{types.NewInputSpecification("parties", "io.provenance.Parties",
+			types.NewInputSpecificationSourceHash("aW5wdXQ="))},
+		"io.provenance.Parties",
+		types.DefinitionType_DEFINITION_TYPE_RECORD,
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	))
+
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(scopeID, nil, []types.Party{{Address: owner, Role: types.PartyType_PARTY_TYPE_OWNER}}, []string{}, ""))
+	otherScopeUUID := uuid.New()
+	otherScopeID := types.ScopeMetadataAddress(otherScopeUUID)
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(otherScopeID, nil, []types.Party{{Address: owner, Role: types.PartyType_PARTY_TYPE_OWNER}}, []string{}, ""))
+
+	createContract := func(conditionResult types.ResultType) types.Contract {
+		return types.Contract{
+			Definition: types.Definition{Name: "ExampleContract"},
+			Spec:       types.RecordReference{Name: "spec", Reference: types.Reference{ScopeId: scopeUUID.String(), Hash: specHash}},
+			Invoker:    owner,
+			Conditions: []types.Condition{{ConditionName: "precondition", Result: types.ExecutionResult{Result: conditionResult}}},
+			Considerations: []types.Consideration{
+				{
+					ConsiderationName: "additionalParties",
+					Inputs:            []types.ProposedRecord{{Name: "parties", Hash: "aW5wdXQ=", TypeName: "io.provenance.Parties"}},
+					Result: types.ExecutionResult{
+						Output: types.ProposedRecord{Name: "additional_parties", Hash: "b3V0cHV0", TypeName: "io.provenance.Parties"},
+						Result: types.ResultType_RESULT_TYPE_PASS,
+					},
+				},
+				{
+					ConsiderationName: "skipped",
+					Result:            types.ExecutionResult{Result: types.ResultType_RESULT_TYPE_SKIP},
+				},
+			},
+			Recitals: types.Recitals{Parties: []*types.Recital{{SignerRole: types.PartyType_PARTY_TYPE_OWNER, Address: owner}}},
+		}
+	}
+	sessionUUID := uuid.New()
+	sessionID := types.SessionMetadataAddress(scopeUUID, sessionUUID)
+	executionID := uuid.New().String()
+	sign := func(contract types.Contract, key *secp256k1.PrivKey) signing.SignatureDescriptors {
+		return s.signatures(types.NewContractExecution(scopeID, sessionID, executionID, contract), key)
+	}
+
+	validContract := createContract(types.ResultType_RESULT_TYPE_PASS)
+	unknownSpecContract := createContract(types.ResultType_RESULT_TYPE_PASS)
+	unknownSpecContract.Spec.Reference.Hash = "E36eeTUk8GYXGXjIbZTm4s/Dw3G1e42SinH1195t4ekgcXXPhfIpfQaEJ21PTzKhdv6JjhzQJ2kAJXK+TRXmeQ=="
+	failedContract := createContract(types.ResultType_RESULT_TYPE_FAIL)
+	wrongSignatures := sign(validContract, ownerKey)
+	wrongSignatures.Signatures[0].Data = sign(failedContract, ownerKey).Signatures[0].Data
+
+	cases := map[string]struct {
+		contract   types.Contract
+		signatures signing.SignatureDescriptors
+		errorMsg   string
+	}{
+		"should fail when the recital party has not signed": {
+			validContract,
+			sign(validContract, otherKey),
+			fmt.Sprintf("missing signature from existing owner %s; required for update", owner),
+		},
+		"should fail when the signature does not match the contract": {
+			validContract,
+			wrongSignatures,
+			"invalid signature 0: unable to verify single signer signature",
+		},
+		"should fail when the signatures are for another session": {
+			validContract,
+			s.signatures(types.NewContractExecution(scopeID, types.SessionMetadataAddress(scopeUUID, uuid.New()), executionID,
+				validContract), ownerKey),
+			"invalid signature 0: unable to verify single signer signature",
+		},
+		"should fail when the signatures are for another scope": {
+			validContract,
+			s.signatures(types.NewContractExecution(otherScopeID, types.SessionMetadataAddress(otherScopeUUID, sessionUUID),
+				executionID, validContract), ownerKey),
+			"invalid signature 0: unable to verify single signer signature",
+		},
+		"should fail when the signatures are for another execution": {
+			validContract,
+			s.signatures(types.NewContractExecution(scopeID, sessionID, uuid.New().String(), validContract), ownerKey),
+			"invalid signature 0: unable to verify single signer signature",
+		},
+		"should fail when the contract specification does not exist": {
+			unknownSpecContract,
+			sign(unknownSpecContract, ownerKey),
+			"cannot find contract specification contractspec1qvfha8nex5j0qeshr9uvsmv5um3q7sghss",
+		},
+		"should fail when a condition did not pass": {
+			failedContract,
+			sign(failedContract, ownerKey),
+			"contract condition precondition did not pass (RESULT_TYPE_FAIL)",
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		s.Run(n, func() {
+			msg := &types.MsgMemorializeContractRequest{
+				ScopeId:     scopeID.String(),
+				SessionId:   sessionUUID.String(),
+				ExecutionId: executionID,
+				Contract:    tc.contract,
+				Signatures:  tc.signatures,
+				Notary:      owner,
+			}
+			_, err := s.handler(s.ctx, msg)
+			s.Require().Error(err)
+			s.Equal(tc.errorMsg, err.Error())
+		})
+	}
+
+	_, err = s.handler(s.ctx, &types.MsgMemorializeContractRequest{
+		ScopeId:     scopeUUID.String(),
+		SessionId:   sessionUUID.String(),
+		ExecutionId: executionID,
+		Contract:    validContract,
+		Signatures:  sign(validContract, ownerKey),
+		Notary:      owner,
+	})
+	s.Require().NoError(err)
+
+	session, found := s.app.MetadataKeeper.GetSession(s.ctx, sessionID)
+	s.Require().True(found, "session should have been recorded")
+	s.Equal(contractSpecID, session.SpecificationId)
+	s.Equal("io.provenance.contracts.ExampleContract", session.Name)
+	s.Equal(executionID, session.Audit.Message)
+
+	records, err := s.app.MetadataKeeper.GetRecords(s.ctx, scopeID, "")
+	s.Require().NoError(err)
+	s.Require().Len(records, 1, "skipped considerations should not be recorded")
+	s.Equal("additional_parties", records[0].Name)
+	s.Equal(sessionID, records[0].SessionId)
+	s.Equal("additionalParties", records[0].Process.Method)
+	s.Equal([]types.RecordOutput{{Hash: "b3V0cHV0", Status: types.ResultStatus_RESULT_STATUS_PASS}}, records[0].Outputs)
+	s.Equal(types.RecordInputStatus_Proposed, records[0].Inputs[0].Status)
+
+	_, err = s.handler(s.ctx, &types.MsgMemorializeContractRequest{
+		ScopeId:     scopeUUID.String(),
+		SessionId:   sessionUUID.String(),
+		ExecutionId: executionID,
+		Contract:    validContract,
+		Signatures:  sign(validContract, ownerKey),
+		Notary:      owner,
+	})
+	s.Require().EqualError(err, fmt.Sprintf("execution %s has already been recorded in scope %s", executionID, scopeID))
+}
+
+func (s HandlerTestSuite) TestChangeOwnershipMsg() {
+	ownerKey := secp256k1.GenPrivKey()
+	owner := sdk.AccAddress(ownerKey.PubKey().Address()).String()
+	newOwnerKey := secp256k1.GenPrivKey()
+	newOwner := sdk.AccAddress(newOwnerKey.PubKey().Address()).String()
+	dataAccess := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(scopeID, nil,
+		[]types.Party{{Address: owner, Role: types.PartyType_PARTY_TYPE_OWNER}}, []string{dataAccess}, owner))
+
+	otherScopeID := types.ScopeMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(otherScopeID, nil,
+		[]types.Party{{Address: owner, Role: types.PartyType_PARTY_TYPE_OWNER}}, []string{}, owner))
+
+	custodian := types.Recitals{Parties: []*types.Recital{{SignerRole: types.PartyType_PARTY_TYPE_CUSTODIAN, Address: newOwner}}}
+	transfer := types.Recitals{Parties: []*types.Recital{{SignerRole: types.PartyType_PARTY_TYPE_OWNER, Address: newOwner}}}
+	sign := func(scopeID types.MetadataAddress, executionID string, recitals types.Recitals, keys ...*secp256k1.PrivKey) signing.SignatureDescriptors {
+		return s.signatures(types.NewRecitalsExecution(scopeID, executionID, recitals), keys...)
+	}
+
+	executionID := uuid.New().String()
+	cases := map[string]struct {
+		recitals   types.Recitals
+		signatures signing.SignatureDescriptors
+		errorMsg   string
+	}{
+		"should fail when the existing owner has not signed": {
+			transfer,
+			sign(scopeID, executionID, transfer, newOwnerKey),
+			fmt.Sprintf("missing signature from existing owner %s; required for update", owner),
+		},
+		"should fail when a new party has not signed": {
+			transfer,
+			sign(scopeID, executionID, transfer, ownerKey),
+			fmt.Sprintf("missing signature from existing owner %s; required for update", newOwner),
+		},
+		"should fail when the signatures are not for the recitals": {
+			transfer,
+			sign(scopeID, executionID, custodian, ownerKey, newOwnerKey),
+			"invalid signature 0: unable to verify single signer signature",
+		},
+		"should fail when the signatures are for another execution": {
+			transfer,
+			sign(scopeID, uuid.New().String(), transfer, ownerKey, newOwnerKey),
+			"invalid signature 0: unable to verify single signer signature",
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		s.Run(n, func() {
+			_, err := s.handler(s.ctx, &types.MsgChangeOwnershipRequest{
+				ScopeId:     scopeID.String(),
+				SessionId:   uuid.New().String(),
+				ExecutionId: executionID,
+				Recitals:    &tc.recitals,
+				Signatures:  tc.signatures,
+				Notary:      owner,
+			})
+			s.Require().Error(err)
+			s.Equal(tc.errorMsg, err.Error())
+		})
+	}
+
+	// A custodian change keeps the value owner and existing data access in place, a recitals only change does not
+	// need a session.
+	custodianChange := &types.MsgChangeOwnershipRequest{
+		ScopeId:     scopeID.String(),
+		ExecutionId: executionID,
+		Recitals:    &custodian,
+		Signatures:  sign(scopeID, executionID, custodian, ownerKey, newOwnerKey),
+		Notary:      owner,
+	}
+	_, err := s.handler(s.ctx, custodianChange)
+	s.Require().NoError(err)
+	scope, found := s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+	s.Require().True(found)
+	s.Equal(custodian.AsParties(), scope.Owners)
+	s.Equal([]string{dataAccess, newOwner}, scope.DataAccess)
+	s.Equal(owner, scope.ValueOwnerAddress)
+
+	// The signatures of the change cannot be replayed against another scope with the same owners or the same scope.
+	replay := *custodianChange
+	replay.ScopeId = otherScopeID.String()
+	replay.ExecutionId = uuid.New().String()
+	_, err = s.handler(s.ctx, &replay)
+	s.Require().EqualError(err, "invalid signature 0: unable to verify single signer signature")
+	replay.ExecutionId = executionID
+	_, err = s.handler(s.ctx, &replay)
+	s.Require().EqualError(err, fmt.Sprintf("execution %s has already been recorded in scope %s", executionID, scopeID))
+	_, err = s.handler(s.ctx, custodianChange)
+	s.Require().EqualError(err, fmt.Sprintf("execution %s has already been recorded in scope %s", executionID, scopeID))
+	otherScope, found := s.app.MetadataKeeper.GetScope(s.ctx, otherScopeID)
+	s.Require().True(found)
+	s.Equal([]types.Party{{Address: owner, Role: types.PartyType_PARTY_TYPE_OWNER}}, otherScope.Owners)
+
+	// Moving the value requires the value owner (still the original owner) to sign along with the new owner.
+	executionID = uuid.New().String()
+	_, err = s.handler(s.ctx, &types.MsgChangeOwnershipRequest{
+		ScopeId:     scopeID.String(),
+		SessionId:   uuid.New().String(),
+		ExecutionId: executionID,
+		Recitals:    &transfer,
+		Signatures:  sign(scopeID, executionID, transfer, newOwnerKey),
+		Notary:      owner,
+	})
+	s.Require().EqualError(err, fmt.Sprintf("missing signature from existing owner %s; required for update", owner))
+
+	_, err = s.handler(s.ctx, &types.MsgChangeOwnershipRequest{
+		ScopeId:     scopeID.String(),
+		SessionId:   uuid.New().String(),
+		ExecutionId: executionID,
+		Recitals:    &transfer,
+		Signatures:  sign(scopeID, executionID, transfer, ownerKey, newOwnerKey),
+		Notary:      owner,
+	})
+	s.Require().NoError(err)
+	scope, found = s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+	s.Require().True(found)
+	s.Equal(transfer.AsParties(), scope.Owners)
+	s.Equal(newOwner, scope.ValueOwnerAddress)
+
+	scopesFor := func(address string) []types.MetadataAddress {
+		addr, err := sdk.AccAddressFromBech32(address)
+		s.Require().NoError(err)
+		ids := []types.MetadataAddress{}
+		err = s.app.MetadataKeeper.IterateScopesForAddress(s.ctx, addr, func(scopeID types.MetadataAddress) bool {
+			ids = append(ids, scopeID)
+			return false
+		})
+		s.Require().NoError(err)
+		return ids
+	}
+	s.Equal([]types.MetadataAddress{scopeID}, scopesFor(newOwner), "new owner should be indexed")
+	s.Equal([]types.MetadataAddress{otherScopeID}, scopesFor(owner), "previous owner index should be removed")
+	s.Equal([]types.MetadataAddress{scopeID}, scopesFor(dataAccess), "existing data access should be kept")
+}
+
+// p8eSignatures creates p8e signatures over the marshalled p8e contract for each of the keys.
+func (s HandlerTestSuite) p8eSignatures(contract p8e.Contract, keys ...*secp256k1.PrivKey) p8e.SignatureSet {
+	bz, err := s.app.AppCodec().MarshalBinaryBare(&contract)
+	s.Require().NoError(err)
+	hash := sha256.Sum256(bz)
+	set := p8e.SignatureSet{}
+	for _, key := range keys {
+		privKey, pubKey := btcec.PrivKeyFromBytes(btcec.S256(), key.Key)
+		sig, err := privKey.Sign(hash[:])
+		s.Require().NoError(err)
+		set.Signatures = append(set.Signatures, &p8e.Signature{
+			Algo:      "SHA256withECDSA",
+			Provider:  "BC",
+			Signature: base64.StdEncoding.EncodeToString(sig.Serialize()),
+			Signer: &p8e.SigningAndEncryptionPublicKeys{
+				SigningPublicKey: &p8e.PublicKey{PublicKeyBytes: pubKey.SerializeUncompressed()},
+			},
+		})
+	}
+	return set
+}
+
+func (s HandlerTestSuite) TestP8EMemorializeContractMsg() {
+	ownerKey := secp256k1.GenPrivKey()
+	ownerAddr := sdk.AccAddress(ownerKey.PubKey().Address())
+	owner := ownerAddr.String()
+	otherKey := secp256k1.GenPrivKey()
+
+	specHash := "Adv+huolGTKofYCR0dw5GHm/R7sUWOwF32XR8r8r9kDy4il5U/LApxOWYHb05jhK4+eY4YzRMRiWcxU3Lx0+Mw=="
+	contractSpecID, err := types.ConvertHashToAddress(types.ContractSpecificationKeyPrefix, specHash)
+	s.Require().NoError(err)
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *types.NewContractSpecification(
+		contractSpecID,
+		types.NewDescription("ExampleContract", "description", "", ""),
+		[]string{owner},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		&types.ContractSpecification_Hash{Hash: specHash},
+		"io.provenance.contracts.ExampleContract",
+	))
+	recordSpecID, err := contractSpecID.AsRecordSpecAddress("additional_parties")
+	s.Require().NoError(err)
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *types.NewRecordSpecification(
+		recordSpecID,
+		"additional_parties",
+		[]*types.InputSpecification{types.NewInputSpecification("parties", "io.provenance.Parties",
+			types.NewInputSpecificationSourceHash("aW5wdXQ="))},
+		"io.provenance.Parties",
+		types.DefinitionType_DEFINITION_TYPE_RECORD,
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	))
+	scopeSpecUUID := uuid.New()
+	scopeSpecID := types.ScopeSpecMetadataAddress(scopeSpecUUID)
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *types.NewScopeSpecification(
+		scopeSpecID,
+		types.NewDescription("ExampleScope", "description", "", ""),
+		[]string{owner},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		[]types.MetadataAddress{contractSpecID},
+	))
+
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+
+	contract := p8e.Contract{
+		Definition: &p8e.DefinitionSpec{Name: "ExampleContract"},
+		Spec: &p8e.Fact{
+			Name:         "spec",
+			DataLocation: &p8e.Location{Ref: &p8e.ProvenanceReference{Hash: specHash}},
+		},
+		Conditions: []*p8e.Condition{{ConditionName: "precondition", Result: &p8e.ExecutionResult{Result: p8e.ExecutionResultType_RESULT_TYPE_PASS}}},
+		Considerations: []*p8e.Consideration{
+			{
+				ConsiderationName: "additionalParties",
+				Inputs:            []*p8e.ProposedFact{{Name: "parties", Hash: "aW5wdXQ=", Classname: "io.provenance.Parties"}},
+				Result: &p8e.ExecutionResult{
+					Output: &p8e.ProposedFact{Name: "additional_parties", Hash: "b3V0cHV0", Classname: "io.provenance.Parties"},
+					Result: p8e.ExecutionResultType_RESULT_TYPE_PASS,
+				},
+			},
+		},
+		Recitals: []*p8e.Recital{{SignerRole: p8e.PartyType_PARTY_TYPE_OWNER, Address: ownerAddr}},
+	}
+	wrongAlgo := s.p8eSignatures(contract, ownerKey)
+	wrongAlgo.Signatures[0].Algo = "SHA1withECDSA"
+	otherContract := contract
+	otherContract.Definition = &p8e.DefinitionSpec{Name: "OtherContract"}
+
+	cases := map[string]struct {
+		signatures p8e.SignatureSet
+		errorMsg   string
+	}{
+		"should fail when the recital party has not signed": {
+			s.p8eSignatures(contract, otherKey),
+			fmt.Sprintf("missing signature from existing owner %s; required for update", owner),
+		},
+		"should fail when the signature does not match the contract": {
+			s.p8eSignatures(otherContract, ownerKey),
+			"invalid signature 0: unable to verify signature",
+		},
+		"should fail with an unsupported signature algorithm": {
+			wrongAlgo,
+			"invalid signature 0: unsupported signature algorithm \"SHA1withECDSA\"",
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+		s.Run(n, func() {
+			_, err := s.handler(s.ctx, &types.MsgP8EMemorializeContractRequest{
+				ScopeId:              scopeUUID.String(),
+				GroupId:              uuid.New().String(),
+				ExecutionId:          uuid.New().String(),
+				ScopeSpecificationId: scopeSpecUUID.String(),
+				Contract:             contract,
+				Signatures:           tc.signatures,
+				Invoker:              owner,
+			})
+			s.Require().Error(err)
+			s.Equal(tc.errorMsg, err.Error())
+		})
+	}
+
+	groupUUID := uuid.New()
+	executionID := uuid.New().String()
+	_, err = s.handler(s.ctx, &types.MsgP8EMemorializeContractRequest{
+		ScopeId:              scopeUUID.String(),
+		GroupId:              groupUUID.String(),
+		ExecutionId:          executionID,
+		ScopeSpecificationId: scopeSpecUUID.String(),
+		Contract:             contract,
+		Signatures:           s.p8eSignatures(contract, ownerKey),
+		Invoker:              owner,
+	})
+	s.Require().NoError(err)
+
+	scope, found := s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+	s.Require().True(found, "scope should have been created")
+	s.Equal(scopeSpecID, scope.SpecificationId)
+	s.Equal([]types.Party{{Address: owner, Role: types.PartyType_PARTY_TYPE_OWNER}}, scope.Owners)
+	s.Equal(owner, scope.ValueOwnerAddress)
+
+	sessionID := types.SessionMetadataAddress(scopeUUID, groupUUID)
+	session, found := s.app.MetadataKeeper.GetSession(s.ctx, sessionID)
+	s.Require().True(found, "session should have been recorded")
+	s.Equal(contractSpecID, session.SpecificationId)
+	s.Equal(executionID, session.Audit.Message)
+
+	records, err := s.app.MetadataKeeper.GetRecords(s.ctx, scopeID, "")
+	s.Require().NoError(err)
+	s.Require().Len(records, 1)
+	s.Equal("additional_parties", records[0].Name)
+	s.Equal(sessionID, records[0].SessionId)
+	s.Equal([]types.RecordOutput{{Hash: "b3V0cHV0", Status: types.ResultStatus_RESULT_STATUS_PASS}}, records[0].Outputs)
+
+	// The recitals are not covered by the contract signatures so a notary cannot use them to swap the scope owners.
+	swap := &types.MsgP8EMemorializeContractRequest{
+		ScopeId:     scopeUUID.String(),
+		GroupId:     uuid.New().String(),
+		ExecutionId: uuid.New().String(),
+		Recitals: &p8e.Recitals{Parties: []*p8e.Recital{{
+			SignerRole: p8e.PartyType_PARTY_TYPE_OWNER,
+			Address:    sdk.AccAddress(otherKey.PubKey().Address()),
+		}}},
+		Contract:   contract,
+		Signatures: s.p8eSignatures(contract, ownerKey),
+		Invoker:    owner,
+	}
+	s.Require().EqualError(swap.ValidateBasic(), "only one of contract or recitals is allowed")
+	_, err = s.handler(s.ctx, swap)
+	s.Require().EqualError(err, "only one of contract or recitals is allowed")
+	scope, found = s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+	s.Require().True(found)
+	s.Equal([]types.Party{{Address: owner, Role: types.PartyType_PARTY_TYPE_OWNER}}, scope.Owners)
+}
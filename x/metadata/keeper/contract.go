@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// ValidateContractExecution checks the results of a contract execution against the contract specification and the
+// scope it is being recorded in.  The session and records that memorialize the execution are returned.
+func (k Keeper) ValidateContractExecution(
+	ctx sdk.Context,
+	sessionID types.MetadataAddress,
+	executionID string,
+	contract types.Contract,
+	signers []string,
+) (*types.Session, []types.Record, error) {
+	contractSpecID, err := contract.ContractSpecID()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid contract specification reference: %w", err)
+	}
+	contractSpec, found := k.GetContractSpecification(ctx, contractSpecID)
+	if !found {
+		return nil, nil, fmt.Errorf("cannot find contract specification %s", contractSpecID)
+	}
+	for _, c := range contract.Conditions {
+		if c.Result.Result != types.ResultType_RESULT_TYPE_PASS {
+			return nil, nil, fmt.Errorf("contract condition %s did not pass (%s)", c.ConditionName, c.Result.Result)
+		}
+	}
+
+	existing, _ := k.GetSession(ctx, sessionID)
+	session := types.NewSession(contractSpec.ClassName, sessionID, contractSpecID, contract.Recitals.AsParties(), existing.Audit)
+	if err = k.ValidateSessionUpdate(ctx, existing, *session, signers); err != nil {
+		return nil, nil, err
+	}
+	session.Audit = existing.Audit.UpdateAudit(ctx.BlockTime(), strings.Join(signers, ", "), executionID)
+
+	records, err := contract.Records(sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, record := range records {
+		recordID, err := sessionID.AsRecordAddress(record.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		existingRecord, _ := k.GetRecord(ctx, recordID)
+		if err = k.validateRecordUpdate(ctx, existingRecord, record, session, signers); err != nil {
+			return nil, nil, fmt.Errorf("invalid record %s: %w", record.Name, err)
+		}
+	}
+
+	return session, records, nil
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetExecution returns the recorded contract execution with the given id.
+func (k Keeper) GetExecution(ctx sdk.Context, executionID string) (execution types.Execution, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetExecutionKey(executionID))
+	if b == nil {
+		return types.Execution{}, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(b, &execution)
+	return execution, true
+}
+
+// SetExecution stores a recorded contract execution in the module kv store.
+func (k Keeper) SetExecution(ctx sdk.Context, execution types.Execution) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetExecutionKey(execution.ExecutionId), k.cdc.MustMarshalBinaryBare(&execution))
+}
+
+// IterateExecutions processes all recorded contract executions with the given handler.
+func (k Keeper) IterateExecutions(ctx sdk.Context, handler func(types.Execution) (stop bool)) error {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ExecutionKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var execution types.Execution
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &execution)
+		if handler(execution) {
+			break
+		}
+	}
+	return nil
+}
+
+// ValidateExecutionUnused checks that the execution id has not been recorded before.  Signatures are made over the
+// execution id so rejecting a reused id prevents the same signatures from being submitted again.
+func (k Keeper) ValidateExecutionUnused(ctx sdk.Context, executionID string) error {
+	if existing, found := k.GetExecution(ctx, executionID); found {
+		return fmt.Errorf("execution %s has already been recorded in scope %s", executionID, existing.ScopeId)
+	}
+	return nil
+}
+
+// recordExecution marks the execution id as used by an execution recorded in the given scope.
+func (k Keeper) recordExecution(ctx sdk.Context, executionID string, scopeID types.MetadataAddress) {
+	k.SetExecution(ctx, types.Execution{ExecutionId: executionID, ScopeId: scopeID, Height: ctx.BlockHeight()})
+}
@@ -41,6 +41,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			k.SetRecordSpecification(ctx, s)
 		}
 	}
+	// History goes in last so that setting the current entries above cannot add versions to it.
+	for _, v := range data.RecordHistory {
+		k.SetRecordVersion(ctx, v)
+	}
+	for _, v := range data.SessionHistory {
+		k.SetSessionVersion(ctx, v)
+	}
+	for _, e := range data.Executions {
+		k.SetExecution(ctx, e)
+	}
 }
 
 // ExportGenesis exports the current keeper state of the metadata module.ExportGenesis
@@ -52,6 +62,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 	scopeSpecs := make([]types.ScopeSpecification, 0)
 	contractSpecs := make([]types.ContractSpecification, 0)
 	recordSpecs := make([]types.RecordSpecification, 0)
+	recordHistory := make([]types.RecordVersion, 0)
+	sessionHistory := make([]types.SessionVersion, 0)
+	executions := make([]types.Execution, 0)
 
 	appendToScopes := func(scope types.Scope) bool {
 		scopes = append(scopes, scope)
@@ -83,6 +96,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	}
 
+	appendToRecordHistory := func(version types.RecordVersion) bool {
+		recordHistory = append(recordHistory, version)
+		return false
+	}
+
+	appendToSessionHistory := func(version types.SessionVersion) bool {
+		sessionHistory = append(sessionHistory, version)
+		return false
+	}
+
+	appendToExecutions := func(execution types.Execution) bool {
+		executions = append(executions, execution)
+		return false
+	}
+
 	if err := k.IterateScopes(ctx, appendToScopes); err != nil {
 		panic(err)
 	}
@@ -102,5 +130,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs)
+	if err := k.IterateRecordHistory(ctx, types.MetadataAddress{}, appendToRecordHistory); err != nil {
+		panic(err)
+	}
+	if err := k.IterateSessionHistory(ctx, types.MetadataAddress{}, appendToSessionHistory); err != nil {
+		panic(err)
+	}
+	if err := k.IterateExecutions(ctx, appendToExecutions); err != nil {
+		panic(err)
+	}
+
+	genesis := types.NewGenesisState(params, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs)
+	genesis.RecordHistory = recordHistory
+	genesis.SessionHistory = sessionHistory
+	genesis.Executions = executions
+	return genesis
 }
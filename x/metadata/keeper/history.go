@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// archiveRecord stores the record as the next prior version of the record with the given id.
+func (k Keeper) archiveRecord(ctx sdk.Context, recordID types.MetadataAddress, record types.Record) {
+	version := lastHistoryVersion(ctx.KVStore(k.storeKey), types.GetRecordHistoryIteratorPrefix(recordID)) + 1
+	k.SetRecordVersion(ctx, types.RecordVersion{Version: version, Record: record, Height: ctx.BlockHeight()})
+}
+
+// SetRecordVersion stores a prior version of a record in the module kv store.
+func (k Keeper) SetRecordVersion(ctx sdk.Context, version types.RecordVersion) {
+	recordID, err := version.Record.SessionId.AsRecordAddress(version.Record.Name)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRecordHistoryKey(recordID, version.Version), k.cdc.MustMarshalBinaryBare(&version))
+}
+
+// IterateRecordHistory processes the prior versions of the record with the given id, oldest first, with the given
+// handler.  An empty record id processes the history of all records.
+func (k Keeper) IterateRecordHistory(ctx sdk.Context, recordID types.MetadataAddress, handler func(types.RecordVersion) (stop bool)) error {
+	prefix := types.RecordHistoryKeyPrefix
+	if !recordID.Empty() {
+		if !recordID.IsRecordAddress() {
+			return fmt.Errorf("invalid address, address must be for a record")
+		}
+		prefix = types.GetRecordHistoryIteratorPrefix(recordID)
+	}
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var version types.RecordVersion
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &version)
+		if handler(version) {
+			break
+		}
+	}
+	return nil
+}
+
+// archiveSession stores the session as the next prior version of the session.
+func (k Keeper) archiveSession(ctx sdk.Context, session types.Session) {
+	version := lastHistoryVersion(ctx.KVStore(k.storeKey), types.GetSessionHistoryIteratorPrefix(session.SessionId)) + 1
+	k.SetSessionVersion(ctx, types.SessionVersion{Version: version, Session: session, Height: ctx.BlockHeight()})
+}
+
+// SetSessionVersion stores a prior version of a session in the module kv store.
+func (k Keeper) SetSessionVersion(ctx sdk.Context, version types.SessionVersion) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSessionHistoryKey(version.Session.SessionId, version.Version), k.cdc.MustMarshalBinaryBare(&version))
+}
+
+// IterateSessionHistory processes the prior versions of the session with the given id, oldest first, with the given
+// handler.  An empty session id processes the history of all sessions.
+func (k Keeper) IterateSessionHistory(ctx sdk.Context, sessionID types.MetadataAddress, handler func(types.SessionVersion) (stop bool)) error {
+	prefix := types.SessionHistoryKeyPrefix
+	if !sessionID.Empty() {
+		if !sessionID.IsSessionAddress() {
+			return fmt.Errorf("invalid address, address must be for a session")
+		}
+		prefix = types.GetSessionHistoryIteratorPrefix(sessionID)
+	}
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var version types.SessionVersion
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &version)
+		if handler(version) {
+			break
+		}
+	}
+	return nil
+}
+
+// lastHistoryVersion returns the highest version stored under the history prefix, or zero when there are none.
+func lastHistoryVersion(store sdk.KVStore, prefix []byte) uint64 {
+	it := sdk.KVStoreReversePrefixIterator(store, prefix)
+	defer it.Close()
+	if !it.Valid() {
+		return 0
+	}
+	return types.GetHistoryKeyVersion(it.Key())
+}
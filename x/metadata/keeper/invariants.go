@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// The names of the metadata invariants
+const (
+	scopeIndexInvariantName        = "scope-index"
+	scopeSpecIndexInvariantName    = "scope-specification-index"
+	contractSpecIndexInvariantName = "contract-specification-index"
+	scopeEntriesInvariantName      = "scope-entries"
+)
+
+// RegisterInvariants registers module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, scopeIndexInvariantName, ScopeIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, scopeSpecIndexInvariantName, ScopeSpecIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, contractSpecIndexInvariantName, ContractSpecIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, scopeEntriesInvariantName, ScopeEntriesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the metadata module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			ScopeIndexInvariant(k),
+			ScopeSpecIndexInvariant(k),
+			ContractSpecIndexInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return ScopeEntriesInvariant(k)(ctx)
+	}
+}
+
+// ScopeIndexInvariant checks that the address, value owner, data access, and scope specification indexes hold exactly the entries
+// for the stored scopes.
+func ScopeIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := make(map[string]bool)
+		_ = k.IterateScopes(ctx, func(scope types.Scope) bool {
+			addresses := []string{}
+			for _, p := range scope.Owners {
+				addresses = append(addresses, p.Address)
+			}
+			addresses = append(addresses, scope.DataAccess...)
+			if len(scope.ValueOwnerAddress) > 0 {
+				addresses = append(addresses, scope.ValueOwnerAddress)
+				if addr, err := sdk.AccAddressFromBech32(scope.ValueOwnerAddress); err == nil {
+					expected[string(types.GetValueOwnerScopeCacheKey(addr, scope.ScopeId))] = true
+				}
+			}
+			for _, a := range addresses {
+				if addr, err := sdk.AccAddressFromBech32(a); err == nil {
+					expected[string(types.GetAddressScopeCacheKey(addr, scope.ScopeId))] = true
+					expected[string(types.GetDataAccessScopeCacheKey(addr, scope.ScopeId))] = true
+				}
+			}
+			if len(scope.SpecificationId) > 0 {
+				expected[string(types.GetScopeSpecScopeCacheKey(scope.SpecificationId, scope.ScopeId))] = true
+			}
+			return false
+		})
+		return k.checkIndex(ctx, scopeIndexInvariantName, expected,
+			types.AddressScopeCacheKeyPrefix, types.ValueOwnerScopeCacheKeyPrefix, types.ScopeSpecScopeCacheKeyPrefix,
+			types.DataAccessScopeCacheKeyPrefix)
+	}
+}
+
+// ScopeSpecIndexInvariant checks that the owner address and contract specification indexes hold exactly the entries
+// for the stored scope specifications.
+func ScopeSpecIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := make(map[string]bool)
+		_ = k.IterateScopeSpecs(ctx, func(spec types.ScopeSpecification) bool {
+			for _, a := range spec.OwnerAddresses {
+				if addr, err := sdk.AccAddressFromBech32(a); err == nil {
+					expected[string(types.GetAddressScopeSpecCacheKey(addr, spec.SpecificationId))] = true
+				}
+			}
+			for _, contractSpecID := range spec.ContractSpecIds {
+				expected[string(types.GetContractSpecScopeSpecCacheKey(contractSpecID, spec.SpecificationId))] = true
+			}
+			return false
+		})
+		return k.checkIndex(ctx, scopeSpecIndexInvariantName, expected,
+			types.AddressScopeSpecCacheKeyPrefix, types.ContractSpecScopeSpecCacheKeyPrefix)
+	}
+}
+
+// ContractSpecIndexInvariant checks that the owner address index holds exactly the entries for the stored contract
+// specifications.
+func ContractSpecIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := make(map[string]bool)
+		_ = k.IterateContractSpecs(ctx, func(spec types.ContractSpecification) bool {
+			for _, a := range spec.OwnerAddresses {
+				if addr, err := sdk.AccAddressFromBech32(a); err == nil {
+					expected[string(types.GetAddressContractSpecCacheKey(addr, spec.SpecificationId))] = true
+				}
+			}
+			return false
+		})
+		return k.checkIndex(ctx, contractSpecIndexInvariantName, expected, types.AddressContractSpecCacheKeyPrefix)
+	}
+}
+
+// ScopeEntriesInvariant checks that every stored session and record belongs to a scope that exists.
+func ScopeEntriesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var broken []string
+		store := ctx.KVStore(k.storeKey)
+		for _, prefix := range [][]byte{types.SessionKeyPrefix, types.RecordKeyPrefix} {
+			it := sdk.KVStorePrefixIterator(store, prefix)
+			for ; it.Valid(); it.Next() {
+				id := types.MetadataAddress(it.Key())
+				scopeUUID, err := id.ScopeUUID()
+				if err != nil {
+					broken = append(broken, fmt.Sprintf("invalid key %X: %s", it.Key(), err))
+					continue
+				}
+				if !store.Has(types.ScopeMetadataAddress(scopeUUID)) {
+					broken = append(broken, fmt.Sprintf("%s has no scope %s", id, types.ScopeMetadataAddress(scopeUUID)))
+				}
+			}
+			it.Close()
+		}
+		return formatInvariant(scopeEntriesInvariantName, broken), len(broken) > 0
+	}
+}
+
+// checkIndex compares the index entries stored under the prefixes with the expected entry keys, reporting both the
+// entries that are missing and those that no longer refer to a stored object.
+func (k Keeper) checkIndex(ctx sdk.Context, name string, expected map[string]bool, prefixes ...[]byte) (string, bool) {
+	var broken []string
+	store := ctx.KVStore(k.storeKey)
+	for _, prefix := range prefixes {
+		it := sdk.KVStorePrefixIterator(store, prefix)
+		for ; it.Valid(); it.Next() {
+			if !expected[string(it.Key())] {
+				broken = append(broken, fmt.Sprintf("unexpected index entry %X", it.Key()))
+			}
+		}
+		it.Close()
+	}
+	for key := range expected {
+		if !store.Has([]byte(key)) {
+			broken = append(broken, fmt.Sprintf("missing index entry %X", []byte(key)))
+		}
+	}
+	return formatInvariant(name, broken), len(broken) > 0
+}
+
+// formatInvariant builds the invariant message with one line for each problem found.
+func formatInvariant(name string, broken []string) string {
+	sort.Strings(broken)
+	msg := fmt.Sprintf("%d problems found\n", len(broken))
+	for _, b := range broken {
+		msg += fmt.Sprintf("\t%s\n", b)
+	}
+	return sdk.FormatInvariant(types.ModuleName, name, msg)
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestMetadataInvariants(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	user := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	invariantChecks := keeper.AllInvariants(app.MetadataKeeper)
+	require.NotNil(t, invariantChecks)
+
+	// empty state passes
+	_, isBroken := invariantChecks(ctx)
+	require.False(t, isBroken)
+
+	contractSpecUUID := uuid.New()
+	contractSpecID := types.ContractSpecMetadataAddress(contractSpecUUID)
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	emptySessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	owners := []types.Party{{Address: user.String(), Role: types.PartyType_PARTY_TYPE_OWNER}}
+
+	app.MetadataKeeper.SetContractSpecification(ctx, *types.NewContractSpecification(contractSpecID, nil,
+		[]string{user.String()}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		types.NewContractSpecificationSourceHash("hash"), "class"))
+	app.MetadataKeeper.SetScopeSpecification(ctx, *types.NewScopeSpecification(scopeSpecID, nil,
+		[]string{user.String()}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{contractSpecID}))
+	app.MetadataKeeper.SetScope(ctx, *types.NewScope(scopeID, scopeSpecID, owners, []string{other.String()}, user.String()))
+	app.MetadataKeeper.SetSession(ctx, *types.NewSession("class", sessionID, contractSpecID, owners, nil))
+	app.MetadataKeeper.SetSession(ctx, *types.NewSession("class", emptySessionID, contractSpecID, owners, nil))
+	app.MetadataKeeper.SetRecord(ctx, *types.NewRecord("record", sessionID,
+		*types.NewProcess("process", &types.Process_Hash{Hash: "hash"}, "method"), nil, nil))
+
+	// fully indexed state passes
+	msg, isBroken := invariantChecks(ctx)
+	require.False(t, isBroken, msg)
+
+	// updating the scope moves its index entries
+	app.MetadataKeeper.SetScope(ctx, *types.NewScope(scopeID, scopeSpecID, owners, nil, other.String()))
+	msg, isBroken = invariantChecks(ctx)
+	require.False(t, isBroken, msg)
+
+	tests := []struct {
+		name      string
+		invariant sdk.Invariant
+		key       []byte
+		remove    bool
+	}{
+		{"missing address scope entry", keeper.ScopeIndexInvariant(app.MetadataKeeper),
+			types.GetAddressScopeCacheKey(user, scopeID), true},
+		{"orphaned value owner scope entry", keeper.ScopeIndexInvariant(app.MetadataKeeper),
+			types.GetValueOwnerScopeCacheKey(user, scopeID), false},
+		{"missing value owner data access scope entry", keeper.ScopeIndexInvariant(app.MetadataKeeper),
+			types.GetDataAccessScopeCacheKey(other, scopeID), true},
+		{"orphaned data access scope entry", keeper.ScopeIndexInvariant(app.MetadataKeeper),
+			types.GetDataAccessScopeCacheKey(sdk.AccAddress("reader______________"), scopeID), false},
+		{"missing scope spec scope entry", keeper.ScopeIndexInvariant(app.MetadataKeeper),
+			types.GetScopeSpecScopeCacheKey(scopeSpecID, scopeID), true},
+		{"orphaned address scope spec entry", keeper.ScopeSpecIndexInvariant(app.MetadataKeeper),
+			types.GetAddressScopeSpecCacheKey(other, scopeSpecID), false},
+		{"missing contract spec scope spec entry", keeper.ScopeSpecIndexInvariant(app.MetadataKeeper),
+			types.GetContractSpecScopeSpecCacheKey(contractSpecID, scopeSpecID), true},
+		{"missing address contract spec entry", keeper.ContractSpecIndexInvariant(app.MetadataKeeper),
+			types.GetAddressContractSpecCacheKey(user, contractSpecID), true},
+		{"orphaned session", keeper.ScopeEntriesInvariant(app.MetadataKeeper),
+			types.SessionMetadataAddress(uuid.New(), uuid.New()), false},
+		{"orphaned record", keeper.ScopeEntriesInvariant(app.MetadataKeeper),
+			types.RecordMetadataAddress(uuid.New(), "record"), false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			cacheStore := cacheCtx.KVStore(app.GetKey(types.StoreKey))
+			if tc.remove {
+				require.True(t, cacheStore.Has(tc.key), "key must exist before removal")
+				cacheStore.Delete(tc.key)
+			} else {
+				cacheStore.Set(tc.key, []byte{0x01})
+			}
+			msg, isBroken := tc.invariant(cacheCtx)
+			require.True(t, isBroken, msg)
+			_, isBroken = invariantChecks(cacheCtx)
+			require.True(t, isBroken)
+		})
+	}
+
+	// removing the scope takes its sessions, with or without records, and records with it
+	app.MetadataKeeper.RemoveScope(ctx, scopeID)
+	require.False(t, store.Has(sessionID))
+	require.False(t, store.Has(emptySessionID))
+	msg, isBroken = invariantChecks(ctx)
+	require.False(t, isBroken, msg)
+}
+
+func TestBuildDataAccessScopeIndex(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	user := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	owners := []types.Party{{Address: user.String(), Role: types.PartyType_PARTY_TYPE_OWNER}}
+	app.MetadataKeeper.SetScope(ctx, *types.NewScope(scopeID, nil, owners, []string{other.String()}, ""))
+
+	// scopes stored before the data access index was added have no entries in it.
+	store.Delete(types.GetDataAccessScopeCacheKey(user, scopeID))
+	store.Delete(types.GetDataAccessScopeCacheKey(other, scopeID))
+	_, isBroken := keeper.ScopeIndexInvariant(app.MetadataKeeper)(ctx)
+	require.True(t, isBroken)
+
+	require.NoError(t, app.MetadataKeeper.BuildDataAccessScopeIndex(ctx))
+	require.True(t, store.Has(types.GetDataAccessScopeCacheKey(user, scopeID)))
+	require.True(t, store.Has(types.GetDataAccessScopeCacheKey(other, scopeID)))
+	msg, isBroken := keeper.ScopeIndexInvariant(app.MetadataKeeper)(ctx)
+	require.False(t, isBroken, msg)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -177,7 +178,7 @@ func (k Keeper) ValidatePartiesInvolved(parties []types.Party, requiredParties [
 			}
 		}
 		if !found {
-			return fmt.Errorf("missing party type from required parties %s", pi.String())
+			return sdkerrors.Wrapf(types.ErrMissingRequiredParty, "missing party type from required parties %s", pi.String())
 		}
 	}
 	return nil
@@ -188,6 +188,15 @@ func (s *KeeperTestSuite) TestValidateScopeUpdate() {
 	s.NoError(err)
 	changedID := types.ScopeMetadataAddress(uuid.New())
 
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *types.NewScopeSpecification(
+		types.ScopeSpecMetadataAddress(s.scopeUUID), nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{}))
+	custodianSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *types.NewScopeSpecification(
+		custodianSpecID, nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER, types.PartyType_PARTY_TYPE_CUSTODIAN}, []types.MetadataAddress{}))
+	unknownSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+
 	cases := map[string]struct {
 		existing types.Scope
 		proposed types.Scope
@@ -286,6 +295,29 @@ func (s *KeeperTestSuite) TestValidateScopeUpdate() {
 			wantErr:  true,
 			errorMsg: fmt.Sprintf("missing signature from existing owner %s; required for update", s.user2),
 		},
+		"scope specification must exist": {
+			existing: types.Scope{},
+			proposed: *types.NewScope(s.scopeID, unknownSpecID, ownerPartyList(s.user1), []string{}, ""),
+			signers:  []string{s.user1},
+			wantErr:  true,
+			errorMsg: fmt.Sprintf("cannot find scope specification %s: scope specification not found", unknownSpecID),
+		},
+		"scope owners must include the parties involved in the scope specification": {
+			existing: types.Scope{},
+			proposed: *types.NewScope(s.scopeID, custodianSpecID, ownerPartyList(s.user1), []string{}, ""),
+			signers:  []string{s.user1},
+			wantErr:  true,
+			errorMsg: "missing party type from required parties PARTY_TYPE_CUSTODIAN: missing required party type",
+		},
+		"scope owners with all parties involved in the scope specification": {
+			existing: types.Scope{},
+			proposed: *types.NewScope(s.scopeID, custodianSpecID, []types.Party{
+				{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER},
+				{Address: s.user2, Role: types.PartyType_PARTY_TYPE_CUSTODIAN}}, []string{}, ""),
+			signers:  []string{s.user1},
+			wantErr:  false,
+			errorMsg: "",
+		},
 		"unsetting all fields on a scope should be successful": {
 			existing: *types.NewScope(s.scopeID, types.ScopeSpecMetadataAddress(s.scopeUUID), ownerPartyList(s.user1), []string{}, s.user1),
 			proposed: types.Scope{ScopeId: s.scopeID, Owners: ownerPartyList(s.user1)},
@@ -428,6 +460,56 @@ func (s *KeeperTestSuite) TestValidateRecordUpdate() {
 	randomScopeUUID := uuid.New()
 	randomSessionId := types.SessionMetadataAddress(randomScopeUUID, uuid.New())
 
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *types.NewContractSpecification(
+		s.contractSpecId,
+		types.NewDescription("TestContract", "description", "", ""),
+		[]string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		types.NewContractSpecificationSourceHash("HASH"),
+		"io.provenance.TestContract",
+	))
+	recordSpecID := types.RecordSpecMetadataAddress(s.contractSpecUUID, s.recordName)
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *types.NewRecordSpecification(
+		recordSpecID,
+		s.recordName,
+		[]*types.InputSpecification{
+			types.NewInputSpecification("proposed", "io.provenance.Proposed", types.NewInputSpecificationSourceHash("HASH")),
+			types.NewInputSpecification("existing", "io.provenance.Existing", types.NewInputSpecificationSourceRecordID(
+				types.RecordMetadataAddress(s.scopeUUID, "existing"))),
+		},
+		"io.provenance.TestRecord",
+		types.DefinitionType_DEFINITION_TYPE_RECORD,
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER, types.PartyType_PARTY_TYPE_SERVICER},
+	))
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession("session", s.sessionId, s.contractSpecId,
+		[]types.Party{{Address: s.user2, Role: types.PartyType_PARTY_TYPE_SERVICER}}, nil))
+
+	unknownSessionId := types.SessionMetadataAddress(s.scopeUUID, uuid.New())
+	noSpecSessionId := types.SessionMetadataAddress(s.scopeUUID, uuid.New())
+	unknownContractSpecId := types.ContractSpecMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession("session", noSpecSessionId,
+		unknownContractSpecId, []types.Party{}, nil))
+
+	proposedInput := *types.NewRecordInput("proposed", &types.RecordInput_Hash{Hash: "HASH"}, "io.provenance.Proposed",
+		types.RecordInputStatus_Proposed)
+	wrongHashInput := *types.NewRecordInput("proposed", &types.RecordInput_Hash{Hash: "INPUT"}, "io.provenance.Proposed",
+		types.RecordInputStatus_Proposed)
+	wrongRecordInput := *types.NewRecordInput("existing", &types.RecordInput_RecordId{
+		RecordId: types.RecordMetadataAddress(s.scopeUUID, "other")}, "io.provenance.Existing", types.RecordInputStatus_Record)
+	existingInput := *types.NewRecordInput("existing", &types.RecordInput_RecordId{
+		RecordId: types.RecordMetadataAddress(s.scopeUUID, "existing")}, "io.provenance.Existing", types.RecordInputStatus_Record)
+	wrongTypeInput := *types.NewRecordInput("existing", &types.RecordInput_RecordId{
+		RecordId: types.RecordMetadataAddress(s.scopeUUID, "existing")}, "io.provenance.Other", types.RecordInputStatus_Record)
+	wrongSourceInput := *types.NewRecordInput("existing", &types.RecordInput_Hash{Hash: "INPUT"}, "io.provenance.Existing",
+		types.RecordInputStatus_Proposed)
+	unknownInput := *types.NewRecordInput("unknown", &types.RecordInput_Hash{Hash: "INPUT"}, "io.provenance.Proposed",
+		types.RecordInputStatus_Proposed)
+	output := types.RecordOutput{Hash: "OUTPUT", Status: types.ResultStatus_RESULT_STATUS_PASS}
+
+	newRecord := func(sessionID types.MetadataAddress, inputs []types.RecordInput, outputs []types.RecordOutput) types.Record {
+		return *types.NewRecord(s.recordName, sessionID, *process, inputs, outputs)
+	}
+
 	cases := map[string]struct {
 		existing types.Record
 		proposed types.Record
@@ -470,6 +552,92 @@ func (s *KeeperTestSuite) TestValidateRecordUpdate() {
 			wantErr:  true,
 			errorMsg: fmt.Sprintf("missing signature from existing owner %s; required for update", s.user1),
 		},
+		"session not found": {
+			existing: types.Record{},
+			proposed: newRecord(unknownSessionId, nil, nil),
+			signers:  []string{s.user1},
+			wantErr:  true,
+			errorMsg: fmt.Sprintf("session not found for session id %s", unknownSessionId),
+		},
+		"contract specification not found": {
+			existing: types.Record{},
+			proposed: newRecord(noSpecSessionId, nil, nil),
+			signers:  []string{s.user1},
+			wantErr:  true,
+			errorMsg: fmt.Sprintf("contract specification not found for id %s", unknownContractSpecId),
+		},
+		"record specification not found": {
+			existing: types.Record{},
+			proposed: *types.NewRecord("unknown", s.sessionId, *process, nil, nil),
+			signers:  []string{s.user1},
+			wantErr:  true,
+			errorMsg: fmt.Sprintf("record specification not found for record name %q in contract specification %s",
+				"unknown", s.contractSpecId),
+		},
+		"missing responsible party signature": {
+			existing: types.Record{},
+			proposed: newRecord(s.sessionId, []types.RecordInput{proposedInput, existingInput}, []types.RecordOutput{output}),
+			signers:  []string{s.user1},
+			wantErr:  true,
+			errorMsg: fmt.Sprintf("missing signature from a PARTY_TYPE_SERVICER party; required by record specification %s",
+				recordSpecID),
+		},
+		"input not defined in specification": {
+			existing: types.Record{},
+			proposed: newRecord(s.sessionId, []types.RecordInput{proposedInput, unknownInput}, []types.RecordOutput{output}),
+			signers:  []string{s.user1, s.user2},
+			wantErr:  true,
+			errorMsg: fmt.Sprintf("input \"unknown\" is not defined in record specification %s", recordSpecID),
+		},
+		"input type name does not match": {
+			existing: types.Record{},
+			proposed: newRecord(s.sessionId, []types.RecordInput{proposedInput, wrongTypeInput}, []types.RecordOutput{output}),
+			signers:  []string{s.user1, s.user2},
+			wantErr:  true,
+			errorMsg: "input \"existing\" has type \"io.provenance.Other\", record specification requires \"io.provenance.Existing\"",
+		},
+		"input source does not match": {
+			existing: types.Record{},
+			proposed: newRecord(s.sessionId, []types.RecordInput{proposedInput, wrongSourceInput}, []types.RecordOutput{output}),
+			signers:  []string{s.user1, s.user2},
+			wantErr:  true,
+			errorMsg: "input \"existing\" must be sourced from a record, as required by record specification",
+		},
+		"input record id does not match": {
+			existing: types.Record{},
+			proposed: newRecord(s.sessionId, []types.RecordInput{proposedInput, wrongRecordInput}, []types.RecordOutput{output}),
+			signers:  []string{s.user1, s.user2},
+			wantErr:  true,
+			errorMsg: fmt.Sprintf("input \"existing\" is sourced from record %s, record specification requires %s",
+				types.RecordMetadataAddress(s.scopeUUID, "other"), types.RecordMetadataAddress(s.scopeUUID, "existing")),
+		},
+		"input hash does not match": {
+			existing: types.Record{},
+			proposed: newRecord(s.sessionId, []types.RecordInput{wrongHashInput, existingInput}, []types.RecordOutput{output}),
+			signers:  []string{s.user1, s.user2},
+			wantErr:  true,
+			errorMsg: "input \"proposed\" has hash \"INPUT\", record specification requires \"HASH\"",
+		},
+		"missing required input": {
+			existing: types.Record{},
+			proposed: newRecord(s.sessionId, []types.RecordInput{proposedInput}, []types.RecordOutput{output}),
+			signers:  []string{s.user1, s.user2},
+			wantErr:  true,
+			errorMsg: fmt.Sprintf("missing input \"existing\" required by record specification %s", recordSpecID),
+		},
+		"output count does not match result type": {
+			existing: types.Record{},
+			proposed: newRecord(s.sessionId, []types.RecordInput{proposedInput, existingInput}, []types.RecordOutput{output, output}),
+			signers:  []string{s.user1, s.user2},
+			wantErr:  true,
+			errorMsg: "record specification result type DEFINITION_TYPE_RECORD requires exactly one output, found 2",
+		},
+		"valid record": {
+			existing: types.Record{},
+			proposed: newRecord(s.sessionId, []types.RecordInput{proposedInput, existingInput}, []types.RecordOutput{output}),
+			signers:  []string{s.user1, s.user2},
+			wantErr:  false,
+		},
 	}
 
 	for n, tc := range cases {
@@ -565,8 +733,23 @@ func (s *KeeperTestSuite) TestMetadataValidateSessionUpdate() {
 	invalidNameSession := types.NewSession("invalid", s.sessionId, s.contractSpecId, parties, nil)
 
 	partiesInvolved := []types.PartyType{types.PartyType_PARTY_TYPE_AFFILIATE}
-	contractSpec := types.NewContractSpecification(s.contractSpecId, types.NewDescription("name", "desc", "url", "icon"), []string{s.user1}, partiesInvolved, &types.ContractSpecification_Hash{"hash"}, "processname")
+	contractSpec := types.NewContractSpecification(s.contractSpecId, types.NewDescription("name", "desc", "url", "icon"), []string{s.user1}, partiesInvolved, &types.ContractSpecification_Hash{Hash: "hash"}, "processname")
 	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *contractSpec)
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *types.NewScopeSpecification(s.specID, nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{s.contractSpecId}))
+
+	otherContractSpecId := types.ContractSpecMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *types.NewContractSpecification(otherContractSpecId,
+		types.NewDescription("name", "desc", "url", "icon"), []string{s.user1}, partiesInvolved,
+		&types.ContractSpecification_Hash{Hash: "hash"}, "processname"))
+	notAllowedSession := types.NewSession("processname", s.sessionId, otherContractSpecId, parties, nil)
+
+	noSpecScopeUUID := uuid.New()
+	noSpecScopeID := types.ScopeMetadataAddress(noSpecScopeUUID)
+	missingSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(noSpecScopeID, missingSpecID, ownerPartyList(s.user1), []string{}, ""))
+	missingSpecSession := types.NewSession("processname", types.SessionMetadataAddress(noSpecScopeUUID, uuid.New()),
+		s.contractSpecId, parties, nil)
 
 	cases := map[string]struct {
 		existing types.Session
@@ -629,14 +812,29 @@ func (s *KeeperTestSuite) TestMetadataValidateSessionUpdate() {
 			proposed: *invalidContractId,
 			signers:  []string{s.user1},
 			wantErr:  true,
-			errorMsg: fmt.Sprintf("cannot find contract specification %s", invalidContractId.SpecificationId),
+			errorMsg: fmt.Sprintf("cannot find contract specification %s: contract specification not found", invalidContractId.SpecificationId),
+		},
+		"invalid session update, contract spec not allowed by scope spec": {
+			existing: *validSession,
+			proposed: *notAllowedSession,
+			signers:  []string{s.user1},
+			wantErr:  true,
+			errorMsg: fmt.Sprintf("contract specification %s is not allowed by scope specification %s: "+
+				"contract specification not allowed by scope specification", otherContractSpecId, s.specID),
+		},
+		"invalid session update, scope spec does not exist": {
+			existing: types.Session{},
+			proposed: *missingSpecSession,
+			signers:  []string{s.user1},
+			wantErr:  true,
+			errorMsg: fmt.Sprintf("cannot find scope specification %s: scope specification not found", missingSpecID),
 		},
 		"invalid session update, involved parties do not match": {
 			existing: *validSession,
 			proposed: *invalidPartiesSession,
 			signers:  []string{s.user1},
 			wantErr:  true,
-			errorMsg: "missing party type from required parties PARTY_TYPE_AFFILIATE",
+			errorMsg: "missing party type from required parties PARTY_TYPE_AFFILIATE: missing required party type",
 		},
 		"invalid session update, missing required signers": {
 			existing: *validSession,
@@ -729,13 +927,13 @@ func (s *KeeperTestSuite) TestValidatePartiesInvolved() {
 			parties:         []types.Party{},
 			requiredParties: []types.PartyType{types.PartyType_PARTY_TYPE_AFFILIATE},
 			wantErr:         true,
-			errorMsg:        "missing party type from required parties PARTY_TYPE_AFFILIATE",
+			errorMsg:        "missing party type from required parties PARTY_TYPE_AFFILIATE: missing required party type",
 		},
 		"invalid, missing required parties": {
 			parties:         []types.Party{{Address: "address", Role: types.PartyType_PARTY_TYPE_CUSTODIAN}},
 			requiredParties: []types.PartyType{types.PartyType_PARTY_TYPE_AFFILIATE},
 			wantErr:         true,
-			errorMsg:        "missing party type from required parties PARTY_TYPE_AFFILIATE",
+			errorMsg:        "missing party type from required parties PARTY_TYPE_AFFILIATE: missing required party type",
 		},
 		"valid, required parties fulfilled": {
 			parties:         []types.Party{{Address: "address", Role: types.PartyType_PARTY_TYPE_CUSTODIAN}},
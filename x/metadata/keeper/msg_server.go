@@ -28,17 +28,44 @@ func (k msgServer) MemorializeContract(
 ) (*types.MsgMemorializeContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// TODO (contract keeper class  methods to process contract execution, scope keeper methods to record it)
+	scopeID, err := msg.ScopeMetadataAddress()
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := msg.SessionMetadataAddress()
+	if err != nil {
+		return nil, err
+	}
+	if err = k.ValidateExecutionUnused(ctx, msg.ExecutionId); err != nil {
+		return nil, err
+	}
+	signers, err := k.ValidateContractSignatures(scopeID, sessionID, msg.ExecutionId, msg.Contract, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+	// All checks are made before anything is written so the session and records are recorded together or not at all.
+	session, records, err := k.ValidateContractExecution(ctx, sessionID, msg.ExecutionId, msg.Contract, signers)
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetSession(ctx, *session)
+	for _, record := range records {
+		k.SetRecord(ctx, record)
+	}
+	k.recordExecution(ctx, msg.ExecutionId, scopeID)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Notary),
+			sdk.NewAttribute(types.AttributeKeySessionID, sessionID.String()),
+			sdk.NewAttribute(types.AttributeKeyExecutionID, msg.ExecutionId),
 		),
 	)
 
-	return nil, fmt.Errorf("not implemented")
+	return &types.MsgMemorializeContractResponse{}, nil
 }
 
 func (k msgServer) ChangeOwnership(
@@ -47,17 +74,87 @@ func (k msgServer) ChangeOwnership(
 ) (*types.MsgChangeOwnershipResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// TODO (contract keeper class  methods to process contract execution, scope keeper methods to record it)
+	scopeID, err := msg.ScopeMetadataAddress()
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := msg.SessionMetadataAddress()
+	if err != nil {
+		return nil, err
+	}
+	existing, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", scopeID)
+	}
+	if err = k.ValidateExecutionUnused(ctx, msg.ExecutionId); err != nil {
+		return nil, err
+	}
+
+	var signers []string
+	if msg.Contract != nil {
+		signers, err = k.ValidateContractSignatures(scopeID, sessionID, msg.ExecutionId, *msg.Contract, msg.Signatures)
+	} else {
+		signers, err = k.ValidateRecitalSignatures(scopeID, msg.ExecutionId, msg.GetRecitals(), msg.Signatures)
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	ctx.EventManager().EmitEvent(
+	proposed := existing.ChangeOwnership(msg.GetRecitals().AsParties())
+	if err = k.ValidateScopeUpdate(ctx, existing, proposed, signers); err != nil {
+		return nil, err
+	}
+
+	// A contract based change is memorialized as a session in the scope in the same way as MemorializeContract.
+	var session *types.Session
+	var records []types.Record
+	if msg.Contract != nil {
+		session, records, err = k.ValidateContractExecution(ctx, sessionID, msg.ExecutionId, *msg.Contract, signers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetScope(ctx, proposed)
+	if session != nil {
+		k.SetSession(ctx, *session)
+		for _, record := range records {
+			k.SetRecord(ctx, record)
+		}
+	}
+	k.recordExecution(ctx, msg.ExecutionId, scopeID)
+
+	// The event is the audit trail of a recitals only change which does not create a session.
+	ownership := sdk.NewEvent(
+		types.EventTypeScopeOwnership,
+		sdk.NewAttribute(types.AttributeKeyScopeID, scopeID.String()),
+		sdk.NewAttribute(types.AttributeKeyExecutionID, msg.ExecutionId),
+		sdk.NewAttribute(types.AttributeKeyPreviousOwners, partyAddresses(existing.Owners)),
+		sdk.NewAttribute(types.AttributeKeyOwners, partyAddresses(proposed.Owners)),
+		sdk.NewAttribute(types.AttributeKeySigners, strings.Join(signers, ",")),
+	)
+	if session != nil {
+		ownership = ownership.AppendAttributes(sdk.NewAttribute(types.AttributeKeySessionID, sessionID.String()))
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		ownership,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Notary),
 		),
-	)
+	})
+
+	return &types.MsgChangeOwnershipResponse{}, nil
+}
 
-	return nil, fmt.Errorf("not implemented")
+// partyAddresses returns the addresses of the parties as a comma separated list
+func partyAddresses(parties []types.Party) string {
+	addresses := make([]string, len(parties))
+	for i, p := range parties {
+		addresses[i] = p.Address
+	}
+	return strings.Join(addresses, ",")
 }
 
 func (k msgServer) AddScope(
@@ -119,8 +216,7 @@ func (k msgServer) AddSession(
 		return nil, err
 	}
 
-	audit := existing.Audit.UpdateAudit(ctx.BlockTime(), strings.Join(msg.Signers, ", "), "")
-	*msg.Session.Audit = *audit
+	msg.Session.Audit = existing.Audit.UpdateAudit(ctx.BlockTime(), strings.Join(msg.Signers, ", "), "")
 
 	k.SetSession(ctx, *msg.Session)
 
@@ -459,3 +555,74 @@ func (k msgServer) AddP8EContractSpec(
 
 	return &types.MsgAddP8EContractSpecResponse{}, nil
 }
+
+func (k msgServer) P8EMemorializeContract(
+	goCtx context.Context,
+	msg *types.MsgP8EMemorializeContractRequest,
+) (*types.MsgP8EMemorializeContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	scopeID, err := msg.ScopeMetadataAddress()
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := msg.SessionMetadataAddress()
+	if err != nil {
+		return nil, err
+	}
+	contract, err := types.ConvertP8eContract(&msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if err = k.ValidateExecutionUnused(ctx, msg.ExecutionId); err != nil {
+		return nil, err
+	}
+	signers, err := k.ValidateP8eContractSignatures(msg.Contract, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// The recitals are not covered by the signatures, ownership of an existing scope is changed with ChangeOwnership.
+	if msg.Recitals != nil {
+		return nil, fmt.Errorf("only one of contract or recitals is allowed")
+	}
+	// New scopes are owned by the signed contract parties, existing scopes keep their owners.
+	existing, found := k.GetScope(ctx, scopeID)
+	proposed := existing
+	if !found {
+		proposed = existing.ChangeOwnership(contract.Recitals.AsParties())
+		proposed.ScopeId = scopeID
+		if proposed.SpecificationId, err = msg.ScopeSpecMetadataAddress(); err != nil {
+			return nil, err
+		}
+	}
+	if err = k.ValidateScopeUpdate(ctx, existing, proposed, signers); err != nil {
+		return nil, err
+	}
+	// The session is validated against the scope in state so the scope is written first, a failed validation below
+	// rolls back the write with the rest of the transaction.
+	k.SetScope(ctx, proposed)
+
+	session, records, err := k.ValidateContractExecution(ctx, sessionID, msg.ExecutionId, contract, signers)
+	if err != nil {
+		return nil, err
+	}
+	k.SetSession(ctx, *session)
+	for _, record := range records {
+		k.SetRecord(ctx, record)
+	}
+	k.recordExecution(ctx, msg.ExecutionId, scopeID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Invoker),
+			sdk.NewAttribute(types.AttributeKeyScopeID, scopeID.String()),
+			sdk.NewAttribute(types.AttributeKeySessionID, sessionID.String()),
+			sdk.NewAttribute(types.AttributeKeyExecutionID, msg.ExecutionId),
+		),
+	)
+
+	return &types.MsgP8EMemorializeContractResponse{}, nil
+}
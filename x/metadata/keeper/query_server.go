@@ -478,3 +478,161 @@ func (k Keeper) RecordSpecificationByID(c context.Context, req *types.RecordSpec
 
 	return &retval, nil
 }
+
+// RecordHistory returns the prior versions of a record by scope id and record name
+func (k Keeper) RecordHistory(c context.Context, req *types.RecordHistoryRequest) (*types.RecordHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.GetScopeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "scope id cannot be empty")
+	}
+
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "record name cannot be empty")
+	}
+
+	scopeAddr, err := types.MetadataAddressFromBech32(req.GetScopeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid scope id %s : %s", req.GetScopeId(), err)
+	}
+
+	recordID, err := scopeAddr.AsRecordAddress(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid scope id %s : %s", req.GetScopeId(), err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRecordHistoryIteratorPrefix(recordID))
+
+	versions := []types.RecordVersion{}
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(_, value []byte) error {
+		var version types.RecordVersion
+		if vErr := k.cdc.UnmarshalBinaryBare(value, &version); vErr != nil {
+			return vErr
+		}
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.RecordHistoryResponse{ScopeId: scopeAddr.String(), Name: name, Versions: versions, Pagination: pageRes}, nil
+}
+
+// SessionHistory returns the prior versions of a session by session id
+func (k Keeper) SessionHistory(c context.Context, req *types.SessionHistoryRequest) (*types.SessionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.GetSessionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id cannot be empty")
+	}
+
+	sessionID, err := types.MetadataAddressFromBech32(req.GetSessionId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session id %s : %s", req.GetSessionId(), err)
+	}
+	if !sessionID.IsSessionAddress() {
+		return nil, status.Errorf(codes.InvalidArgument, "id %s is not a session metadata address", req.GetSessionId())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSessionHistoryIteratorPrefix(sessionID))
+
+	versions := []types.SessionVersion{}
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(_, value []byte) error {
+		var version types.SessionVersion
+		if vErr := k.cdc.UnmarshalBinaryBare(value, &version); vErr != nil {
+			return vErr
+		}
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.SessionHistoryResponse{SessionId: sessionID.String(), Versions: versions, Pagination: pageRes}, nil
+}
+
+// DataAccess returns a list of scope identifiers the given address may read the data of as an owner, data access party,
+// or value owner
+func (k Keeper) DataAccess(c context.Context, req *types.DataAccessRequest) (*types.DataAccessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	scopeStore := prefix.NewStore(store, types.GetDataAccessScopeCacheIteratorPrefix(addr))
+
+	scopeUUIDs := []string{}
+	pageRes, err := query.Paginate(scopeStore, req.Pagination, func(key, _ []byte) error {
+		var ma types.MetadataAddress
+		if mErr := ma.Unmarshal(key); mErr != nil {
+			return mErr
+		}
+		scopeUUID, sErr := ma.ScopeUUID()
+		if sErr != nil {
+			return sErr
+		}
+		scopeUUIDs = append(scopeUUIDs, scopeUUID.String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+	return &types.DataAccessResponse{ScopeUuids: scopeUUIDs, Pagination: pageRes}, nil
+}
+
+// CanAccess returns whether an address may receive the off-chain data of a scope, by scope, session, or record id
+func (k Keeper) CanAccess(c context.Context, req *types.CanAccessRequest) (*types.CanAccessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id cannot be empty")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	id, err := types.MetadataAddressFromBech32(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id %s : %s", req.Id, err)
+	}
+	scopeID, err := id.AsScopeAddress()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "id %s is not a scope, session, or record metadata address", req.Id)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "scope %s not found", scopeID)
+	}
+
+	access := k.GetScopeAccess(ctx, scope, addr.String())
+	return &access, nil
+}
@@ -16,8 +16,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/suite"
 
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -273,6 +276,200 @@ func (s *QueryServerTestSuite) TestSessionQuery() {
 	s.Equal(sessionID.String(), scrs.SessionId)
 }
 
+func (s *QueryServerTestSuite) TestRecordHistoryQuery() {
+	app, ctx, queryClient, scopeUUID, scopeID, sessionID, recordName := s.app, s.ctx, s.queryClient, s.scopeUUID, s.scopeID, s.sessionID, s.recordName
+
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	record := types.NewRecord(recordName, sessionID, *process, []types.RecordInput{}, []types.RecordOutput{})
+	app.MetadataKeeper.SetRecord(ctx, *record)
+
+	rh, err := queryClient.RecordHistory(gocontext.Background(), &types.RecordHistoryRequest{ScopeId: scopeID.String(), Name: recordName})
+	s.NoError(err)
+	s.Equal(0, len(rh.Versions), "a new record should have no prior versions")
+
+	// setting an unchanged record does not add a version
+	app.MetadataKeeper.SetRecord(ctx, *record)
+	for i := 0; i < 4; i++ {
+		record.Process.Method = fmt.Sprintf("method%d", i)
+		app.MetadataKeeper.SetRecord(ctx.WithBlockHeight(int64(i+1)), *record)
+	}
+	app.MetadataKeeper.RemoveRecord(ctx.WithBlockHeight(5), s.recordID)
+
+	_, err = queryClient.RecordHistory(gocontext.Background(), &types.RecordHistoryRequest{})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = scope id cannot be empty")
+
+	_, err = queryClient.RecordHistory(gocontext.Background(), &types.RecordHistoryRequest{ScopeId: scopeID.String()})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = record name cannot be empty")
+
+	_, err = queryClient.RecordHistory(gocontext.Background(), &types.RecordHistoryRequest{ScopeId: "foo", Name: recordName})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = invalid scope id foo : decoding bech32 failed: invalid bech32 string length 3")
+
+	rh, err = queryClient.RecordHistory(gocontext.Background(), &types.RecordHistoryRequest{ScopeId: scopeID.String(), Name: recordName})
+	s.NoError(err)
+	s.Equal(5, len(rh.Versions), "should be a version for each update and the removal")
+	s.Equal(scopeID.String(), rh.ScopeId)
+	for i, v := range rh.Versions {
+		s.Equal(uint64(i+1), v.Version)
+		s.Equal(int64(i+1), v.Height)
+	}
+	s.Equal("process_method", rh.Versions[0].Record.Process.Method)
+	s.Equal("method3", rh.Versions[4].Record.Process.Method)
+
+	rh, err = queryClient.RecordHistory(gocontext.Background(), &types.RecordHistoryRequest{
+		ScopeId: scopeID.String(), Name: recordName, Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	s.NoError(err)
+	s.Equal(2, len(rh.Versions), "should be limited to 2 versions")
+	s.Equal(uint64(5), rh.Pagination.Total)
+
+	rh, err = queryClient.RecordHistory(gocontext.Background(), &types.RecordHistoryRequest{
+		ScopeId: scopeID.String(), Name: recordName, Pagination: &query.PageRequest{Key: rh.Pagination.NextKey}})
+	s.NoError(err)
+	s.Equal(3, len(rh.Versions), "should be the remaining 3 versions")
+	s.Equal(uint64(3), rh.Versions[0].Version)
+
+	rh, err = queryClient.RecordHistory(gocontext.Background(), &types.RecordHistoryRequest{
+		ScopeId: types.ScopeMetadataAddress(scopeUUID).String(), Name: recordName + "other"})
+	s.NoError(err)
+	s.Equal(0, len(rh.Versions), "other records should have no versions")
+}
+
+func (s *QueryServerTestSuite) TestSessionHistoryQuery() {
+	app, ctx, queryClient, scopeID, sessionID, cSpecID := s.app, s.ctx, s.queryClient, s.scopeID, s.sessionID, s.cSpecID
+
+	session := types.NewSession("name", sessionID, cSpecID, []types.Party{
+		{Address: s.user1, Role: types.PartyType_PARTY_TYPE_AFFILIATE}}, nil)
+	app.MetadataKeeper.SetSession(ctx, *session)
+	session.Name = "updated"
+	app.MetadataKeeper.SetSession(ctx, *session)
+	app.MetadataKeeper.RemoveSession(ctx, sessionID)
+
+	_, err := queryClient.SessionHistory(gocontext.Background(), &types.SessionHistoryRequest{})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = session id cannot be empty")
+
+	_, err = queryClient.SessionHistory(gocontext.Background(), &types.SessionHistoryRequest{SessionId: scopeID.String()})
+	s.EqualError(err, fmt.Sprintf("rpc error: code = InvalidArgument desc = id %s is not a session metadata address", scopeID))
+
+	sh, err := queryClient.SessionHistory(gocontext.Background(), &types.SessionHistoryRequest{SessionId: sessionID.String()})
+	s.NoError(err)
+	s.Equal(sessionID.String(), sh.SessionId)
+	s.Equal(2, len(sh.Versions), "should be a version for the update and the removal")
+	s.Equal("name", sh.Versions[0].Session.Name)
+	s.Equal("updated", sh.Versions[1].Session.Name)
+	s.Equal(uint64(2), sh.Versions[1].Version)
+
+	// history survives a genesis export and import
+	genesis := app.MetadataKeeper.ExportGenesis(ctx)
+	s.Equal(sh.Versions, genesis.SessionHistory)
+	imported := simapp.Setup(false)
+	importedCtx := imported.BaseApp.NewContext(false, tmproto.Header{})
+	imported.MetadataKeeper.InitGenesis(importedCtx, genesis)
+	s.Equal(genesis, imported.MetadataKeeper.ExportGenesis(importedCtx))
+}
+
+func (s *QueryServerTestSuite) TestDataAccessQuery() {
+	app, ctx, queryClient, user1, user2 := s.app, s.ctx, s.queryClient, s.user1, s.user2
+
+	owners := []types.Party{{Address: user1, Role: types.PartyType_PARTY_TYPE_OWNER}}
+	for i := 0; i < 5; i++ {
+		dataAccess := []string{user2}
+		if i == 4 {
+			dataAccess = nil
+		}
+		scope := types.NewScope(types.ScopeMetadataAddress(uuid.New()), nil, owners, dataAccess, "")
+		app.MetadataKeeper.SetScope(ctx, *scope)
+	}
+
+	_, err := queryClient.DataAccess(gocontext.Background(), &types.DataAccessRequest{})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = address cannot be empty")
+
+	_, err = queryClient.DataAccess(gocontext.Background(), &types.DataAccessRequest{Address: "foo"})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = invalid address: decoding bech32 failed: invalid bech32 string length 3")
+
+	da, err := queryClient.DataAccess(gocontext.Background(), &types.DataAccessRequest{Address: user2})
+	s.NoError(err)
+	s.Equal(4, len(da.ScopeUuids), "should be 4 scopes listing user2 for data access")
+
+	da, err = queryClient.DataAccess(gocontext.Background(), &types.DataAccessRequest{Address: user2, Pagination: &query.PageRequest{Limit: 3}})
+	s.NoError(err)
+	s.Equal(3, len(da.ScopeUuids), "should be limited to 3 scopes")
+
+	da, err = queryClient.DataAccess(gocontext.Background(), &types.DataAccessRequest{Address: user1})
+	s.NoError(err)
+	s.Equal(5, len(da.ScopeUuids), "owners may read all 5 scopes")
+
+	valueOwner := sdk.AccAddress("value_owner_________")
+	scope := types.NewScope(types.ScopeMetadataAddress(uuid.New()), nil, owners, nil, valueOwner.String())
+	app.MetadataKeeper.SetScope(ctx, *scope)
+	da, err = queryClient.DataAccess(gocontext.Background(), &types.DataAccessRequest{Address: valueOwner.String()})
+	s.NoError(err)
+	s.Equal(1, len(da.ScopeUuids), "value owner may read its scope")
+
+	// changing the value owner moves the entry
+	scope.ValueOwnerAddress = user2
+	app.MetadataKeeper.SetScope(ctx, *scope)
+	da, err = queryClient.DataAccess(gocontext.Background(), &types.DataAccessRequest{Address: valueOwner.String()})
+	s.NoError(err)
+	s.Equal(0, len(da.ScopeUuids), "previous value owner may no longer read the scope")
+	da, err = queryClient.DataAccess(gocontext.Background(), &types.DataAccessRequest{Address: user2})
+	s.NoError(err)
+	s.Equal(5, len(da.ScopeUuids), "should be 4 data access scopes and 1 value owner scope for user2")
+}
+
+func (s *QueryServerTestSuite) TestCanAccessQuery() {
+	app, ctx, queryClient, scopeUUID, scopeID, sessionID, recordID, user1, user2 := s.app, s.ctx, s.queryClient, s.scopeUUID, s.scopeID, s.sessionID, s.recordID, s.user1, s.user2
+	reader := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	granted := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	stranger := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	markerAddr := markertypes.MustGetMarkerAddress("accesscoin").String()
+	err := app.MarkerKeeper.AddMarkerAccount(ctx, &markertypes.MarkerAccount{
+		BaseAccount:   &authtypes.BaseAccount{Address: markerAddr, AccountNumber: 23},
+		AccessControl: []markertypes.AccessGrant{{Address: granted, Permissions: markertypes.AccessListByNames("withdraw")}},
+		Denom:         "accesscoin",
+		Supply:        sdk.NewInt(1000),
+		MarkerType:    markertypes.MarkerType_Coin,
+		Status:        markertypes.StatusActive,
+	})
+	s.NoError(err)
+
+	owners := []types.Party{{Address: user1, Role: types.PartyType_PARTY_TYPE_OWNER}}
+	app.MetadataKeeper.SetScope(ctx, *types.NewScope(scopeID, nil, owners, []string{reader}, markerAddr))
+
+	_, err = queryClient.CanAccess(gocontext.Background(), &types.CanAccessRequest{Address: user1})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = id cannot be empty")
+
+	_, err = queryClient.CanAccess(gocontext.Background(), &types.CanAccessRequest{Id: scopeID.String()})
+	s.EqualError(err, "rpc error: code = InvalidArgument desc = address cannot be empty")
+
+	_, err = queryClient.CanAccess(gocontext.Background(), &types.CanAccessRequest{Id: s.specID.String(), Address: user1})
+	s.EqualError(err, fmt.Sprintf("rpc error: code = InvalidArgument desc = id %s is not a scope, session, or record metadata address", s.specID))
+
+	missingID := types.ScopeMetadataAddress(uuid.New())
+	_, err = queryClient.CanAccess(gocontext.Background(), &types.CanAccessRequest{Id: missingID.String(), Address: user1})
+	s.EqualError(err, fmt.Sprintf("rpc error: code = NotFound desc = scope %s not found", missingID))
+
+	tests := []struct {
+		name     string
+		id       types.MetadataAddress
+		address  string
+		expected types.CanAccessResponse
+	}{
+		{"owner", scopeID, user1, types.CanAccessResponse{Allowed: true, Owner: true}},
+		{"data access by session id", sessionID, reader, types.CanAccessResponse{Allowed: true, DataAccess: true}},
+		{"value owner by record id", recordID, markerAddr, types.CanAccessResponse{Allowed: true, ValueOwner: true}},
+		{"marker access grant", scopeID, granted, types.CanAccessResponse{Allowed: true, MarkerAccess: true}},
+		{"no access", scopeID, stranger, types.CanAccessResponse{}},
+		{"other user no access", scopeID, user2, types.CanAccessResponse{}},
+	}
+	for _, tc := range tests {
+		res, err := queryClient.CanAccess(gocontext.Background(), &types.CanAccessRequest{Id: tc.id.String(), Address: tc.address})
+		s.NoError(err, tc.name)
+		tc.expected.ScopeId = types.ScopeMetadataAddress(scopeUUID).String()
+		tc.expected.Address = tc.address
+		s.Equal(tc.expected, *res, tc.name)
+	}
+}
+
 // TODO: ScopeSpecification tests
 // TODO: ContractSpecification tests
 // TODO: ContractSpecificationExtended tests
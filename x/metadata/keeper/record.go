@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
@@ -51,8 +52,14 @@ func (k Keeper) SetRecord(ctx sdk.Context, record types.Record) {
 			"session id", record.SessionId, "name", record.Name, "error", err)
 		return
 	}
-	if store.Has(recordID) {
+	if oldBytes := store.Get(recordID); oldBytes != nil {
 		eventType = types.EventTypeRecordUpdated
+		// keep the version being replaced so earlier results are not lost
+		if !bytes.Equal(oldBytes, b) {
+			var oldRecord types.Record
+			k.cdc.MustUnmarshalBinaryBare(oldBytes, &oldRecord)
+			k.archiveRecord(ctx, recordID, oldRecord)
+		}
 	}
 
 	store.Set(recordID, b)
@@ -71,6 +78,9 @@ func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 		panic(fmt.Errorf("invalid address, address must be for a record"))
 	}
 	store := ctx.KVStore(k.storeKey)
+	if record, found := k.GetRecord(ctx, id); found {
+		k.archiveRecord(ctx, id, record)
+	}
 	store.Delete(id)
 
 	sessionUUID, _ := id.SessionUUID()
@@ -108,6 +118,17 @@ func (k Keeper) IterateRecords(ctx sdk.Context, scopeID types.MetadataAddress, h
 // ValidateRecordUpdate checks the current record and the proposed record to determine if the the proposed changes are valid
 // based on the existing state
 func (k Keeper) ValidateRecordUpdate(ctx sdk.Context, existing, proposed types.Record, signers []string) error {
+	return k.validateRecordUpdate(ctx, existing, proposed, nil, signers)
+}
+
+// validateRecordUpdate checks the proposed record against the existing record, the scope, and the specifications of the
+// session it is recorded in.  If the session is nil it is loaded from the store.
+func (k Keeper) validateRecordUpdate(
+	ctx sdk.Context,
+	existing, proposed types.Record,
+	session *types.Session,
+	signers []string,
+) error {
 	if len(existing.SessionId) > 0 {
 		if !existing.SessionId.Equals(proposed.SessionId) || existing.Name != proposed.Name {
 			return fmt.Errorf("existing and proposed records do not match")
@@ -136,8 +157,124 @@ func (k Keeper) ValidateRecordUpdate(ctx sdk.Context, existing, proposed types.R
 		return err
 	}
 
-	// TODO finish full validation of update once specs are complete
+	if session == nil {
+		s, found := k.GetSession(ctx, proposed.SessionId)
+		if !found {
+			return fmt.Errorf("session not found for session id %s", proposed.SessionId)
+		}
+		session = &s
+	}
+
+	contractSpec, found := k.GetContractSpecification(ctx, session.SpecificationId)
+	if !found {
+		return fmt.Errorf("contract specification not found for id %s", session.SpecificationId)
+	}
+	recSpecID, err := contractSpec.SpecificationId.AsRecordSpecAddress(proposed.Name)
+	if err != nil {
+		return err
+	}
+	recSpec, found := k.GetRecordSpecification(ctx, recSpecID)
+	if !found {
+		return fmt.Errorf("record specification not found for record name %q in contract specification %s",
+			proposed.Name, contractSpec.SpecificationId)
+	}
+
+	if err := validateRecordResponsibleParties(recSpec, append(scope.Owners, session.Parties...), signers); err != nil {
+		return err
+	}
+	if err := validateRecordInputs(recSpec, proposed.Inputs); err != nil {
+		return err
+	}
+	return validateRecordOutputs(recSpec, proposed.Outputs)
+}
+
+// validateRecordResponsibleParties ensures there is a signature from a party in each role responsible for the record.
+func validateRecordResponsibleParties(spec types.RecordSpecification, parties []types.Party, signers []string) error {
+	signed := make(map[string]bool, len(signers))
+	for _, signer := range signers {
+		signed[signer] = true
+	}
+	for _, role := range spec.ResponsibleParties {
+		found := false
+		for _, party := range parties {
+			if party.Role == role && signed[party.Address] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("missing signature from a %s party; required by record specification %s",
+				role, spec.SpecificationId)
+		}
+	}
+	return nil
+}
+
+// validateRecordInputs ensures the record inputs match the names, type names, and sources of the input specifications.
+// The record id or hash of an input must also match the one set on its input specification.
+func validateRecordInputs(spec types.RecordSpecification, inputs []types.RecordInput) error {
+	inputSpecs := make(map[string]*types.InputSpecification, len(spec.Inputs))
+	for _, inputSpec := range spec.Inputs {
+		if inputSpec != nil {
+			inputSpecs[inputSpec.Name] = inputSpec
+		}
+	}
+	provided := make(map[string]bool, len(inputs))
+	for _, input := range inputs {
+		inputSpec, found := inputSpecs[input.Name]
+		if !found {
+			return fmt.Errorf("input %q is not defined in record specification %s", input.Name, spec.SpecificationId)
+		}
+		if provided[input.Name] {
+			return fmt.Errorf("input %q is provided more than once", input.Name)
+		}
+		provided[input.Name] = true
+		if input.TypeName != inputSpec.TypeName {
+			return fmt.Errorf("input %q has type %q, record specification requires %q",
+				input.Name, input.TypeName, inputSpec.TypeName)
+		}
+		switch specSource := inputSpec.Source.(type) {
+		case *types.InputSpecification_RecordId:
+			source, ok := input.Source.(*types.RecordInput_RecordId)
+			if !ok || input.Status != types.RecordInputStatus_Record {
+				return fmt.Errorf("input %q must be sourced from a record, as required by record specification", input.Name)
+			}
+			if !specSource.RecordId.Empty() && !specSource.RecordId.Equals(source.RecordId) {
+				return fmt.Errorf("input %q is sourced from record %s, record specification requires %s",
+					input.Name, source.RecordId, specSource.RecordId)
+			}
+		case *types.InputSpecification_Hash:
+			source, ok := input.Source.(*types.RecordInput_Hash)
+			if !ok || input.Status != types.RecordInputStatus_Proposed {
+				return fmt.Errorf("input %q must be sourced from a hash, as required by record specification", input.Name)
+			}
+			if len(specSource.Hash) > 0 && specSource.Hash != source.Hash {
+				return fmt.Errorf("input %q has hash %q, record specification requires %q",
+					input.Name, source.Hash, specSource.Hash)
+			}
+		}
+	}
+	for _, inputSpec := range spec.Inputs {
+		if inputSpec != nil && !provided[inputSpec.Name] {
+			return fmt.Errorf("missing input %q required by record specification %s", inputSpec.Name, spec.SpecificationId)
+		}
+	}
+	return nil
+}
 
+// validateRecordOutputs ensures the number of record outputs agrees with the result type of the record specification.
+func validateRecordOutputs(spec types.RecordSpecification, outputs []types.RecordOutput) error {
+	switch spec.ResultType {
+	case types.DefinitionType_DEFINITION_TYPE_RECORD:
+		if len(outputs) != 1 {
+			return fmt.Errorf("record specification result type %s requires exactly one output, found %d",
+				spec.ResultType, len(outputs))
+		}
+	case types.DefinitionType_DEFINITION_TYPE_RECORD_LIST:
+		if len(outputs) == 0 {
+			return fmt.Errorf("record specification result type %s requires at least one output", spec.ResultType)
+		}
+	}
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/metadata/types"
@@ -42,6 +43,43 @@ func (k Keeper) IterateScopesForAddress(ctx sdk.Context, address sdk.AccAddress,
 	return nil
 }
 
+// IterateScopesForValueOwner processes scopes with the provided address as their value owner with the given handler.
+func (k Keeper) IterateScopesForValueOwner(ctx sdk.Context, address sdk.AccAddress, handler func(scopeID types.MetadataAddress) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetValueOwnerScopeCacheIteratorPrefix(address)
+	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var scopeID types.MetadataAddress
+		if err := scopeID.Unmarshal(it.Key()[len(prefix):]); err != nil {
+			return err
+		}
+		if handler(scopeID) {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateScopesForDataAccess processes scopes the provided address may read the data of as an owner, data access party,
+// or value owner with the given handler.
+func (k Keeper) IterateScopesForDataAccess(ctx sdk.Context, address sdk.AccAddress, handler func(scopeID types.MetadataAddress) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetDataAccessScopeCacheIteratorPrefix(address)
+	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var scopeID types.MetadataAddress
+		if err := scopeID.Unmarshal(it.Key()[len(prefix):]); err != nil {
+			return err
+		}
+		if handler(scopeID) {
+			break
+		}
+	}
+	return nil
+}
+
 // IterateScopesForScopeSpec processes scopes associated with the provided scope specification id with the given handler.
 func (k Keeper) IterateScopesForScopeSpec(ctx sdk.Context, scopeSpecID types.MetadataAddress,
 	handler func(scopeID types.MetadataAddress) (stop bool),
@@ -127,6 +165,18 @@ func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) {
 
 	// RecordGroups will be removed as the last record in each is deleted.
 
+	// Sessions without any records are not removed with the records, they must not outlive the scope either.
+	var sessionIDs []types.MetadataAddress
+	if err = k.IterateSessions(ctx, id, func(s types.Session) (stop bool) {
+		sessionIDs = append(sessionIDs, s.SessionId)
+		return false
+	}); err != nil {
+		panic(err)
+	}
+	for _, sessionID := range sessionIDs {
+		k.RemoveSession(ctx, sessionID)
+	}
+
 	k.clearScopeIndex(ctx, scope)
 
 	ctx.EventManager().EmitEvent(
@@ -139,6 +189,28 @@ func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) {
 	store.Delete(id)
 }
 
+// GetScopeAccess reports the grounds the given address has to receive the off-chain data of a scope: as an owner, as a
+// data access party, as the value owner, or through an access grant on a marker that is the value owner.
+func (k Keeper) GetScopeAccess(ctx sdk.Context, scope types.Scope, address string) types.CanAccessResponse {
+	access := types.CanAccessResponse{ScopeId: scope.ScopeId.String(), Address: address}
+	for _, p := range scope.Owners {
+		if p.Address == address {
+			access.Owner = true
+			break
+		}
+	}
+	for _, a := range scope.DataAccess {
+		if a == address {
+			access.DataAccess = true
+			break
+		}
+	}
+	access.ValueOwner = len(scope.ValueOwnerAddress) > 0 && scope.ValueOwnerAddress == address
+	access.MarkerAccess = k.HasMarkerAccessGrant(ctx, scope.ValueOwnerAddress, address)
+	access.Allowed = access.Owner || access.DataAccess || access.ValueOwner || access.MarkerAccess
+	return access
+}
+
 // clearScopeIndex delete any index records for this scope
 func (k Keeper) clearScopeIndex(ctx sdk.Context, scope types.Scope) {
 	store := ctx.KVStore(k.storeKey)
@@ -162,6 +234,7 @@ func (k Keeper) clearScopeIndex(ctx sdk.Context, scope types.Scope) {
 		addr, err := sdk.AccAddressFromBech32(a)
 		if err == nil {
 			store.Delete(types.GetAddressScopeCacheKey(addr, scope.ScopeId))
+			store.Delete(types.GetDataAccessScopeCacheKey(addr, scope.ScopeId))
 		}
 	}
 	store.Delete(types.GetScopeSpecScopeCacheKey(scope.SpecificationId, scope.ScopeId))
@@ -189,6 +262,8 @@ func (k Keeper) indexScope(ctx sdk.Context, scope types.Scope) {
 		addr, err := sdk.AccAddressFromBech32(a)
 		if err == nil {
 			store.Set(types.GetAddressScopeCacheKey(addr, scope.ScopeId), []byte{0x01})
+			// owners, data access parties, and the value owner may all read the scope data
+			store.Set(types.GetDataAccessScopeCacheKey(addr, scope.ScopeId), []byte{0x01})
 		}
 	}
 	if len(scope.SpecificationId) > 0 {
@@ -196,6 +271,35 @@ func (k Keeper) indexScope(ctx sdk.Context, scope types.Scope) {
 	}
 }
 
+// BuildDataAccessScopeIndex adds the data access index entries for the owners, data access parties, and value owner
+// of every stored scope.  This is intended for initializing the index on an existing chain.
+func (k Keeper) BuildDataAccessScopeIndex(ctx sdk.Context) error {
+	var keys [][]byte
+	err := k.IterateScopes(ctx, func(scope types.Scope) bool {
+		addresses := append([]string{}, scope.DataAccess...)
+		for _, p := range scope.Owners {
+			addresses = append(addresses, p.Address)
+		}
+		if len(scope.ValueOwnerAddress) > 0 {
+			addresses = append(addresses, scope.ValueOwnerAddress)
+		}
+		for _, a := range addresses {
+			if addr, err := sdk.AccAddressFromBech32(a); err == nil {
+				keys = append(keys, types.GetDataAccessScopeCacheKey(addr, scope.ScopeId))
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Set(key, []byte{0x01})
+	}
+	return nil
+}
+
 // ValidateScopeUpdate checks the current scope and the proposed scope to determine if the the proposed changes are valid
 // based on the existing state
 func (k Keeper) ValidateScopeUpdate(ctx sdk.Context, existing, proposed types.Scope, signers []string) error {
@@ -242,6 +346,18 @@ func (k Keeper) ValidateScopeUpdate(ctx sdk.Context, existing, proposed types.Sc
 		return err
 	}
 
+	// A scope specification, when set, must exist and have its required parties present on the scope.
+	if !proposed.SpecificationId.Empty() {
+		scopeSpec, found := k.GetScopeSpecification(ctx, proposed.SpecificationId)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrScopeSpecificationNotFound, "cannot find scope specification %s",
+				proposed.SpecificationId)
+		}
+		if err := k.ValidatePartiesInvolved(proposed.Owners, scopeSpec.PartiesInvolved); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // GetSession returns the scope with the given id.
@@ -28,8 +30,14 @@ func (k Keeper) SetSession(ctx sdk.Context, session types.Session) {
 	b := k.cdc.MustMarshalBinaryBare(&session)
 	eventType := types.EventTypeSessionCreated
 
-	if store.Has(session.SessionId) {
+	if oldBytes := store.Get(session.SessionId); oldBytes != nil {
 		eventType = types.EventTypeSessionUpdated
+		// keep the version being replaced so earlier parties and audit details are not lost
+		if !bytes.Equal(oldBytes, b) {
+			var oldSession types.Session
+			k.cdc.MustUnmarshalBinaryBare(oldBytes, &oldSession)
+			k.archiveSession(ctx, oldSession)
+		}
 	}
 
 	store.Set(session.SessionId, b)
@@ -52,6 +60,9 @@ func (k Keeper) RemoveSession(ctx sdk.Context, id types.MetadataAddress) {
 	hasRecords, err := k.hasSessionRecords(ctx, id)
 
 	if err == nil && !hasRecords {
+		if session, found := k.GetSession(ctx, id); found {
+			k.archiveSession(ctx, session)
+		}
 		store.Delete(id)
 
 		ctx.EventManager().EmitEvent(
@@ -135,7 +146,8 @@ func (k Keeper) ValidateSessionUpdate(ctx sdk.Context, existing, proposed types.
 
 	contractSpec, found := k.GetContractSpecification(ctx, proposed.SpecificationId)
 	if !found {
-		return fmt.Errorf("cannot find contract specification %s", proposed.SpecificationId)
+		return sdkerrors.Wrapf(types.ErrContractSpecificationNotFound, "cannot find contract specification %s",
+			proposed.SpecificationId)
 	}
 
 	if proposed.GetName() != contractSpec.GetClassName() {
@@ -146,6 +158,27 @@ func (k Keeper) ValidateSessionUpdate(ctx sdk.Context, existing, proposed types.
 		return err
 	}
 
+	// The contract specification must be one of those allowed by the scope specification.
+	if !scope.SpecificationId.Empty() {
+		scopeSpec, found := k.GetScopeSpecification(ctx, scope.SpecificationId)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrScopeSpecificationNotFound, "cannot find scope specification %s",
+				scope.SpecificationId)
+		}
+		allowed := false
+		for _, id := range scopeSpec.ContractSpecIds {
+			if id.Equals(proposed.SpecificationId) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return sdkerrors.Wrapf(types.ErrContractSpecificationNotAllowed,
+				"contract specification %s is not allowed by scope specification %s",
+				proposed.SpecificationId, scope.SpecificationId)
+		}
+	}
+
 	if err = k.ValidateRequiredSignatures(scope.Owners, signers); err != nil {
 		return err
 	}
@@ -1,16 +1,23 @@
 package keeper
 
 import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
 	"fmt"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/cosmos/cosmos-sdk/client"
 	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/metadata/types"
+	"github.com/provenance-io/provenance/x/metadata/types/p8e"
 )
 
 // AccountIsMarker determines if account is marker
@@ -62,6 +69,44 @@ func (k Keeper) HasSignerWithMarkerValueAuthority(ctx sdk.Context, valueOwner st
 	return false
 }
 
+// markerDataAccessPermissions are the marker permissions that control the value held by a marker and so give access
+// to the data of the scopes the marker owns the value of.  Other permissions such as deposit, mint, or burn do not.
+var markerDataAccessPermissions = []markertypes.Access{
+	markertypes.Access_Admin,
+	markertypes.Access_Withdraw,
+	markertypes.Access_Transfer,
+}
+
+// HasMarkerAccessGrant checks if the value owner is a marker that grants the given address admin, withdraw, or transfer
+// access.
+func (k Keeper) HasMarkerAccessGrant(ctx sdk.Context, valueOwner string, address string) bool {
+	valueOwnerAddr, err := sdk.AccAddressFromBech32(valueOwner)
+	// if the value owner is invalid then it is not possible to have any authority for it. e.g. value owner is empty.
+	if err != nil {
+		return false
+	}
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return false
+	}
+
+	mac := k.authKeeper.GetAccount(ctx, valueOwnerAddr)
+	if mac == nil {
+		return false
+	}
+
+	macc, isMarker := mac.(*markertypes.MarkerAccount)
+	if !isMarker {
+		return false
+	}
+	for _, role := range markerDataAccessPermissions {
+		if macc.AddressHasAccess(addr, role) {
+			return true
+		}
+	}
+	return false
+}
+
 // ValidateRawSignature takes a given message and verifies the signature instance is valid
 // for it directly without calculating a signing structure to wrap it. ValidateRawSignature returns the address of the
 // user who created the signature and any encountered errors.
@@ -94,6 +139,130 @@ func (k Keeper) ValidateRawSignature(signature signing.SignatureDescriptor, mess
 	return
 }
 
+// ValidateContractSignatures verifies each of the signatures against the marshalled contract execution, the contract
+// bound to the scope, session, and execution it is recorded under, and returns the addresses of the signers.  Every
+// party listed in the contract recitals must be one of the signers.
+func (k Keeper) ValidateContractSignatures(
+	scopeID, sessionID types.MetadataAddress,
+	executionID string,
+	contract types.Contract,
+	signatures signing.SignatureDescriptors,
+) ([]string, error) {
+	message, err := k.cdc.MarshalBinaryBare(types.NewContractExecution(scopeID, sessionID, executionID, contract))
+	if err != nil {
+		return nil, err
+	}
+	return k.validateSignedParties(contract.Recitals.AsParties(), signatures, message)
+}
+
+// ValidateRecitalSignatures verifies each of the signatures against the marshalled contract execution, the recitals
+// bound to the scope and execution of the ownership change, and returns the addresses of the signers.  Every party
+// listed in the recitals must be one of the signers.
+func (k Keeper) ValidateRecitalSignatures(
+	scopeID types.MetadataAddress,
+	executionID string,
+	recitals types.Recitals,
+	signatures signing.SignatureDescriptors,
+) ([]string, error) {
+	message, err := k.cdc.MarshalBinaryBare(types.NewRecitalsExecution(scopeID, executionID, recitals))
+	if err != nil {
+		return nil, err
+	}
+	return k.validateSignedParties(recitals.AsParties(), signatures, message)
+}
+
+// validateSignedParties verifies each of the signatures against the message and makes sure all parties have signed.
+func (k Keeper) validateSignedParties(parties []types.Party, signatures signing.SignatureDescriptors, message []byte) ([]string, error) {
+	signers := make([]string, 0, len(signatures.Signatures))
+	for i, sig := range signatures.Signatures {
+		if sig == nil {
+			return nil, fmt.Errorf("signature %d is empty", i)
+		}
+		addr, err := k.ValidateRawSignature(*sig, message)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature %d: %w", i, err)
+		}
+		signers = append(signers, addr.String())
+	}
+	if len(signers) == 0 {
+		return nil, fmt.Errorf("at least one signature is required")
+	}
+	if err := k.ValidateRequiredSignatures(parties, signers); err != nil {
+		return nil, err
+	}
+	return signers, nil
+}
+
+// ValidateP8eContractSignatures verifies each of the p8e signatures against the marshalled p8e contract and returns
+// the addresses of the signers.  Every party listed in the contract recitals must be one of the signers.
+func (k Keeper) ValidateP8eContractSignatures(contract p8e.Contract, signatures p8e.SignatureSet) ([]string, error) {
+	recitals, err := types.ConvertP8eRecitals(contract.Recitals)
+	if err != nil {
+		return nil, err
+	}
+	message, err := k.cdc.MarshalBinaryBare(&contract)
+	if err != nil {
+		return nil, err
+	}
+	signers := make([]string, 0, len(signatures.Signatures))
+	for i, sig := range signatures.Signatures {
+		if sig == nil {
+			return nil, fmt.Errorf("signature %d is empty", i)
+		}
+		addr, err := validateP8eSignature(*sig, message)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature %d: %w", i, err)
+		}
+		signers = append(signers, addr.String())
+	}
+	if len(signers) == 0 {
+		return nil, fmt.Errorf("at least one signature is required")
+	}
+	if err := k.ValidateRequiredSignatures(recitals.AsParties(), signers); err != nil {
+		return nil, err
+	}
+	return signers, nil
+}
+
+// validateP8eSignature verifies a p8e (DER encoded ECDSA over secp256k1) signature of the message and returns the
+// address of the signing public key.
+func validateP8eSignature(sig p8e.Signature, message []byte) (sdk.AccAddress, error) {
+	if sig.Signer == nil || sig.Signer.SigningPublicKey == nil {
+		return nil, fmt.Errorf("missing signing public key")
+	}
+	if sig.Signer.SigningPublicKey.Curve != p8e.PublicKeyCurve_SECP256K1 {
+		return nil, fmt.Errorf("unsupported public key curve %s", sig.Signer.SigningPublicKey.Curve)
+	}
+	pubKey, err := btcec.ParsePubKey(sig.Signer.SigningPublicKey.PublicKeyBytes, btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	var hash []byte
+	switch sig.Algo {
+	case "SHA256withECDSA":
+		h := sha256.Sum256(message)
+		hash = h[:]
+	case "SHA512withECDSA":
+		h := sha512.Sum512(message)
+		hash = h[:]
+	default:
+		return nil, fmt.Errorf("unsupported signature algorithm %q", sig.Algo)
+	}
+	sigBytes, err := base64.StdEncoding.DecodeString(sig.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("signature is not base64 encoded: %w", err)
+	}
+	signature, err := btcec.ParseDERSignature(sigBytes, btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	if !signature.Verify(hash, pubKey) {
+		return nil, fmt.Errorf("unable to verify signature")
+	}
+	secpPubKey := secp256k1.PubKey{Key: pubKey.SerializeCompressed()}
+	return sdk.AccAddress(secpPubKey.Address()), nil
+}
+
 // CreateRawSignature creates a standard TX signature but uses the message bytes as provided instead of the typical approach
 // of building a signing structure with sequence, chain-id, and account number.  This approach is required for independent
 // signatures like those used in the contract memorialize process which are independent of blockchain tx and their replay protection.
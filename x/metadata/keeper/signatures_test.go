@@ -245,3 +245,42 @@ func TestHasMarkerValueAuthority(t *testing.T) {
 			markertypes.Access_Burn),
 		"user doesn't have this access")
 }
+
+func TestHasMarkerAccessGrant(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	user := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address().Bytes())
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address().Bytes())
+	depositor := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address().Bytes())
+
+	markerAddr := markertypes.MustGetMarkerAddress("testcoin").String()
+	err := app.MarkerKeeper.AddMarkerAccount(ctx, &markertypes.MarkerAccount{
+		BaseAccount: &authtypes.BaseAccount{
+			Address:       markerAddr,
+			AccountNumber: 23,
+		},
+		AccessControl: []markertypes.AccessGrant{
+			{
+				Address:     user.String(),
+				Permissions: markertypes.AccessListByNames("withdraw"),
+			},
+			{
+				Address:     depositor.String(),
+				Permissions: markertypes.AccessListByNames("deposit,mint,burn"),
+			},
+		},
+		Denom:      "testcoin",
+		Supply:     sdk.NewInt(1000),
+		MarkerType: markertypes.MarkerType_Coin,
+		Status:     markertypes.StatusActive,
+	})
+	require.NoError(t, err)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, user))
+
+	require.True(t, app.MetadataKeeper.HasMarkerAccessGrant(ctx, markerAddr, user.String()), "user has a grant")
+	require.False(t, app.MetadataKeeper.HasMarkerAccessGrant(ctx, markerAddr, other.String()), "other has no grant")
+	require.False(t, app.MetadataKeeper.HasMarkerAccessGrant(ctx, markerAddr, depositor.String()), "deposit gives no data access")
+	require.False(t, app.MetadataKeeper.HasMarkerAccessGrant(ctx, user.String(), user.String()), "user is not a marker")
+	require.False(t, app.MetadataKeeper.HasMarkerAccessGrant(ctx, "", user.String()), "no value owner")
+}
@@ -243,7 +243,9 @@ func TestMigrate(t *testing.T) {
       "specification_id": "contractspec1qvfha8nex5j0qeshr9uvsmv5um3q7sghss"
     }
   ],
+  "executions": [],
   "params": {},
+  "record_history": [],
   "record_specifications": [
     {
       "inputs": [
@@ -323,6 +325,7 @@ func TestMigrate(t *testing.T) {
       "value_owner_address": ""
     }
   ],
+  "session_history": [],
   "sessions": [
     {
       "audit": {
@@ -0,0 +1,109 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding metadata type.  Index entries carry no value so their
+// keys are decoded into the two identifiers they relate.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.ScopeKeyPrefix):
+			var scopeA, scopeB types.Scope
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &scopeA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &scopeB)
+			return fmt.Sprintf("%v\n%v", scopeA, scopeB)
+
+		case bytes.Equal(kvA.Key[:1], types.SessionKeyPrefix):
+			var sessionA, sessionB types.Session
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &sessionA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &sessionB)
+			return fmt.Sprintf("%v\n%v", sessionA, sessionB)
+
+		case bytes.Equal(kvA.Key[:1], types.RecordKeyPrefix):
+			var recordA, recordB types.Record
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &recordA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(kvA.Key[:1], types.ContractSpecificationKeyPrefix):
+			var specA, specB types.ContractSpecification
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &specA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &specB)
+			return fmt.Sprintf("%v\n%v", specA, specB)
+
+		case bytes.Equal(kvA.Key[:1], types.ScopeSpecificationKeyPrefix):
+			var specA, specB types.ScopeSpecification
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &specA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &specB)
+			return fmt.Sprintf("%v\n%v", specA, specB)
+
+		case bytes.Equal(kvA.Key[:1], types.RecordSpecificationKeyPrefix):
+			var specA, specB types.RecordSpecification
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &specA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &specB)
+			return fmt.Sprintf("%v\n%v", specA, specB)
+
+		case bytes.Equal(kvA.Key[:1], types.RecordHistoryKeyPrefix):
+			var versionA, versionB types.RecordVersion
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &versionA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &versionB)
+			return fmt.Sprintf("%v\n%v", versionA, versionB)
+
+		case bytes.Equal(kvA.Key[:1], types.SessionHistoryKeyPrefix):
+			var versionA, versionB types.SessionVersion
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &versionA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &versionB)
+			return fmt.Sprintf("%v\n%v", versionA, versionB)
+
+		case bytes.Equal(kvA.Key[:1], types.ExecutionKeyPrefix):
+			var executionA, executionB types.Execution
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &executionA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &executionB)
+			return fmt.Sprintf("%v\n%v", executionA, executionB)
+
+		case bytes.Equal(kvA.Key[:1], types.AddressScopeCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.ValueOwnerScopeCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.AddressScopeSpecCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.AddressContractSpecCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.DataAccessScopeCacheKeyPrefix):
+			return fmt.Sprintf("%v\n%v", addressIndexEntry(kvA), addressIndexEntry(kvB))
+
+		case bytes.Equal(kvA.Key[:1], types.ScopeSpecScopeCacheKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.ContractSpecScopeSpecCacheKeyPrefix):
+			return fmt.Sprintf("%v\n%v", metadataIndexEntry(kvA), metadataIndexEntry(kvB))
+
+		default:
+			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
+		}
+	}
+}
+
+// addressIndexEntry formats an index entry keyed by an account address followed by a metadata id.
+func addressIndexEntry(pair kv.Pair) string {
+	split := len(pair.Key) - (1 + 16) // all indexed ids are a type byte and a single uuid
+	if split < 1 {
+		return fmt.Sprintf("%X: %v", pair.Key, pair.Value)
+	}
+	return fmt.Sprintf("%v %v: %v",
+		sdk.AccAddress(pair.Key[1:split]), types.MetadataAddress(pair.Key[split:]), pair.Value)
+}
+
+// metadataIndexEntry formats an index entry keyed by two metadata ids.
+func metadataIndexEntry(pair kv.Pair) string {
+	split := 1 + 1 + 16 // prefix plus an id made of a type byte and a single uuid
+	if len(pair.Key) < split {
+		return fmt.Sprintf("%X: %v", pair.Key, pair.Value)
+	}
+	return fmt.Sprintf("%v %v: %v",
+		types.MetadataAddress(pair.Key[1:split]), types.MetadataAddress(pair.Key[split:]), pair.Value)
+}
@@ -0,0 +1,102 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/simulation"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	scopeUUID := uuid.New()
+	contractSpecUUID := uuid.New()
+
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	contractSpecID := types.ContractSpecMetadataAddress(contractSpecUUID)
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+
+	parties := []types.Party{{Address: owner.String(), Role: types.PartyType_PARTY_TYPE_OWNER}}
+	scope := *types.NewScope(scopeID, scopeSpecID, parties, nil, owner.String())
+	session := *types.NewSession("session", sessionID, contractSpecID, parties, nil)
+	record := *types.NewRecord("record", sessionID, *types.NewProcess("process", &types.Process_Hash{Hash: "hash"}, "method"), nil, nil)
+	contractSpec := *types.NewContractSpecification(contractSpecID, nil, []string{owner.String()},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, types.NewContractSpecificationSourceHash("hash"), "class")
+	scopeSpec := *types.NewScopeSpecification(scopeSpecID, nil, []string{owner.String()},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{contractSpecID})
+	recordSpec := *types.NewRecordSpecification(types.RecordSpecMetadataAddress(contractSpecUUID, "record"), "record",
+		nil, "type", types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER})
+	recordVersion := types.RecordVersion{Version: 1, Record: record, Height: 2}
+	sessionVersion := types.SessionVersion{Version: 1, Session: session, Height: 2}
+	execution := types.Execution{ExecutionId: "execution", ScopeId: scopeID, Height: 2}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: scopeID, Value: cdc.MustMarshalBinaryBare(&scope)},
+			{Key: sessionID, Value: cdc.MustMarshalBinaryBare(&session)},
+			{Key: types.RecordMetadataAddress(scopeUUID, "record"), Value: cdc.MustMarshalBinaryBare(&record)},
+			{Key: contractSpecID, Value: cdc.MustMarshalBinaryBare(&contractSpec)},
+			{Key: scopeSpecID, Value: cdc.MustMarshalBinaryBare(&scopeSpec)},
+			{Key: recordSpec.SpecificationId, Value: cdc.MustMarshalBinaryBare(&recordSpec)},
+			{Key: types.GetRecordHistoryKey(types.RecordMetadataAddress(scopeUUID, "record"), 1), Value: cdc.MustMarshalBinaryBare(&recordVersion)},
+			{Key: types.GetSessionHistoryKey(sessionID, 1), Value: cdc.MustMarshalBinaryBare(&sessionVersion)},
+			{Key: types.GetExecutionKey("execution"), Value: cdc.MustMarshalBinaryBare(&execution)},
+			{Key: types.GetAddressScopeCacheKey(owner, scopeID), Value: []byte{0x01}},
+			{Key: types.GetScopeSpecScopeCacheKey(scopeSpecID, scopeID), Value: []byte{0x01}},
+			{Key: types.GetValueOwnerScopeCacheKey(owner, scopeID), Value: []byte{0x01}},
+			{Key: types.GetAddressScopeSpecCacheKey(owner, scopeSpecID), Value: []byte{0x01}},
+			{Key: types.GetContractSpecScopeSpecCacheKey(contractSpecID, scopeSpecID), Value: []byte{0x01}},
+			{Key: types.GetAddressContractSpecCacheKey(owner, contractSpecID), Value: []byte{0x01}},
+			{Key: types.GetDataAccessScopeCacheKey(owner, scopeID), Value: []byte{0x01}},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Scope", fmt.Sprintf("%v\n%v", scope, scope)},
+		{"Session", fmt.Sprintf("%v\n%v", session, session)},
+		{"Record", fmt.Sprintf("%v\n%v", record, record)},
+		{"ContractSpecification", fmt.Sprintf("%v\n%v", contractSpec, contractSpec)},
+		{"ScopeSpecification", fmt.Sprintf("%v\n%v", scopeSpec, scopeSpec)},
+		{"RecordSpecification", fmt.Sprintf("%v\n%v", recordSpec, recordSpec)},
+		{"RecordHistory", fmt.Sprintf("%v\n%v", recordVersion, recordVersion)},
+		{"SessionHistory", fmt.Sprintf("%v\n%v", sessionVersion, sessionVersion)},
+		{"Execution", fmt.Sprintf("%v\n%v", execution, execution)},
+		{"AddressScopeCache", fmt.Sprintf("%v %v: [1]\n%v %v: [1]", owner, scopeID, owner, scopeID)},
+		{"ScopeSpecScopeCache", fmt.Sprintf("%v %v: [1]\n%v %v: [1]", scopeSpecID, scopeID, scopeSpecID, scopeID)},
+		{"ValueOwnerScopeCache", fmt.Sprintf("%v %v: [1]\n%v %v: [1]", owner, scopeID, owner, scopeID)},
+		{"AddressScopeSpecCache", fmt.Sprintf("%v %v: [1]\n%v %v: [1]", owner, scopeSpecID, owner, scopeSpecID)},
+		{"ContractSpecScopeSpecCache", fmt.Sprintf("%v %v: [1]\n%v %v: [1]", contractSpecID, scopeSpecID, contractSpecID, scopeSpecID)},
+		{"AddressContractSpecCache", fmt.Sprintf("%v %v: [1]\n%v %v: [1]", owner, contractSpecID, owner, contractSpecID)},
+		{"DataAccessScopeCache", fmt.Sprintf("%v %v: [1]\n%v %v: [1]", owner, scopeID, owner, scopeID)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/google/uuid"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// partyTypes are the party types that can be required by a specification
+var partyTypes = []types.PartyType{
+	types.PartyType_PARTY_TYPE_ORIGINATOR,
+	types.PartyType_PARTY_TYPE_SERVICER,
+	types.PartyType_PARTY_TYPE_INVESTOR,
+	types.PartyType_PARTY_TYPE_CUSTODIAN,
+	types.PartyType_PARTY_TYPE_OWNER,
+	types.PartyType_PARTY_TYPE_AFFILIATE,
+	types.PartyType_PARTY_TYPE_OMNIBUS,
+	types.PartyType_PARTY_TYPE_PROVENANCE,
+}
+
+// GenUUID returns a random uuid drawn from the simulation source so runs are reproducible for a seed
+func GenUUID(r *rand.Rand) uuid.UUID {
+	return uuid.Must(uuid.NewRandomFromReader(r))
+}
+
+// GenPartyTypes returns a random non-empty set of distinct party types
+func GenPartyTypes(r *rand.Rand) []types.PartyType {
+	perm := r.Perm(len(partyTypes))[:simtypes.RandIntBetween(r, 1, 4)]
+	parties := make([]types.PartyType, len(perm))
+	for i, p := range perm {
+		parties[i] = partyTypes[p]
+	}
+	return parties
+}
+
+// GenContractSpecification returns a random contract specification owned by the given account
+func GenContractSpecification(r *rand.Rand, owner simtypes.Account) types.ContractSpecification {
+	return *types.NewContractSpecification(
+		types.ContractSpecMetadataAddress(GenUUID(r)),
+		types.NewDescription(simtypes.RandStringOfLength(r, 10), simtypes.RandStringOfLength(r, 50), "", ""),
+		[]string{owner.Address.String()},
+		GenPartyTypes(r),
+		types.NewContractSpecificationSourceHash(simtypes.RandStringOfLength(r, 32)),
+		simtypes.RandStringOfLength(r, 20),
+	)
+}
+
+// GenRecordSpecification returns a random record specification for the contract specification.  The parties
+// responsible for the record are drawn from the parties involved in the contract and all inputs are sourced by hash.
+func GenRecordSpecification(r *rand.Rand, contractSpec types.ContractSpecification) types.RecordSpecification {
+	contractSpecUUID, err := contractSpec.SpecificationId.ContractSpecUUID()
+	if err != nil {
+		panic(err)
+	}
+	name := simtypes.RandStringOfLength(r, 10)
+	inputs := make([]*types.InputSpecification, r.Intn(3))
+	for i := range inputs {
+		inputs[i] = types.NewInputSpecification(
+			fmt.Sprintf("input%d", i),
+			simtypes.RandStringOfLength(r, 10),
+			types.NewInputSpecificationSourceHash(simtypes.RandStringOfLength(r, 32)),
+		)
+	}
+	involved := contractSpec.PartiesInvolved
+	responsible := make([]types.PartyType, simtypes.RandIntBetween(r, 1, len(involved)+1))
+	for i, p := range r.Perm(len(involved))[:len(responsible)] {
+		responsible[i] = involved[p]
+	}
+	resultTypes := []types.DefinitionType{
+		types.DefinitionType_DEFINITION_TYPE_PROPOSED,
+		types.DefinitionType_DEFINITION_TYPE_RECORD,
+		types.DefinitionType_DEFINITION_TYPE_RECORD_LIST,
+	}
+	return *types.NewRecordSpecification(
+		types.RecordSpecMetadataAddress(contractSpecUUID, name),
+		name,
+		inputs,
+		simtypes.RandStringOfLength(r, 10),
+		resultTypes[r.Intn(len(resultTypes))],
+		responsible,
+	)
+}
+
+// GenScopeSpecification returns a random scope specification owned by the given account that allows the contract
+// specifications.
+func GenScopeSpecification(
+	r *rand.Rand, owner simtypes.Account, contractSpecIDs []types.MetadataAddress,
+) types.ScopeSpecification {
+	return *types.NewScopeSpecification(
+		types.ScopeSpecMetadataAddress(GenUUID(r)),
+		types.NewDescription(simtypes.RandStringOfLength(r, 10), simtypes.RandStringOfLength(r, 50), "", ""),
+		[]string{owner.Address.String()},
+		GenPartyTypes(r),
+		contractSpecIDs,
+	)
+}
+
+// GenScope returns a random scope owned by one or two of the accounts.  When a scope specification is given the
+// owners fill every party type it requires.
+func GenScope(r *rand.Rand, accs []simtypes.Account, scopeSpec *types.ScopeSpecification) types.Scope {
+	var owners []simtypes.Account
+	for i := simtypes.RandIntBetween(r, 1, 3); i > 0; i-- {
+		acc, _ := simtypes.RandomAcc(r, accs)
+		owners = append(owners, acc)
+	}
+
+	roles := GenPartyTypes(r)
+	specID := types.MetadataAddress{}
+	if scopeSpec != nil {
+		roles = scopeSpec.PartiesInvolved
+		specID = scopeSpec.SpecificationId
+	}
+	parties := make([]types.Party, len(roles))
+	for i, role := range roles {
+		parties[i] = types.Party{Address: owners[i%len(owners)].Address.String(), Role: role}
+	}
+
+	dataAccess := make([]string, r.Intn(4))
+	for i := range dataAccess {
+		acc, _ := simtypes.RandomAcc(r, accs)
+		dataAccess[i] = acc.Address.String()
+	}
+
+	return *types.NewScope(
+		types.ScopeMetadataAddress(GenUUID(r)),
+		specID,
+		parties,
+		dataAccess,
+		owners[r.Intn(len(owners))].Address.String(),
+	)
+}
+
+// GenSession returns a new session in the scope for the contract specification, the parties involved in the contract
+// are filled by the owners of the scope.
+func GenSession(r *rand.Rand, scope types.Scope, contractSpec types.ContractSpecification) types.Session {
+	scopeUUID, err := scope.ScopeId.ScopeUUID()
+	if err != nil {
+		panic(err)
+	}
+	parties := make([]types.Party, len(contractSpec.PartiesInvolved))
+	for i, role := range contractSpec.PartiesInvolved {
+		parties[i] = types.Party{Address: scope.Owners[r.Intn(len(scope.Owners))].Address, Role: role}
+	}
+	return *types.NewSession(
+		contractSpec.ClassName,
+		types.SessionMetadataAddress(scopeUUID, GenUUID(r)),
+		contractSpec.SpecificationId,
+		parties,
+		nil,
+	)
+}
+
+// GenRecord returns a record in the session with the inputs and outputs required by the record specification.  Inputs
+// take the source set on their input specification.
+func GenRecord(r *rand.Rand, session types.Session, recordSpec types.RecordSpecification) types.Record {
+	inputs := make([]types.RecordInput, len(recordSpec.Inputs))
+	for i, input := range recordSpec.Inputs {
+		switch source := input.Source.(type) {
+		case *types.InputSpecification_RecordId:
+			inputs[i] = *types.NewRecordInput(input.Name, &types.RecordInput_RecordId{RecordId: source.RecordId},
+				input.TypeName, types.RecordInputStatus_Record)
+		case *types.InputSpecification_Hash:
+			inputs[i] = *types.NewRecordInput(input.Name, &types.RecordInput_Hash{Hash: source.Hash},
+				input.TypeName, types.RecordInputStatus_Proposed)
+		default:
+			inputs[i] = *types.NewRecordInput(input.Name, &types.RecordInput_Hash{Hash: simtypes.RandStringOfLength(r, 32)},
+				input.TypeName, types.RecordInputStatus_Proposed)
+		}
+	}
+	outputs := make([]types.RecordOutput, 1)
+	if recordSpec.ResultType == types.DefinitionType_DEFINITION_TYPE_RECORD_LIST {
+		outputs = make([]types.RecordOutput, simtypes.RandIntBetween(r, 1, 4))
+	}
+	for i := range outputs {
+		outputs[i] = *types.NewRecordOutput(simtypes.RandStringOfLength(r, 32), types.ResultStatus_RESULT_STATUS_PASS)
+	}
+	return *types.NewRecord(
+		recordSpec.Name,
+		session.SessionId,
+		*types.NewProcess(
+			simtypes.RandStringOfLength(r, 10),
+			&types.Process_Hash{Hash: simtypes.RandStringOfLength(r, 32)},
+			simtypes.RandStringOfLength(r, 10),
+		),
+		inputs,
+		outputs,
+	)
+}
+
+// GenGenesisState returns a randomized, referentially consistent, set of specifications along with scopes that use
+// them, each with a session and records for the contract it was created under.
+func GenGenesisState(r *rand.Rand, accs []simtypes.Account) *types.GenesisState {
+	var (
+		scopes        []types.Scope
+		sessions      []types.Session
+		records       []types.Record
+		scopeSpecs    []types.ScopeSpecification
+		contractSpecs []types.ContractSpecification
+		recordSpecs   []types.RecordSpecification
+	)
+
+	recordSpecsByContract := make(map[string][]types.RecordSpecification)
+	for i := r.Intn(4); i > 0; i-- {
+		owner, _ := simtypes.RandomAcc(r, accs)
+		contractSpec := GenContractSpecification(r, owner)
+		contractSpecs = append(contractSpecs, contractSpec)
+		for j := r.Intn(3); j > 0; j-- {
+			recordSpec := GenRecordSpecification(r, contractSpec)
+			recordSpecs = append(recordSpecs, recordSpec)
+			recordSpecsByContract[contractSpec.SpecificationId.String()] = append(
+				recordSpecsByContract[contractSpec.SpecificationId.String()], recordSpec)
+		}
+	}
+
+	for i := r.Intn(3); i > 0 && len(contractSpecs) > 0; i-- {
+		owner, _ := simtypes.RandomAcc(r, accs)
+		contractSpec := contractSpecs[r.Intn(len(contractSpecs))]
+		scopeSpecs = append(scopeSpecs, GenScopeSpecification(r, owner, []types.MetadataAddress{contractSpec.SpecificationId}))
+	}
+
+	for i := r.Intn(4); i > 0; i-- {
+		if len(scopeSpecs) == 0 {
+			scopes = append(scopes, GenScope(r, accs, nil))
+			continue
+		}
+		scopeSpec := scopeSpecs[r.Intn(len(scopeSpecs))]
+		scope := GenScope(r, accs, &scopeSpec)
+		scopes = append(scopes, scope)
+
+		for _, contractSpec := range contractSpecs {
+			if !contractSpec.SpecificationId.Equals(scopeSpec.ContractSpecIds[0]) {
+				continue
+			}
+			session := GenSession(r, scope, contractSpec)
+			sessions = append(sessions, session)
+			for _, recordSpec := range recordSpecsByContract[contractSpec.SpecificationId.String()] {
+				records = append(records, GenRecord(r, session, recordSpec))
+			}
+		}
+	}
+
+	return types.NewGenesisState(types.DefaultParams(), scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs)
+}
+
+// RandomizedGenState generates a random GenesisState for metadata
+func RandomizedGenState(simState *module.SimulationState) {
+	metadataGenesis := GenGenesisState(simState.Rand, simState.Accounts)
+
+	bz, err := json.MarshalIndent(&metadataGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated metadata parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(metadataGenesis)
+}
@@ -0,0 +1,79 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/simulation"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// TestRandomizedGenState checks that the generated state can be imported and that every session and record refers to
+// a scope and specification that exists.
+func TestRandomizedGenState(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+
+	for seed := int64(1); seed <= 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			NumBonded:    3,
+			Accounts:     simtypes.RandomAccounts(r, 3),
+			InitialStake: 1000,
+			GenState:     make(map[string]json.RawMessage),
+		}
+
+		simulation.RandomizedGenState(&simState)
+
+		var genState types.GenesisState
+		cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genState)
+
+		app := simapp.Setup(false)
+		ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+		require.NotPanics(t, func() { app.MetadataKeeper.InitGenesis(ctx, &genState) }, "seed %d", seed)
+
+		for _, scope := range genState.Scopes {
+			if scope.SpecificationId.Empty() {
+				continue
+			}
+			_, found := app.MetadataKeeper.GetScopeSpecification(ctx, scope.SpecificationId)
+			require.True(t, found, "seed %d: scope %s specification", seed, scope.ScopeId)
+		}
+		for _, session := range genState.Sessions {
+			scopeID, err := session.SessionId.AsScopeAddress()
+			require.NoError(t, err)
+			_, found := app.MetadataKeeper.GetScope(ctx, scopeID)
+			require.True(t, found, "seed %d: session %s scope", seed, session.SessionId)
+			_, found = app.MetadataKeeper.GetContractSpecification(ctx, session.SpecificationId)
+			require.True(t, found, "seed %d: session %s specification", seed, session.SessionId)
+		}
+		for _, record := range genState.Records {
+			_, found := app.MetadataKeeper.GetSession(ctx, record.SessionId)
+			require.True(t, found, "seed %d: record %s session", seed, record.Name)
+			require.NoError(t, record.ValidateBasic(), "seed %d", seed)
+		}
+		for _, scope := range genState.Scopes {
+			for _, party := range scope.Owners {
+				addr, err := sdk.AccAddressFromBech32(party.Address)
+				require.NoError(t, err)
+				var indexed bool
+				require.NoError(t, app.MetadataKeeper.IterateScopesForAddress(ctx, addr, func(id types.MetadataAddress) bool {
+					indexed = indexed || id.Equals(scope.ScopeId)
+					return indexed
+				}))
+				require.True(t, indexed, "seed %d: scope %s owner index", seed, scope.ScopeId)
+			}
+		}
+	}
+}
@@ -0,0 +1,574 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgAddScopeSpecification       = "op_weight_msg_add_scope_specification"
+	OpWeightMsgDeleteScopeSpecification    = "op_weight_msg_delete_scope_specification"
+	OpWeightMsgAddContractSpecification    = "op_weight_msg_add_contract_specification"
+	OpWeightMsgDeleteContractSpecification = "op_weight_msg_delete_contract_specification"
+	OpWeightMsgAddRecordSpecification      = "op_weight_msg_add_record_specification"
+	OpWeightMsgDeleteRecordSpecification   = "op_weight_msg_delete_record_specification"
+	OpWeightMsgAddScope                    = "op_weight_msg_add_scope"
+	OpWeightMsgDeleteScope                 = "op_weight_msg_delete_scope"
+	OpWeightMsgAddSession                  = "op_weight_msg_add_session"
+	OpWeightMsgAddRecord                   = "op_weight_msg_add_record"
+	OpWeightMsgDeleteRecord                = "op_weight_msg_delete_record"
+)
+
+// Default simulation operation weights, specifications are created often enough for scopes, sessions, and records to
+// find one to be recorded against.
+const (
+	DefaultWeightMsgAddScopeSpecification       = 50
+	DefaultWeightMsgDeleteScopeSpecification    = 10
+	DefaultWeightMsgAddContractSpecification    = 50
+	DefaultWeightMsgDeleteContractSpecification = 10
+	DefaultWeightMsgAddRecordSpecification      = 50
+	DefaultWeightMsgDeleteRecordSpecification   = 10
+	DefaultWeightMsgAddScope                    = 100
+	DefaultWeightMsgDeleteScope                 = 20
+	DefaultWeightMsgAddSession                  = 80
+	DefaultWeightMsgAddRecord                   = 80
+	DefaultWeightMsgDeleteRecord                = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler, k keeper.Keeper, ak authkeeper.AccountKeeper,
+) simulation.WeightedOperations {
+	weight := func(key string, defaultWeight int) int {
+		var w int
+		appParams.GetOrGenerate(cdc, key, &w, nil, func(_ *rand.Rand) { w = defaultWeight })
+		return w
+	}
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddScopeSpecification, DefaultWeightMsgAddScopeSpecification),
+			SimulateMsgAddScopeSpecification(k, ak),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgDeleteScopeSpecification, DefaultWeightMsgDeleteScopeSpecification),
+			SimulateMsgDeleteScopeSpecification(k, ak),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddContractSpecification, DefaultWeightMsgAddContractSpecification),
+			SimulateMsgAddContractSpecification(k, ak),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgDeleteContractSpecification, DefaultWeightMsgDeleteContractSpecification),
+			SimulateMsgDeleteContractSpecification(k, ak),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddRecordSpecification, DefaultWeightMsgAddRecordSpecification),
+			SimulateMsgAddRecordSpecification(k, ak),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgDeleteRecordSpecification, DefaultWeightMsgDeleteRecordSpecification),
+			SimulateMsgDeleteRecordSpecification(k, ak),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddScope, DefaultWeightMsgAddScope),
+			SimulateMsgAddScope(k, ak),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgDeleteScope, DefaultWeightMsgDeleteScope),
+			SimulateMsgDeleteScope(k, ak),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddSession, DefaultWeightMsgAddSession),
+			SimulateMsgAddSession(k, ak),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddRecord, DefaultWeightMsgAddRecord),
+			SimulateMsgAddRecord(k, ak),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgDeleteRecord, DefaultWeightMsgDeleteRecord),
+			SimulateMsgDeleteRecord(k, ak),
+		),
+	}
+}
+
+// SimulateMsgAddScopeSpecification creates a scope specification owned by a random account that allows up to two of
+// the existing contract specifications.
+func SimulateMsgAddScopeSpecification(k keeper.Keeper, ak authkeeper.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+
+		var contractSpecIDs []types.MetadataAddress
+		for i := r.Intn(3); i > 0; i-- {
+			if contractSpec, found := randomContractSpecification(r, ctx, k); found {
+				contractSpecIDs = append(contractSpecIDs, contractSpec.SpecificationId)
+			}
+		}
+		spec := GenScopeSpecification(r, owner, contractSpecIDs)
+		if err := k.ValidateScopeSpecUpdate(ctx, nil, spec); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddScopeSpecificationRequest, err.Error()), nil, nil
+		}
+
+		msg := &types.MsgAddScopeSpecificationRequest{Specification: spec, Signers: spec.OwnerAddresses}
+		return Dispatch(r, app, ctx, ak, chainID, msg, owner)
+	}
+}
+
+// SimulateMsgDeleteScopeSpecification removes a random scope specification that is not used by any scope.
+func SimulateMsgDeleteScopeSpecification(k keeper.Keeper, ak authkeeper.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var specs []types.ScopeSpecification
+		if err := k.IterateScopeSpecs(ctx, func(spec types.ScopeSpecification) bool {
+			used := false
+			if err := k.IterateScopesForScopeSpec(ctx, spec.SpecificationId, func(types.MetadataAddress) bool {
+				used = true
+				return true
+			}); err == nil && !used {
+				specs = append(specs, spec)
+			}
+			return false
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteScopeSpecificationRequest, err.Error()), nil, nil
+		}
+		if len(specs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteScopeSpecificationRequest, "no unused scope specifications"), nil, nil
+		}
+		spec := specs[r.Intn(len(specs))]
+		signers, found := findAccounts(accs, spec.OwnerAddresses)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteScopeSpecificationRequest, "owner is not a simulation account"), nil, nil
+		}
+
+		msg := &types.MsgDeleteScopeSpecificationRequest{SpecificationId: spec.SpecificationId, Signers: spec.OwnerAddresses}
+		return Dispatch(r, app, ctx, ak, chainID, msg, signers...)
+	}
+}
+
+// SimulateMsgAddContractSpecification creates a contract specification owned by a random account.
+func SimulateMsgAddContractSpecification(k keeper.Keeper, ak authkeeper.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+		spec := GenContractSpecification(r, owner)
+		if err := k.ValidateContractSpecUpdate(ctx, nil, spec); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddContractSpecificationRequest, err.Error()), nil, nil
+		}
+
+		msg := &types.MsgAddContractSpecificationRequest{Specification: spec, Signers: spec.OwnerAddresses}
+		return Dispatch(r, app, ctx, ak, chainID, msg, owner)
+	}
+}
+
+// SimulateMsgDeleteContractSpecification removes a random contract specification, along with its record
+// specifications, that is not allowed by any scope specification.
+func SimulateMsgDeleteContractSpecification(k keeper.Keeper, ak authkeeper.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var specs []types.ContractSpecification
+		if err := k.IterateContractSpecs(ctx, func(spec types.ContractSpecification) bool {
+			used := false
+			if err := k.IterateScopeSpecsForContractSpec(ctx, spec.SpecificationId, func(types.MetadataAddress) bool {
+				used = true
+				return true
+			}); err == nil && !used {
+				specs = append(specs, spec)
+			}
+			return false
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteContractSpecificationRequest, err.Error()), nil, nil
+		}
+		if len(specs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteContractSpecificationRequest, "no unused contract specifications"), nil, nil
+		}
+		spec := specs[r.Intn(len(specs))]
+		signers, found := findAccounts(accs, spec.OwnerAddresses)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteContractSpecificationRequest, "owner is not a simulation account"), nil, nil
+		}
+
+		msg := &types.MsgDeleteContractSpecificationRequest{SpecificationId: spec.SpecificationId, Signers: spec.OwnerAddresses}
+		return Dispatch(r, app, ctx, ak, chainID, msg, signers...)
+	}
+}
+
+// SimulateMsgAddRecordSpecification adds a record specification to a random contract specification.
+func SimulateMsgAddRecordSpecification(k keeper.Keeper, ak authkeeper.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contractSpec, found := randomContractSpecification(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRecordSpecificationRequest, "no contract specifications"), nil, nil
+		}
+		signers, found := findAccounts(accs, contractSpec.OwnerAddresses)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRecordSpecificationRequest, "owner is not a simulation account"), nil, nil
+		}
+		spec := GenRecordSpecification(r, contractSpec)
+		var existing *types.RecordSpecification
+		if e, found := k.GetRecordSpecification(ctx, spec.SpecificationId); found {
+			existing = &e
+		}
+		if err := k.ValidateRecordSpecUpdate(ctx, existing, spec); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRecordSpecificationRequest, err.Error()), nil, nil
+		}
+
+		msg := &types.MsgAddRecordSpecificationRequest{Specification: spec, Signers: contractSpec.OwnerAddresses}
+		return Dispatch(r, app, ctx, ak, chainID, msg, signers...)
+	}
+}
+
+// SimulateMsgDeleteRecordSpecification removes a random record specification.
+func SimulateMsgDeleteRecordSpecification(k keeper.Keeper, ak authkeeper.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var specs []types.RecordSpecification
+		if err := k.IterateRecordSpecs(ctx, func(spec types.RecordSpecification) bool {
+			specs = append(specs, spec)
+			return false
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteRecordSpecificationRequest, err.Error()), nil, nil
+		}
+		if len(specs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteRecordSpecificationRequest, "no record specifications"), nil, nil
+		}
+		spec := specs[r.Intn(len(specs))]
+		contractSpecID, err := spec.SpecificationId.AsContractSpecAddress()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteRecordSpecificationRequest, err.Error()), nil, nil
+		}
+		contractSpec, found := k.GetContractSpecification(ctx, contractSpecID)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteRecordSpecificationRequest, "contract specification not found"), nil, nil
+		}
+		signers, found := findAccounts(accs, contractSpec.OwnerAddresses)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteRecordSpecificationRequest, "owner is not a simulation account"), nil, nil
+		}
+
+		msg := &types.MsgDeleteRecordSpecificationRequest{SpecificationId: spec.SpecificationId, Signers: contractSpec.OwnerAddresses}
+		return Dispatch(r, app, ctx, ak, chainID, msg, signers...)
+	}
+}
+
+// SimulateMsgAddScope creates a scope owned by random accounts, half of the time under a random scope specification.
+func SimulateMsgAddScope(k keeper.Keeper, ak authkeeper.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var scopeSpec *types.ScopeSpecification
+		if r.Intn(2) == 0 {
+			if spec, found := randomScopeSpecification(r, ctx, k); found {
+				scopeSpec = &spec
+			}
+		}
+		scope := GenScope(r, accs, scopeSpec)
+		signers, found := scopeOwners(accs, scope)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddScopeRequest, "owner is not a simulation account"), nil, nil
+		}
+		existing, _ := k.GetScope(ctx, scope.ScopeId)
+		if err := k.ValidateScopeUpdate(ctx, existing, scope, accountAddresses(signers)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddScopeRequest, err.Error()), nil, nil
+		}
+
+		msg := types.NewMsgAddScopeRequest(scope, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, chainID, msg, signers...)
+	}
+}
+
+// SimulateMsgDeleteScope removes a random scope, and the records within it, signed by all of its owners.
+func SimulateMsgDeleteScope(k keeper.Keeper, ak authkeeper.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		scope, found := randomScope(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteScopeRequest, "no scopes"), nil, nil
+		}
+		signers, found := scopeOwners(accs, scope)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteScopeRequest, "owner is not a simulation account"), nil, nil
+		}
+		if err := k.ValidateScopeRemove(ctx, scope, types.Scope{ScopeId: scope.ScopeId}, accountAddresses(signers)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteScopeRequest, err.Error()), nil, nil
+		}
+
+		msg := types.NewMsgDeleteScopeRequest(scope.ScopeId, accountAddresses(signers))
+		return Dispatch(r, app, ctx, ak, chainID, msg, signers...)
+	}
+}
+
+// SimulateMsgAddSession starts a session in a random scope for a contract specification the scope allows.
+func SimulateMsgAddSession(k keeper.Keeper, ak authkeeper.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		scope, found := randomScope(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSessionRequest, "no scopes"), nil, nil
+		}
+		signers, found := scopeOwners(accs, scope)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSessionRequest, "owner is not a simulation account"), nil, nil
+		}
+
+		var contractSpec types.ContractSpecification
+		if scope.SpecificationId.Empty() {
+			contractSpec, found = randomContractSpecification(r, ctx, k)
+		} else if scopeSpec, ok := k.GetScopeSpecification(ctx, scope.SpecificationId); ok && len(scopeSpec.ContractSpecIds) > 0 {
+			contractSpec, found = k.GetContractSpecification(ctx, scopeSpec.ContractSpecIds[r.Intn(len(scopeSpec.ContractSpecIds))])
+		} else {
+			found = false
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSessionRequest, "no contract specification for scope"), nil, nil
+		}
+
+		session := GenSession(r, scope, contractSpec)
+		if err := k.ValidateSessionUpdate(ctx, types.Session{}, session, accountAddresses(signers)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSessionRequest, err.Error()), nil, nil
+		}
+
+		msg := &types.MsgAddSessionRequest{Session: &session, Signers: accountAddresses(signers)}
+		return Dispatch(r, app, ctx, ak, chainID, msg, signers...)
+	}
+}
+
+// SimulateMsgAddRecord records the result of a random record specification of the contract a random session was
+// started for.
+func SimulateMsgAddRecord(k keeper.Keeper, ak authkeeper.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var sessions []types.Session
+		if err := k.IterateSessions(ctx, types.MetadataAddress{}, func(session types.Session) bool {
+			sessions = append(sessions, session)
+			return false
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRecordRequest, err.Error()), nil, nil
+		}
+		if len(sessions) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRecordRequest, "no sessions"), nil, nil
+		}
+		session := sessions[r.Intn(len(sessions))]
+
+		scopeID, err := session.SessionId.AsScopeAddress()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRecordRequest, err.Error()), nil, nil
+		}
+		scope, found := k.GetScope(ctx, scopeID)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRecordRequest, "scope for session not found"), nil, nil
+		}
+		signers, found := scopeOwners(accs, scope)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRecordRequest, "owner is not a simulation account"), nil, nil
+		}
+		recordSpecs, err := k.GetRecordSpecificationsForContractSpecificationID(ctx, session.SpecificationId)
+		if err != nil || len(recordSpecs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRecordRequest, "no record specifications for session"), nil, nil
+		}
+
+		record := GenRecord(r, session, *recordSpecs[r.Intn(len(recordSpecs))])
+		recordID, err := scopeID.AsRecordAddress(record.Name)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRecordRequest, err.Error()), nil, nil
+		}
+		existing, _ := k.GetRecord(ctx, recordID)
+		if err := k.ValidateRecordUpdate(ctx, existing, record, accountAddresses(signers)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddRecordRequest, err.Error()), nil, nil
+		}
+
+		msg := &types.MsgAddRecordRequest{SessionId: session.SessionId, Record: &record, Signers: accountAddresses(signers)}
+		return Dispatch(r, app, ctx, ak, chainID, msg, signers...)
+	}
+}
+
+// SimulateMsgDeleteRecord removes a random record signed by all owners of its scope.
+func SimulateMsgDeleteRecord(k keeper.Keeper, ak authkeeper.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var records []types.Record
+		if err := k.IterateRecords(ctx, types.MetadataAddress{}, func(record types.Record) bool {
+			records = append(records, record)
+			return false
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteRecordRequest, err.Error()), nil, nil
+		}
+		if len(records) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteRecordRequest, "no records"), nil, nil
+		}
+		record := records[r.Intn(len(records))]
+
+		scopeID, err := record.SessionId.AsScopeAddress()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteRecordRequest, err.Error()), nil, nil
+		}
+		scope, found := k.GetScope(ctx, scopeID)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteRecordRequest, "scope for record not found"), nil, nil
+		}
+		signers, found := scopeOwners(accs, scope)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteRecordRequest, "owner is not a simulation account"), nil, nil
+		}
+		recordID, err := scopeID.AsRecordAddress(record.Name)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteRecordRequest, err.Error()), nil, nil
+		}
+		if err := k.ValidateRecordRemove(ctx, record, recordID, accountAddresses(signers)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteRecordRequest, err.Error()), nil, nil
+		}
+
+		msg := &types.MsgDeleteRecordRequest{RecordId: recordID, Signers: accountAddresses(signers)}
+		return Dispatch(r, app, ctx, ak, chainID, msg, signers...)
+	}
+}
+
+// Dispatch sends an operation to the chain using a given account/funds on account for fees.  Failures on the server side
+// are handled as no-op msg operations with the error string as the status/response.
+func Dispatch(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak authkeeper.AccountKeeper,
+	chainID string,
+	msg sdk.Msg,
+	signers ...simtypes.Account,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	accountNumbers := make([]uint64, len(signers))
+	sequences := make([]uint64, len(signers))
+	privKeys := make([]cryptotypes.PrivKey, len(signers))
+	for i, signer := range signers {
+		account := ak.GetAccount(ctx, signer.Address)
+		if account == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "signer account not found"), nil, nil
+		}
+		accountNumbers[i] = account.GetAccountNumber()
+		sequences[i] = account.GetSequence()
+		privKeys[i] = signer.PrivKey
+	}
+
+	// metadata transactions are sent without fees as the module has no access to balances
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		sdk.NewCoins(),
+		helpers.DefaultGenTxGas,
+		chainID,
+		accountNumbers,
+		sequences,
+		privKeys...,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
+
+// randomScope returns a random scope from the store.
+func randomScope(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.Scope, bool) {
+	var scopes []types.Scope
+	if err := k.IterateScopes(ctx, func(scope types.Scope) bool {
+		scopes = append(scopes, scope)
+		return false
+	}); err != nil || len(scopes) == 0 {
+		return types.Scope{}, false
+	}
+	return scopes[r.Intn(len(scopes))], true
+}
+
+// randomScopeSpecification returns a random scope specification from the store.
+func randomScopeSpecification(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.ScopeSpecification, bool) {
+	var specs []types.ScopeSpecification
+	if err := k.IterateScopeSpecs(ctx, func(spec types.ScopeSpecification) bool {
+		specs = append(specs, spec)
+		return false
+	}); err != nil || len(specs) == 0 {
+		return types.ScopeSpecification{}, false
+	}
+	return specs[r.Intn(len(specs))], true
+}
+
+// randomContractSpecification returns a random contract specification from the store.
+func randomContractSpecification(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.ContractSpecification, bool) {
+	var specs []types.ContractSpecification
+	if err := k.IterateContractSpecs(ctx, func(spec types.ContractSpecification) bool {
+		specs = append(specs, spec)
+		return false
+	}); err != nil || len(specs) == 0 {
+		return types.ContractSpecification{}, false
+	}
+	return specs[r.Intn(len(specs))], true
+}
+
+// scopeOwners returns the distinct simulation accounts of the scope owners.
+func scopeOwners(accs []simtypes.Account, scope types.Scope) ([]simtypes.Account, bool) {
+	addresses := make([]string, len(scope.Owners))
+	for i, owner := range scope.Owners {
+		addresses[i] = owner.Address
+	}
+	return findAccounts(accs, addresses)
+}
+
+// findAccounts returns the distinct simulation accounts for the addresses, false if any of them is not a simulation
+// account.
+func findAccounts(accs []simtypes.Account, addresses []string) ([]simtypes.Account, bool) {
+	var found []simtypes.Account
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		if seen[address] {
+			continue
+		}
+		seen[address] = true
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, false
+		}
+		acc, ok := simtypes.FindAccount(accs, addr)
+		if !ok {
+			return nil, false
+		}
+		found = append(found, acc)
+	}
+	return found, len(found) > 0
+}
+
+// accountAddresses returns the bech32 addresses of the accounts.
+func accountAddresses(accs []simtypes.Account) []string {
+	addresses := make([]string, len(accs))
+	for i, acc := range accs {
+		addresses[i] = acc.Address.String()
+	}
+	return addresses
+}
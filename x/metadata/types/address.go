@@ -140,6 +140,60 @@ func MetadataAddressFromBech32(address string) (addr MetadataAddress, err error)
 	return MetadataAddress(bz), nil
 }
 
+// parseScopeID returns the scope MetadataAddress for a value given as either a bech32 scope address or a scope uuid.
+func parseScopeID(scopeID string) (MetadataAddress, error) {
+	if addr, err := MetadataAddressFromBech32(scopeID); err == nil {
+		if !addr.IsScopeAddress() {
+			return MetadataAddress{}, fmt.Errorf("invalid scope id %s: not a scope address", scopeID)
+		}
+		return addr, nil
+	}
+	scopeUUID, err := uuid.Parse(strings.TrimSpace(scopeID))
+	if err != nil {
+		return MetadataAddress{}, fmt.Errorf("invalid scope id %s: expected a scope address or uuid", scopeID)
+	}
+	return ScopeMetadataAddress(scopeUUID), nil
+}
+
+// parseScopeSpecID returns the scope specification MetadataAddress for a value given as either a bech32 scope
+// specification address or a scope specification uuid.
+func parseScopeSpecID(scopeSpecID string) (MetadataAddress, error) {
+	if addr, err := MetadataAddressFromBech32(scopeSpecID); err == nil {
+		if !addr.IsScopeSpecificationAddress() {
+			return MetadataAddress{}, fmt.Errorf("invalid scope specification id %s: not a scope specification address", scopeSpecID)
+		}
+		return addr, nil
+	}
+	specUUID, err := uuid.Parse(strings.TrimSpace(scopeSpecID))
+	if err != nil {
+		return MetadataAddress{}, fmt.Errorf("invalid scope specification id %s: expected a scope specification address or uuid", scopeSpecID)
+	}
+	return ScopeSpecMetadataAddress(specUUID), nil
+}
+
+// parseSessionID returns the session MetadataAddress for a value given as either a bech32 session address or a
+// session uuid within the provided scope.  Session addresses must belong to the provided scope.
+func parseSessionID(scopeID MetadataAddress, sessionID string) (MetadataAddress, error) {
+	scopeUUID, err := scopeID.ScopeUUID()
+	if err != nil {
+		return MetadataAddress{}, err
+	}
+	if addr, err := MetadataAddressFromBech32(sessionID); err == nil {
+		if !addr.IsSessionAddress() {
+			return MetadataAddress{}, fmt.Errorf("invalid session id %s: not a session address", sessionID)
+		}
+		if sessionScopeUUID, _ := addr.ScopeUUID(); sessionScopeUUID != scopeUUID {
+			return MetadataAddress{}, fmt.Errorf("session %s is not part of scope %s", sessionID, scopeID)
+		}
+		return addr, nil
+	}
+	sessionUUID, err := uuid.Parse(strings.TrimSpace(sessionID))
+	if err != nil {
+		return MetadataAddress{}, fmt.Errorf("invalid session id %s: expected a session address or uuid", sessionID)
+	}
+	return SessionMetadataAddress(scopeUUID, sessionUUID), nil
+}
+
 // ScopeMetadataAddress creates a MetadataAddress instance for the given scope by its uuid
 func ScopeMetadataAddress(scopeUUID uuid.UUID) MetadataAddress {
 	bz, err := scopeUUID.MarshalBinary()
@@ -16,6 +16,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeleteScopeRequest{}, "provenance/metadata/DeleteScopeRequest", nil)
 	cdc.RegisterConcrete(&MsgAddSessionRequest{}, "provenance/metadata/AddSessionRequest", nil)
 	cdc.RegisterConcrete(&MsgAddRecordRequest{}, "provenance/metadata/AddRecordRequest", nil)
+	cdc.RegisterConcrete(&MsgDeleteRecordRequest{}, "provenance/metadata/DeleteRecordRequest", nil)
 	cdc.RegisterConcrete(&MsgAddScopeSpecificationRequest{}, "provenance/metadata/AddScopeSpecificationRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteScopeSpecificationRequest{}, "provenance/metadata/DeleteScopeSpecificationRequest", nil)
 	cdc.RegisterConcrete(&MsgAddContractSpecificationRequest{}, "provenance/metadata/AddContractSpecificationRequest", nil)
@@ -23,6 +24,21 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddRecordSpecificationRequest{}, "provenance/metadata/AddRecordSpecificationRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteRecordSpecificationRequest{}, "provenance/metadata/DeleteRecordSpecificationRequest", nil)
 	cdc.RegisterConcrete(&MsgAddP8EContractSpecRequest{}, "provenance/metadata/AddP8EContractSpecRequest", nil)
+	cdc.RegisterConcrete(&MsgP8EMemorializeContractRequest{}, "provenance/metadata/P8EMemorializeContractRequest", nil)
+
+	// oneof fields are interfaces to amino, the msg sign bytes can not be encoded without them.
+	cdc.RegisterInterface((*isContractSpecification_Source)(nil), nil)
+	cdc.RegisterConcrete(&ContractSpecification_ResourceId{}, "provenance/metadata/ContractSpecificationResourceId", nil)
+	cdc.RegisterConcrete(&ContractSpecification_Hash{}, "provenance/metadata/ContractSpecificationHash", nil)
+	cdc.RegisterInterface((*isInputSpecification_Source)(nil), nil)
+	cdc.RegisterConcrete(&InputSpecification_RecordId{}, "provenance/metadata/InputSpecificationRecordId", nil)
+	cdc.RegisterConcrete(&InputSpecification_Hash{}, "provenance/metadata/InputSpecificationHash", nil)
+	cdc.RegisterInterface((*isProcess_ProcessId)(nil), nil)
+	cdc.RegisterConcrete(&Process_Address{}, "provenance/metadata/ProcessAddress", nil)
+	cdc.RegisterConcrete(&Process_Hash{}, "provenance/metadata/ProcessHash", nil)
+	cdc.RegisterInterface((*isRecordInput_Source)(nil), nil)
+	cdc.RegisterConcrete(&RecordInput_RecordId{}, "provenance/metadata/RecordInputRecordId", nil)
+	cdc.RegisterConcrete(&RecordInput_Hash{}, "provenance/metadata/RecordInputHash", nil)
 }
 
 // RegisterInterfaces registers implementations for the tx messages
@@ -34,6 +50,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDeleteScopeRequest{},
 		&MsgAddSessionRequest{},
 		&MsgAddRecordRequest{},
+		&MsgDeleteRecordRequest{},
 		&MsgAddScopeSpecificationRequest{},
 		&MsgDeleteScopeSpecificationRequest{},
 		&MsgAddContractSpecificationRequest{},
@@ -41,6 +58,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAddRecordSpecificationRequest{},
 		&MsgDeleteRecordSpecificationRequest{},
 		&MsgAddP8EContractSpecRequest{},
+		&MsgP8EMemorializeContractRequest{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
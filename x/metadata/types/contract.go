@@ -57,7 +57,7 @@ func (contract Contract) ValidateBasic() error {
 
 // GetSigners returns the required signers for a given contract
 func (contract Contract) GetSigners() (signers []sdk.AccAddress) {
-	signers = make([]sdk.AccAddress, 0, len(contract.Recitals.Parties))
+	signers = make([]sdk.AccAddress, len(contract.Recitals.Parties))
 
 	for i, p := range contract.Recitals.Parties {
 		addr, err := sdk.AccAddressFromBech32(p.Address)
@@ -68,3 +68,89 @@ func (contract Contract) GetSigners() (signers []sdk.AccAddress) {
 	}
 	return
 }
+
+// ContractSpecID returns the id of the contract specification this contract was executed against.  The id is
+// derived from the spec reference hash in the same way ConvertP8eContractSpec derives it for p8e specifications.
+func (contract Contract) ContractSpecID() (MetadataAddress, error) {
+	return ConvertHashToAddress(ContractSpecificationKeyPrefix, contract.Spec.Reference.Hash)
+}
+
+// Records converts the executed considerations of the contract into records attached to the given session.  Skipped
+// considerations did not produce an output and are not included.
+func (contract Contract) Records(sessionID MetadataAddress) ([]Record, error) {
+	records := []Record{}
+	for _, c := range contract.Considerations {
+		if c.Result.Result == ResultType_RESULT_TYPE_SKIP {
+			continue
+		}
+		name := c.Result.Output.Name
+		if len(strings.TrimSpace(name)) == 0 {
+			name = c.ConsiderationName
+		}
+		inputs := make([]RecordInput, len(c.Inputs))
+		for i, in := range c.Inputs {
+			input, err := in.RecordInput()
+			if err != nil {
+				return nil, fmt.Errorf("invalid input %s on consideration %s: %w", in.Name, c.ConsiderationName, err)
+			}
+			inputs[i] = input
+		}
+		process := Process{
+			ProcessId: &Process_Hash{Hash: contract.Spec.Reference.Hash},
+			Name:      contract.Definition.Name,
+			Method:    c.ConsiderationName,
+		}
+		outputs := []RecordOutput{{Hash: c.Result.Output.Hash, Status: ResultStatus(c.Result.Result)}}
+		records = append(records, *NewRecord(name, sessionID, process, inputs, outputs))
+	}
+	return records, nil
+}
+
+// NewContractExecution creates the message the contract parties sign for a contract executed in the given session.
+func NewContractExecution(scopeID, sessionID MetadataAddress, executionID string, contract Contract) *ContractExecution {
+	return &ContractExecution{
+		ScopeId:     scopeID,
+		SessionId:   sessionID,
+		ExecutionId: executionID,
+		Contract:    &contract,
+	}
+}
+
+// NewRecitalsExecution creates the message the parties sign for an ownership change to the recitals made without a
+// contract.
+func NewRecitalsExecution(scopeID MetadataAddress, executionID string, recitals Recitals) *ContractExecution {
+	return &ContractExecution{
+		ScopeId:     scopeID,
+		ExecutionId: executionID,
+		Recitals:    &recitals,
+	}
+}
+
+// AsParties returns the recitals as a list of parties
+func (r Recitals) AsParties() []Party {
+	parties := make([]Party, 0, len(r.Parties))
+	for _, p := range r.Parties {
+		if p == nil {
+			continue
+		}
+		parties = append(parties, Party{Address: p.Address, Role: p.SignerRole})
+	}
+	return parties
+}
+
+// RecordInput converts the proposed record into a record input.  Proposed records with an ancestor reference are
+// treated as inputs from an existing record, all others as proposed (hashed) values.
+func (pr ProposedRecord) RecordInput() (RecordInput, error) {
+	if len(strings.TrimSpace(pr.Ancestor.ScopeId)) > 0 && len(strings.TrimSpace(pr.Ancestor.Name)) > 0 {
+		scopeID, err := parseScopeID(pr.Ancestor.ScopeId)
+		if err != nil {
+			return RecordInput{}, err
+		}
+		recordID, err := scopeID.AsRecordAddress(pr.Ancestor.Name)
+		if err != nil {
+			return RecordInput{}, err
+		}
+		return *NewRecordInput(pr.Name, &RecordInput_RecordId{RecordId: recordID}, pr.TypeName, RecordInputStatus_Record), nil
+	}
+	return *NewRecordInput(pr.Name, &RecordInput_Hash{Hash: pr.Hash}, pr.TypeName, RecordInputStatus_Proposed), nil
+}
@@ -163,6 +163,76 @@ func (m *Contract) GetRecitals() Recitals {
 	return Recitals{}
 }
 
+// ContractExecution is the message signed by the parties of a contract execution.  It binds the executed contract, or
+// the recitals of an ownership change, to the scope, session, and execution it is recorded under so the signatures
+// cannot be replayed against another scope, session, or execution.
+type ContractExecution struct {
+	// The scope the execution is recorded in.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// The session the execution is recorded as, empty for a recitals only ownership change.
+	SessionId MetadataAddress `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3,customtype=MetadataAddress" json:"session_id" yaml:"session_id"`
+	// Unique identifier of the execution instance.
+	ExecutionId string `protobuf:"bytes,3,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty" yaml:"execution_id"`
+	// The executed contract.
+	Contract *Contract `protobuf:"bytes,4,opt,name=contract,proto3" json:"contract,omitempty"`
+	// The recitals of an ownership change made without a contract.
+	Recitals *Recitals `protobuf:"bytes,5,opt,name=recitals,proto3" json:"recitals,omitempty"`
+}
+
+func (m *ContractExecution) Reset()         { *m = ContractExecution{} }
+func (m *ContractExecution) String() string { return proto.CompactTextString(m) }
+func (*ContractExecution) ProtoMessage()    {}
+func (*ContractExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84557edfb3e1481c, []int{1}
+}
+func (m *ContractExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractExecution.Merge(m, src)
+}
+func (m *ContractExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractExecution proto.InternalMessageInfo
+
+func (m *ContractExecution) GetExecutionId() string {
+	if m != nil {
+		return m.ExecutionId
+	}
+	return ""
+}
+
+func (m *ContractExecution) GetContract() *Contract {
+	if m != nil {
+		return m.Contract
+	}
+	return nil
+}
+
+func (m *ContractExecution) GetRecitals() *Recitals {
+	if m != nil {
+		return m.Recitals
+	}
+	return nil
+}
+
 // Recitals is a list of recital
 type Recitals struct {
 	Parties []*Recital `protobuf:"bytes,1,rep,name=parties,proto3" json:"parties,omitempty"`
@@ -172,7 +242,7 @@ func (m *Recitals) Reset()         { *m = Recitals{} }
 func (m *Recitals) String() string { return proto.CompactTextString(m) }
 func (*Recitals) ProtoMessage()    {}
 func (*Recitals) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84557edfb3e1481c, []int{1}
+	return fileDescriptor_84557edfb3e1481c, []int{2}
 }
 func (m *Recitals) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,7 +290,7 @@ func (m *RecordReference) Reset()         { *m = RecordReference{} }
 func (m *RecordReference) String() string { return proto.CompactTextString(m) }
 func (*RecordReference) ProtoMessage()    {}
 func (*RecordReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84557edfb3e1481c, []int{2}
+	return fileDescriptor_84557edfb3e1481c, []int{3}
 }
 func (m *RecordReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +345,7 @@ func (m *Condition) Reset()         { *m = Condition{} }
 func (m *Condition) String() string { return proto.CompactTextString(m) }
 func (*Condition) ProtoMessage()    {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84557edfb3e1481c, []int{3}
+	return fileDescriptor_84557edfb3e1481c, []int{4}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -331,7 +401,7 @@ func (m *Consideration) Reset()         { *m = Consideration{} }
 func (m *Consideration) String() string { return proto.CompactTextString(m) }
 func (*Consideration) ProtoMessage()    {}
 func (*Consideration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84557edfb3e1481c, []int{4}
+	return fileDescriptor_84557edfb3e1481c, []int{5}
 }
 func (m *Consideration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,7 +463,7 @@ func (m *Recital) Reset()         { *m = Recital{} }
 func (m *Recital) String() string { return proto.CompactTextString(m) }
 func (*Recital) ProtoMessage()    {}
 func (*Recital) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84557edfb3e1481c, []int{5}
+	return fileDescriptor_84557edfb3e1481c, []int{6}
 }
 func (m *Recital) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +523,7 @@ func (m *ProposedRecord) Reset()         { *m = ProposedRecord{} }
 func (m *ProposedRecord) String() string { return proto.CompactTextString(m) }
 func (*ProposedRecord) ProtoMessage()    {}
 func (*ProposedRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84557edfb3e1481c, []int{6}
+	return fileDescriptor_84557edfb3e1481c, []int{7}
 }
 func (m *ProposedRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,7 +596,7 @@ func (m *ExecutionResult) Reset()         { *m = ExecutionResult{} }
 func (m *ExecutionResult) String() string { return proto.CompactTextString(m) }
 func (*ExecutionResult) ProtoMessage()    {}
 func (*ExecutionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84557edfb3e1481c, []int{7}
+	return fileDescriptor_84557edfb3e1481c, []int{8}
 }
 func (m *ExecutionResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +669,7 @@ func (m *Definition) Reset()         { *m = Definition{} }
 func (m *Definition) String() string { return proto.CompactTextString(m) }
 func (*Definition) ProtoMessage()    {}
 func (*Definition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84557edfb3e1481c, []int{8}
+	return fileDescriptor_84557edfb3e1481c, []int{9}
 }
 func (m *Definition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +744,7 @@ func (m *Reference) Reset()         { *m = Reference{} }
 func (m *Reference) String() string { return proto.CompactTextString(m) }
 func (*Reference) ProtoMessage()    {}
 func (*Reference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84557edfb3e1481c, []int{9}
+	return fileDescriptor_84557edfb3e1481c, []int{10}
 }
 func (m *Reference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -741,6 +811,7 @@ func (m *Reference) GetTypeName() string {
 func init() {
 	proto.RegisterEnum("provenance.metadata.v1.ResultType", ResultType_name, ResultType_value)
 	proto.RegisterType((*Contract)(nil), "provenance.metadata.v1.Contract")
+	proto.RegisterType((*ContractExecution)(nil), "provenance.metadata.v1.ContractExecution")
 	proto.RegisterType((*Recitals)(nil), "provenance.metadata.v1.Recitals")
 	proto.RegisterType((*RecordReference)(nil), "provenance.metadata.v1.RecordReference")
 	proto.RegisterType((*Condition)(nil), "provenance.metadata.v1.Condition")
@@ -757,65 +828,72 @@ func init() {
 }
 
 var fileDescriptor_84557edfb3e1481c = []byte{
-	// 913 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0xdb, 0x36,
-	0x14, 0xb6, 0x62, 0xd7, 0x3f, 0x5e, 0x16, 0xc7, 0x25, 0x8a, 0x4d, 0x4d, 0x01, 0x3b, 0xd5, 0x90,
-	0xb5, 0x28, 0x50, 0x19, 0xdd, 0x76, 0x59, 0x6f, 0x76, 0xe2, 0x6e, 0xc6, 0xb2, 0xcc, 0x90, 0xd3,
-	0xc3, 0xb6, 0x83, 0xc0, 0x48, 0x8c, 0x22, 0xc4, 0x16, 0x05, 0x92, 0x32, 0xea, 0x7f, 0x60, 0xe7,
-	0x5e, 0x76, 0xd9, 0x5f, 0x54, 0x60, 0x97, 0x1e, 0x87, 0x1d, 0xba, 0x21, 0xb9, 0xed, 0xb8, 0xbf,
-	0x60, 0x10, 0x29, 0x5a, 0x96, 0x11, 0xb5, 0x0b, 0x76, 0x13, 0x1f, 0xdf, 0xf7, 0xf1, 0xbd, 0xef,
-	0x7d, 0xa2, 0x04, 0x07, 0x31, 0xa3, 0x0b, 0x12, 0xe1, 0xc8, 0x23, 0xfd, 0x39, 0x11, 0xd8, 0xc7,
-	0x02, 0xf7, 0x17, 0xcf, 0xfa, 0x1e, 0x8d, 0x04, 0xc3, 0x9e, 0xb0, 0x63, 0x46, 0x05, 0x45, 0x1f,
-	0xe7, 0x69, 0xb6, 0x4e, 0xb3, 0x17, 0xcf, 0xf6, 0xee, 0x05, 0x34, 0xa0, 0x32, 0xa5, 0x9f, 0x3e,
-	0xa9, 0xec, 0xbd, 0x27, 0x25, 0xa4, 0x3c, 0x26, 0x5e, 0x78, 0x1e, 0x7a, 0x58, 0x84, 0x34, 0xca,
-	0x72, 0x7b, 0x01, 0xa5, 0xc1, 0x8c, 0xf4, 0xe5, 0xea, 0x2c, 0x39, 0xef, 0x8b, 0x70, 0x4e, 0xb8,
-	0xc0, 0xf3, 0x58, 0x25, 0x58, 0x7f, 0x54, 0xa1, 0x79, 0x98, 0x55, 0x83, 0xbe, 0x01, 0xf0, 0xc9,
-	0x79, 0x18, 0x85, 0x29, 0x83, 0x69, 0xec, 0x1b, 0x8f, 0xb7, 0x3f, 0xb7, 0xec, 0x9b, 0x8b, 0xb3,
-	0x8f, 0x56, 0x99, 0xc3, 0xda, 0x9b, 0x77, 0xbd, 0x8a, 0xb3, 0x86, 0x45, 0x03, 0xa8, 0xa5, 0xe5,
-	0x98, 0x5b, 0x92, 0xe3, 0x51, 0x19, 0x87, 0x43, 0x3c, 0xca, 0x7c, 0x87, 0x9c, 0x13, 0x46, 0x22,
-	0x8f, 0x64, 0x44, 0x12, 0x8a, 0x4c, 0x68, 0x84, 0xd1, 0x82, 0x5e, 0x12, 0x66, 0x56, 0xf7, 0x8d,
-	0xc7, 0x2d, 0x47, 0x2f, 0xd1, 0x08, 0xea, 0x61, 0x14, 0x27, 0x82, 0x9b, 0xb5, 0xfd, 0xea, 0xed,
-	0xe9, 0x33, 0x30, 0xfa, 0x1a, 0xc0, 0xa3, 0x91, 0x2f, 0x0b, 0xe6, 0xe6, 0x1d, 0x49, 0xf5, 0xb0,
-	0x8c, 0xea, 0x50, 0x67, 0xea, 0x66, 0x73, 0x28, 0x9a, 0x42, 0xdb, 0xa3, 0x11, 0x0f, 0x7d, 0xc2,
-	0xb0, 0x22, 0xab, 0x4b, 0xb2, 0x83, 0xf7, 0x90, 0xe5, 0xd9, 0x19, 0xe1, 0x06, 0x05, 0x1a, 0x42,
-	0x93, 0x11, 0x2f, 0x14, 0x78, 0xc6, 0xcd, 0x86, 0x54, 0x71, 0xff, 0x3d, 0x6d, 0xca, 0xbc, 0x8c,
-	0x69, 0x85, 0xb3, 0x46, 0xd0, 0xd4, 0x7b, 0xe8, 0x2b, 0x68, 0xc4, 0x98, 0x89, 0x90, 0x70, 0xd3,
-	0x90, 0xd5, 0xf5, 0x3e, 0x40, 0xe7, 0xe8, 0x7c, 0x6b, 0x06, 0xbb, 0x1b, 0x4a, 0x22, 0x04, 0xb5,
-	0x08, 0xcf, 0x89, 0xf4, 0x48, 0xcb, 0x91, 0xcf, 0x68, 0x04, 0x2d, 0xa6, 0x13, 0xb2, 0xc1, 0x3f,
-	0x2c, 0x3f, 0xa3, 0x38, 0x93, 0x1c, 0x69, 0x2d, 0xa1, 0xb5, 0x12, 0x1b, 0x1d, 0x48, 0x69, 0xd5,
-	0xc2, 0x5d, 0x3b, 0x71, 0x67, 0x15, 0x3d, 0x51, 0x47, 0xd7, 0x19, 0xe1, 0xc9, 0x4c, 0x7c, 0xc8,
-	0x70, 0xa3, 0x57, 0xc4, 0x4b, 0x52, 0x98, 0x23, 0xd3, 0xb5, 0x23, 0x14, 0xd8, 0xfa, 0xcd, 0x80,
-	0x9d, 0xc2, 0x6c, 0xd0, 0x53, 0x40, 0x85, 0xb9, 0xac, 0xd7, 0x70, 0xb7, 0xb0, 0x23, 0xeb, 0x38,
-	0x5a, 0x39, 0x73, 0x4b, 0x6a, 0xfc, 0x59, 0x59, 0x1d, 0x13, 0x46, 0x63, 0xca, 0x89, 0xaf, 0x74,
-	0xdd, 0x30, 0x66, 0xde, 0x4d, 0xf5, 0xff, 0x74, 0x13, 0x40, 0x23, 0x1b, 0x25, 0x1a, 0xc2, 0x36,
-	0x0f, 0x83, 0x88, 0x30, 0x97, 0xd1, 0x99, 0xaa, 0xbf, 0x5d, 0x3e, 0x9c, 0x09, 0x66, 0x62, 0x79,
-	0xba, 0x8c, 0x89, 0x03, 0x0a, 0xe5, 0xd0, 0x19, 0x49, 0xdf, 0x47, 0xec, 0xfb, 0x8c, 0x70, 0x2e,
-	0x45, 0x6e, 0x39, 0x7a, 0x69, 0xfd, 0x6a, 0x40, 0xbb, 0xd8, 0xd0, 0x8d, 0xfe, 0x40, 0x50, 0xbb,
-	0xc0, 0xfc, 0x22, 0x43, 0xcb, 0x67, 0xf4, 0x00, 0x5a, 0x62, 0x19, 0x13, 0x25, 0xab, 0x7a, 0xcd,
-	0x9b, 0x69, 0x40, 0xaa, 0x79, 0x08, 0xcd, 0xb4, 0x36, 0x2e, 0x28, 0x33, 0x6b, 0xb7, 0xf3, 0xd3,
-	0x0a, 0x68, 0xfd, 0xb2, 0x05, 0xbb, 0x1b, 0x3a, 0xa5, 0x63, 0xa2, 0x89, 0x88, 0x13, 0x91, 0xdd,
-	0x71, 0xb7, 0x1c, 0x93, 0xc2, 0xa2, 0xe7, 0x05, 0xd3, 0xb5, 0xcb, 0x6f, 0x4a, 0x75, 0xaa, 0x14,
-	0x34, 0x43, 0xa0, 0x9f, 0x60, 0x9b, 0x49, 0x4e, 0xe2, 0xbb, 0x58, 0xcf, 0x79, 0xcf, 0x56, 0xb7,
-	0xb5, 0xad, 0x6f, 0x6b, 0xfb, 0x54, 0xdf, 0xd6, 0xc3, 0x6e, 0x7a, 0xf4, 0x3f, 0xef, 0x7a, 0x68,
-	0x89, 0xe7, 0xb3, 0xe7, 0xd6, 0x1a, 0xd8, 0x7a, 0xfd, 0x67, 0xcf, 0x70, 0x40, 0x47, 0x06, 0x02,
-	0x7d, 0x0a, 0x3b, 0x84, 0x31, 0xca, 0xdc, 0x39, 0xe1, 0x1c, 0x07, 0x44, 0x8a, 0xd7, 0x72, 0x3e,
-	0x92, 0xc1, 0xef, 0x54, 0xcc, 0xfa, 0xdb, 0x00, 0xc8, 0xaf, 0xf0, 0x1b, 0x07, 0x76, 0x02, 0x77,
-	0x19, 0xe1, 0x34, 0x61, 0x1e, 0x71, 0x67, 0x54, 0x7d, 0x57, 0xfe, 0xf3, 0x8b, 0xed, 0x74, 0x34,
-	0xf6, 0x38, 0x83, 0xa2, 0x47, 0xb0, 0xeb, 0x31, 0x82, 0x05, 0x65, 0xae, 0x76, 0x92, 0x1a, 0x79,
-	0x3b, 0x0b, 0x0f, 0x54, 0x14, 0x7d, 0x0f, 0xbb, 0xf9, 0xb7, 0xc4, 0x4d, 0xfd, 0x20, 0x5b, 0x68,
-	0x97, 0x0f, 0x2a, 0xef, 0x44, 0xca, 0xdc, 0xf6, 0x0b, 0x6b, 0xeb, 0x67, 0x03, 0x5a, 0xf9, 0xe5,
-	0x75, 0x1f, 0x9a, 0xdc, 0xa3, 0x31, 0x71, 0x43, 0x3f, 0xeb, 0xb7, 0x21, 0xd7, 0x63, 0x3f, 0xdd,
-	0x0a, 0x18, 0x4d, 0xe2, 0x74, 0x2b, 0xfb, 0xea, 0xc8, 0xf5, 0xd8, 0x5f, 0xd9, 0xb7, 0xb6, 0x66,
-	0x5f, 0xad, 0xda, 0x9d, 0x35, 0xd5, 0x0a, 0x96, 0xae, 0x17, 0x2d, 0xfd, 0xe4, 0x12, 0x20, 0x77,
-	0x03, 0x7a, 0x00, 0x9f, 0x38, 0xa3, 0xe9, 0xcb, 0xe3, 0x53, 0xf7, 0xf4, 0x87, 0xc9, 0xc8, 0x7d,
-	0x79, 0x32, 0x9d, 0x8c, 0x0e, 0xc7, 0x2f, 0xc6, 0xa3, 0xa3, 0x4e, 0x05, 0xdd, 0x83, 0xce, 0xfa,
-	0xe6, 0x64, 0x30, 0x9d, 0x76, 0x8c, 0xcd, 0xe8, 0xf4, 0xdb, 0xf1, 0xa4, 0xb3, 0xb5, 0x19, 0x7d,
-	0x31, 0x18, 0x1f, 0x77, 0xaa, 0xc3, 0xcb, 0x37, 0x57, 0x5d, 0xe3, 0xed, 0x55, 0xd7, 0xf8, 0xeb,
-	0xaa, 0x6b, 0xbc, 0xbe, 0xee, 0x56, 0xde, 0x5e, 0x77, 0x2b, 0xbf, 0x5f, 0x77, 0x2b, 0x70, 0x3f,
-	0xa4, 0x25, 0x4a, 0x4e, 0x8c, 0x1f, 0xbf, 0x0c, 0x42, 0x71, 0x91, 0x9c, 0xd9, 0x1e, 0x9d, 0xf7,
-	0xf3, 0xa4, 0xa7, 0x21, 0x5d, 0x5b, 0xf5, 0x5f, 0xe5, 0xbf, 0x1e, 0x69, 0x73, 0xfc, 0xac, 0x2e,
-	0x4d, 0xfb, 0xc5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x7c, 0xfa, 0x79, 0xf3, 0x08, 0x00,
-	0x00,
+	// 1027 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0xdb, 0x46,
+	0x13, 0x35, 0x25, 0x59, 0x12, 0xc7, 0xb6, 0x24, 0xef, 0x17, 0x7c, 0x91, 0x1d, 0x40, 0x72, 0x58,
+	0xb8, 0x09, 0x8c, 0x46, 0x42, 0xda, 0x5e, 0x6a, 0xf4, 0x22, 0xd9, 0x4a, 0x2b, 0xc4, 0x71, 0x05,
+	0xca, 0x39, 0xb4, 0x3d, 0x10, 0x34, 0xb9, 0x96, 0x09, 0x4b, 0x5c, 0x62, 0x77, 0x65, 0x44, 0x7f,
+	0xa0, 0xe7, 0x5c, 0x7a, 0xe9, 0x2f, 0x0a, 0xd0, 0x4b, 0x8e, 0x45, 0x0e, 0x4a, 0x61, 0xdf, 0x7a,
+	0xf4, 0x2f, 0x28, 0xb8, 0xcb, 0x25, 0x29, 0xc1, 0x74, 0x1c, 0xf4, 0xc6, 0x5d, 0xcd, 0x7b, 0x3b,
+	0xfb, 0xe6, 0xcd, 0x8e, 0x60, 0x37, 0xa0, 0xe4, 0x12, 0xfb, 0xb6, 0xef, 0xe0, 0xf6, 0x04, 0x73,
+	0xdb, 0xb5, 0xb9, 0xdd, 0xbe, 0x7c, 0xde, 0x76, 0x88, 0xcf, 0xa9, 0xed, 0xf0, 0x56, 0x40, 0x09,
+	0x27, 0xe8, 0xff, 0x49, 0x58, 0x4b, 0x85, 0xb5, 0x2e, 0x9f, 0x6f, 0x3f, 0x18, 0x91, 0x11, 0x11,
+	0x21, 0xed, 0xf0, 0x4b, 0x46, 0x6f, 0xef, 0x65, 0x90, 0xb2, 0x00, 0x3b, 0xde, 0x99, 0xe7, 0xd8,
+	0xdc, 0x23, 0x7e, 0x14, 0xdb, 0x1c, 0x11, 0x32, 0x1a, 0xe3, 0xb6, 0x58, 0x9d, 0x4e, 0xcf, 0xda,
+	0xdc, 0x9b, 0x60, 0xc6, 0xed, 0x49, 0x20, 0x03, 0x8c, 0x0f, 0x79, 0x28, 0x1f, 0x44, 0xd9, 0xa0,
+	0x1f, 0x01, 0x5c, 0x7c, 0xe6, 0xf9, 0x5e, 0xc8, 0x50, 0xd7, 0x76, 0xb4, 0xa7, 0x6b, 0x5f, 0x1b,
+	0xad, 0xdb, 0x93, 0x6b, 0x1d, 0xc6, 0x91, 0xdd, 0xc2, 0xbb, 0x79, 0x73, 0xc5, 0x4c, 0x61, 0x51,
+	0x07, 0x0a, 0x61, 0x3a, 0xf5, 0x9c, 0xe0, 0x78, 0x92, 0xc5, 0x61, 0x62, 0x87, 0x50, 0xd7, 0xc4,
+	0x67, 0x98, 0x62, 0xdf, 0xc1, 0x11, 0x91, 0x80, 0xa2, 0x3a, 0x94, 0x3c, 0xff, 0x92, 0x5c, 0x60,
+	0x5a, 0xcf, 0xef, 0x68, 0x4f, 0x75, 0x53, 0x2d, 0x51, 0x0f, 0x8a, 0x9e, 0x1f, 0x4c, 0x39, 0xab,
+	0x17, 0x76, 0xf2, 0x9f, 0x4f, 0x1f, 0x81, 0xd1, 0x0f, 0x00, 0x0e, 0xf1, 0x5d, 0x91, 0x30, 0xab,
+	0xaf, 0x0a, 0xaa, 0xc7, 0x59, 0x54, 0x07, 0x2a, 0x52, 0x5d, 0x36, 0x81, 0xa2, 0x21, 0x54, 0x1c,
+	0xe2, 0x33, 0xcf, 0xc5, 0xd4, 0x96, 0x64, 0x45, 0x41, 0xb6, 0x7b, 0x07, 0x59, 0x12, 0x1d, 0x11,
+	0x2e, 0x51, 0xa0, 0x2e, 0x94, 0x29, 0x76, 0x3c, 0x6e, 0x8f, 0x59, 0xbd, 0x24, 0x54, 0xdc, 0xb9,
+	0xe3, 0x9a, 0x22, 0x2e, 0x62, 0x8a, 0x71, 0xc6, 0xc7, 0x1c, 0x6c, 0xaa, 0xe2, 0xf6, 0xde, 0x60,
+	0x67, 0x2a, 0x6a, 0xd3, 0x83, 0x32, 0x73, 0x48, 0x80, 0x2d, 0xcf, 0x15, 0x35, 0x5e, 0xef, 0xee,
+	0x85, 0xb8, 0x0f, 0xf3, 0x66, 0xf5, 0x55, 0xc4, 0xda, 0x71, 0x5d, 0x8a, 0x19, 0xbb, 0x99, 0x37,
+	0xab, 0x33, 0x7b, 0x32, 0xde, 0x37, 0x14, 0xc0, 0x30, 0x4b, 0xe2, 0xb3, 0xef, 0xa2, 0x97, 0x00,
+	0x0c, 0x33, 0xe6, 0x11, 0x3f, 0x24, 0xca, 0x09, 0xa2, 0xaf, 0xb2, 0x89, 0x36, 0x23, 0xa2, 0x18,
+	0x62, 0x98, 0x7a, 0xb4, 0xe8, 0xbb, 0x68, 0x1f, 0xd6, 0xb1, 0x4a, 0x30, 0xa4, 0x13, 0x15, 0xef,
+	0x3e, 0xbc, 0x99, 0x37, 0xff, 0x27, 0x71, 0xe9, 0x5f, 0x0d, 0x73, 0x2d, 0x5e, 0xf6, 0x5d, 0xf4,
+	0x3d, 0x94, 0x55, 0x3f, 0xd5, 0x0b, 0x77, 0x2b, 0xa5, 0xc4, 0x30, 0x63, 0x44, 0x88, 0x8e, 0x75,
+	0x5e, 0xbd, 0x9f, 0xce, 0x29, 0x85, 0x7b, 0x50, 0x56, 0xbb, 0xe8, 0x3b, 0x28, 0x05, 0x36, 0xe5,
+	0x1e, 0x66, 0x75, 0x4d, 0xd4, 0xbf, 0xf9, 0x09, 0x22, 0x53, 0xc5, 0x1b, 0x63, 0xa8, 0x2e, 0x79,
+	0x15, 0x21, 0x28, 0xf8, 0xf6, 0x04, 0x8b, 0x0a, 0xe9, 0xa6, 0xf8, 0x46, 0x3d, 0xd0, 0xa9, 0x0a,
+	0x88, 0x5a, 0xeb, 0x71, 0xf6, 0x19, 0x8b, 0xae, 0x4f, 0x90, 0xc6, 0x0c, 0xf4, 0xd8, 0xce, 0x68,
+	0x57, 0x98, 0x57, 0x2e, 0xac, 0xd4, 0x89, 0x1b, 0xf1, 0xee, 0xb1, 0x3c, 0xba, 0x48, 0x31, 0x9b,
+	0x8e, 0xf9, 0xa7, 0x5a, 0x3a, 0xf6, 0x99, 0x29, 0xc2, 0x55, 0xcf, 0x49, 0xb0, 0xf1, 0xa7, 0x06,
+	0x1b, 0x0b, 0xee, 0x47, 0xcf, 0x00, 0x2d, 0x38, 0x3f, 0x9d, 0xc3, 0xe6, 0xc2, 0x2f, 0x22, 0x8f,
+	0xc3, 0xb8, 0xf7, 0x73, 0x42, 0xe3, 0x2f, 0xb3, 0xf2, 0x18, 0x50, 0x12, 0x10, 0x86, 0x5d, 0xa9,
+	0xeb, 0x52, 0xeb, 0x27, 0xb7, 0xc9, 0xff, 0x97, 0xdb, 0x8c, 0xa0, 0x14, 0x95, 0x12, 0x75, 0x61,
+	0x8d, 0x79, 0x23, 0x1f, 0x53, 0x8b, 0x92, 0xb1, 0xcc, 0xbf, 0x92, 0x5d, 0x9c, 0x81, 0x4d, 0xf9,
+	0xec, 0x64, 0x16, 0x60, 0x13, 0x24, 0xca, 0x24, 0x63, 0x1c, 0xbe, 0x78, 0xb6, 0xec, 0x17, 0x21,
+	0xb2, 0x6e, 0xaa, 0xa5, 0xf1, 0x87, 0x06, 0x95, 0xc5, 0x0b, 0xdd, 0xea, 0x0f, 0x04, 0x85, 0x73,
+	0x9b, 0x9d, 0x47, 0x68, 0xf1, 0x8d, 0x1e, 0x81, 0xce, 0x67, 0x01, 0x96, 0xb2, 0xca, 0x87, 0xb4,
+	0x1c, 0x6e, 0x08, 0x35, 0x0f, 0xa0, 0x1c, 0xe6, 0xc6, 0x38, 0xa1, 0x51, 0xeb, 0xdc, 0xdb, 0x4f,
+	0x31, 0xd0, 0xf8, 0x3d, 0x07, 0xd5, 0x25, 0x9d, 0xc2, 0x32, 0x91, 0x29, 0x0f, 0xa6, 0x3c, 0x9a,
+	0x22, 0x9f, 0x59, 0x26, 0x89, 0x45, 0xfb, 0x0b, 0xa6, 0xab, 0x64, 0xcf, 0x22, 0x79, 0xaa, 0x10,
+	0x34, 0x42, 0xa0, 0x5f, 0x61, 0x8d, 0x0a, 0x4e, 0xec, 0x5a, 0xb6, 0xaa, 0xf3, 0x76, 0x4b, 0xce,
+	0xc3, 0x96, 0x9a, 0x87, 0xad, 0x13, 0x35, 0x0f, 0xbb, 0x8d, 0xf0, 0xe8, 0x9b, 0x79, 0x13, 0xc9,
+	0x07, 0x27, 0x05, 0x36, 0xde, 0x7e, 0x6c, 0x6a, 0x26, 0xa8, 0x9d, 0x0e, 0x47, 0x5f, 0xc0, 0x06,
+	0xa6, 0x94, 0x50, 0x6b, 0x82, 0x19, 0xb3, 0x47, 0x58, 0x88, 0xa7, 0x9b, 0xeb, 0x62, 0xf3, 0x95,
+	0xdc, 0x33, 0xfe, 0xd1, 0x00, 0x92, 0x21, 0x79, 0x6b, 0xc1, 0x8e, 0x61, 0x93, 0x62, 0x46, 0xa6,
+	0xd4, 0xc1, 0xd6, 0x98, 0xc8, 0xc9, 0x7d, 0xef, 0xc6, 0x36, 0x6b, 0x0a, 0x7b, 0x14, 0x41, 0xd1,
+	0x13, 0xa8, 0x3a, 0x14, 0xdb, 0x9c, 0x50, 0x4b, 0x39, 0x49, 0x96, 0xbc, 0x12, 0x6d, 0x47, 0xef,
+	0x31, 0xfa, 0x09, 0xaa, 0xc9, 0xb4, 0xb6, 0x42, 0x3f, 0x88, 0x2b, 0x54, 0xb2, 0x0b, 0x95, 0xdc,
+	0x44, 0xc8, 0x5c, 0x71, 0x17, 0xd6, 0xc6, 0x6f, 0x1a, 0xe8, 0xc9, 0xe3, 0xb5, 0xb5, 0x34, 0x62,
+	0xf4, 0x64, 0x6c, 0x6c, 0x41, 0x79, 0x44, 0xc9, 0x34, 0x88, 0x5f, 0x79, 0xb3, 0x24, 0xd6, 0x7d,
+	0x37, 0xb6, 0x6f, 0x21, 0x65, 0x5f, 0xa5, 0xda, 0x6a, 0x4a, 0xb5, 0x05, 0x4b, 0x17, 0x17, 0x2d,
+	0xbd, 0x77, 0x01, 0x90, 0xb8, 0x01, 0x3d, 0x82, 0x87, 0x66, 0x6f, 0xf8, 0xfa, 0xe8, 0xc4, 0x3a,
+	0xf9, 0x79, 0xd0, 0xb3, 0x5e, 0x1f, 0x0f, 0x07, 0xbd, 0x83, 0xfe, 0x8b, 0x7e, 0xef, 0xb0, 0xb6,
+	0x82, 0x1e, 0x40, 0x2d, 0xfd, 0xe3, 0xa0, 0x33, 0x1c, 0xd6, 0xb4, 0xe5, 0xdd, 0xe1, 0xcb, 0xfe,
+	0xa0, 0x96, 0x5b, 0xde, 0x7d, 0xd1, 0xe9, 0x1f, 0xd5, 0xf2, 0xdd, 0x8b, 0x77, 0x57, 0x0d, 0xed,
+	0xfd, 0x55, 0x43, 0xfb, 0xfb, 0xaa, 0xa1, 0xbd, 0xbd, 0x6e, 0xac, 0xbc, 0xbf, 0x6e, 0xac, 0xfc,
+	0x75, 0xdd, 0x58, 0x81, 0x2d, 0x8f, 0x64, 0x28, 0x39, 0xd0, 0x7e, 0xf9, 0x76, 0xe4, 0xf1, 0xf3,
+	0xe9, 0x69, 0xcb, 0x21, 0x93, 0x76, 0x12, 0xf4, 0xcc, 0x23, 0xa9, 0x55, 0xfb, 0x4d, 0xf2, 0xe7,
+	0x2e, 0xbc, 0x1c, 0x3b, 0x2d, 0x0a, 0xd3, 0x7e, 0xf3, 0xef, 0x00, 0x2c, 0xc5, 0x16, 0xe1, 0x55,
+	0x0a, 0x00, 0x00,
 }
 
 func (m *Contract) Marshal() (dAtA []byte, err error) {
@@ -920,6 +998,80 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Recitals != nil {
+		{
+			size, err := m.Recitals.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintContract(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Contract != nil {
+		{
+			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintContract(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ExecutionId) > 0 {
+		i -= len(m.ExecutionId)
+		copy(dAtA[i:], m.ExecutionId)
+		i = encodeVarintContract(dAtA, i, uint64(len(m.ExecutionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.SessionId.Size()
+		i -= size
+		if _, err := m.SessionId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintContract(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintContract(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Recitals) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1207,12 +1359,12 @@ func (m *ExecutionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RecordedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RecordedAt):])
-	if err8 != nil {
-		return 0, err8
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RecordedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RecordedAt):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n8
-	i = encodeVarintContract(dAtA, i, uint64(n8))
+	i -= n10
+	i = encodeVarintContract(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x1a
 	if m.Result != 0 {
@@ -1393,6 +1545,31 @@ func (m *Contract) Size() (n int) {
 	return n
 }
 
+func (m *ContractExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovContract(uint64(l))
+	l = m.SessionId.Size()
+	n += 1 + l + sovContract(uint64(l))
+	l = len(m.ExecutionId)
+	if l > 0 {
+		n += 1 + l + sovContract(uint64(l))
+	}
+	if m.Contract != nil {
+		l = m.Contract.Size()
+		n += 1 + l + sovContract(uint64(l))
+	}
+	if m.Recitals != nil {
+		l = m.Recitals.Size()
+		n += 1 + l + sovContract(uint64(l))
+	}
+	return n
+}
+
 func (m *Recitals) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1860,6 +2037,226 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContractExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContract
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthContract
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthContract
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SessionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContract
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContract
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Contract == nil {
+				m.Contract = &Contract{}
+			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recitals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContract
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Recitals == nil {
+				m.Recitals = &Recitals{}
+			}
+			if err := m.Recitals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContract(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContract
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Recitals) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
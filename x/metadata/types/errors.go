@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/metadata module sentinel errors
+var (
+	// ErrScopeSpecificationNotFound occurs when a scope references a scope specification that does not exist
+	ErrScopeSpecificationNotFound = sdkerrors.Register(ModuleName, 2, "scope specification not found")
+	// ErrContractSpecificationNotFound occurs when a session references a contract specification that does not exist
+	ErrContractSpecificationNotFound = sdkerrors.Register(ModuleName, 3, "contract specification not found")
+	// ErrContractSpecificationNotAllowed occurs when a session uses a contract specification that is not listed on
+	// the scope specification of its scope
+	ErrContractSpecificationNotAllowed = sdkerrors.Register(ModuleName, 4, "contract specification not allowed by scope specification")
+	// ErrMissingRequiredParty occurs when a party type required by a specification is not present
+	ErrMissingRequiredParty = sdkerrors.Register(ModuleName, 5, "missing required party type")
+)
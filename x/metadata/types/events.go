@@ -55,6 +55,12 @@ const (
 	AttributeKeyRecordID string = "record_id"
 	// AttributeKeyExecutionID is the attribute key for a scope ID attribute JSON value.
 	AttributeKeyExecutionID string = "execution_id"
+	// AttributeKeyPreviousOwners is the attribute key for the owner addresses of a scope before an ownership change.
+	AttributeKeyPreviousOwners string = "previous_owners"
+	// AttributeKeyOwners is the attribute key for the owner addresses of a scope after an ownership change.
+	AttributeKeyOwners string = "owners"
+	// AttributeKeySigners is the attribute key for the addresses that signed a change.
+	AttributeKeySigners string = "signers"
 	// AttributeKeyModuleName is the attribute key for this module.
 	AttributeKeyModuleName string = "module"
 	// AttributeKeyTxHash is the attribute for the transaction hash.
@@ -1,7 +1,190 @@
 package types
 
-// Validate ensures the genesis state is valid.
+import (
+	"fmt"
+	"strings"
+)
+
+// Validate ensures every entry in the genesis state is well formed, is not duplicated, and that every scope,
+// session, record and specification refers only to scopes, sessions and specifications present in the genesis
+// state.  All problems found are reported in a single error.
 func (state GenesisState) Validate() error {
+	var problems []string
+	report := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	// Entries that fail basic validation are reported by position and left out of the reference checks.
+	contractSpecs := make(map[string]ContractSpecification, len(state.ContractSpecifications))
+	for i, spec := range state.ContractSpecifications {
+		if err := spec.ValidateBasic(); err != nil {
+			report("contract_specifications[%d]: %s", i, err)
+			continue
+		}
+		if _, found := contractSpecs[string(spec.SpecificationId)]; found {
+			report("contract_specifications[%d]: duplicate id %s", i, spec.SpecificationId)
+		}
+		contractSpecs[string(spec.SpecificationId)] = spec
+	}
+
+	recordSpecs := make(map[string]bool, len(state.RecordSpecifications))
+	for i, spec := range state.RecordSpecifications {
+		if err := spec.ValidateBasic(); err != nil {
+			report("record_specifications[%d]: %s", i, err)
+			continue
+		}
+		if recordSpecs[string(spec.SpecificationId)] {
+			report("record_specifications[%d]: duplicate id %s", i, spec.SpecificationId)
+		}
+		recordSpecs[string(spec.SpecificationId)] = true
+		contractSpecID, err := spec.SpecificationId.AsContractSpecAddress()
+		if err != nil {
+			report("record_specifications[%d]: %s", i, err)
+		} else if _, found := contractSpecs[string(contractSpecID)]; !found {
+			report("record_specifications[%d]: contract specification %s not found", i, contractSpecID)
+		}
+	}
+
+	scopeSpecs := make(map[string]bool, len(state.ScopeSpecifications))
+	for i, spec := range state.ScopeSpecifications {
+		if err := spec.ValidateBasic(); err != nil {
+			report("scope_specifications[%d]: %s", i, err)
+			continue
+		}
+		if scopeSpecs[string(spec.SpecificationId)] {
+			report("scope_specifications[%d]: duplicate id %s", i, spec.SpecificationId)
+		}
+		scopeSpecs[string(spec.SpecificationId)] = true
+		for _, contractSpecID := range spec.ContractSpecIds {
+			if _, found := contractSpecs[string(contractSpecID)]; !found {
+				report("scope_specifications[%d]: contract specification %s not found", i, contractSpecID)
+			}
+		}
+	}
+
+	scopes := make(map[string]bool, len(state.Scopes))
+	for i, scope := range state.Scopes {
+		if err := scope.ValidateBasic(); err != nil {
+			report("scopes[%d]: %s", i, err)
+			continue
+		}
+		if scopes[string(scope.ScopeId)] {
+			report("scopes[%d]: duplicate id %s", i, scope.ScopeId)
+		}
+		scopes[string(scope.ScopeId)] = true
+		if !scope.SpecificationId.Empty() && !scopeSpecs[string(scope.SpecificationId)] {
+			report("scopes[%d]: scope specification %s not found", i, scope.SpecificationId)
+		}
+	}
+
+	sessions := make(map[string]Session, len(state.Sessions))
+	for i, session := range state.Sessions {
+		if err := session.ValidateBasic(); err != nil {
+			report("sessions[%d]: %s", i, err)
+			continue
+		}
+		if _, found := sessions[string(session.SessionId)]; found {
+			report("sessions[%d]: duplicate id %s", i, session.SessionId)
+		}
+		sessions[string(session.SessionId)] = session
+		scopeID, err := session.SessionId.AsScopeAddress()
+		if err != nil {
+			report("sessions[%d]: %s", i, err)
+		} else if !scopes[string(scopeID)] {
+			report("sessions[%d]: scope %s not found", i, scopeID)
+		}
+		if _, found := contractSpecs[string(session.SpecificationId)]; !found {
+			report("sessions[%d]: contract specification %s not found", i, session.SpecificationId)
+		}
+	}
+
+	records := make(map[string]bool, len(state.Records))
+	for i, record := range state.Records {
+		if err := record.ValidateBasic(); err != nil {
+			report("records[%d]: %s", i, err)
+			continue
+		}
+		recordID, err := record.SessionId.AsRecordAddress(record.Name)
+		if err != nil {
+			report("records[%d]: %s", i, err)
+			continue
+		}
+		if records[string(recordID)] {
+			report("records[%d]: duplicate id %s", i, recordID)
+		}
+		records[string(recordID)] = true
+		session, found := sessions[string(record.SessionId)]
+		if !found {
+			report("records[%d]: session %s not found", i, record.SessionId)
+			continue
+		}
+		recordSpecID, err := session.SpecificationId.AsRecordSpecAddress(record.Name)
+		if err != nil {
+			report("records[%d]: %s", i, err)
+		} else if !recordSpecs[string(recordSpecID)] {
+			report("records[%d]: record specification %s not found", i, recordSpecID)
+		}
+	}
+
+	// History entries may outlive the scopes they belonged to so only their own contents are checked.
+	recordVersions := make(map[string]bool, len(state.RecordHistory))
+	for i, version := range state.RecordHistory {
+		if err := version.Record.ValidateBasic(); err != nil {
+			report("record_history[%d]: %s", i, err)
+			continue
+		}
+		if version.Version == 0 {
+			report("record_history[%d]: version must be greater than zero", i)
+			continue
+		}
+		recordID, err := version.Record.SessionId.AsRecordAddress(version.Record.Name)
+		if err != nil {
+			report("record_history[%d]: %s", i, err)
+			continue
+		}
+		key := string(GetRecordHistoryKey(recordID, version.Version))
+		if recordVersions[key] {
+			report("record_history[%d]: duplicate version %d of %s", i, version.Version, recordID)
+		}
+		recordVersions[key] = true
+	}
+
+	sessionVersions := make(map[string]bool, len(state.SessionHistory))
+	for i, version := range state.SessionHistory {
+		if err := version.Session.ValidateBasic(); err != nil {
+			report("session_history[%d]: %s", i, err)
+			continue
+		}
+		if version.Version == 0 {
+			report("session_history[%d]: version must be greater than zero", i)
+			continue
+		}
+		key := string(GetSessionHistoryKey(version.Session.SessionId, version.Version))
+		if sessionVersions[key] {
+			report("session_history[%d]: duplicate version %d of %s", i, version.Version, version.Session.SessionId)
+		}
+		sessionVersions[key] = true
+	}
+
+	executions := make(map[string]bool, len(state.Executions))
+	for i, execution := range state.Executions {
+		if strings.TrimSpace(execution.ExecutionId) == "" {
+			report("executions[%d]: execution id is empty", i)
+			continue
+		}
+		if !execution.ScopeId.IsScopeAddress() {
+			report("executions[%d]: invalid scope id %s", i, execution.ScopeId)
+		}
+		if executions[execution.ExecutionId] {
+			report("executions[%d]: duplicate execution id %s", i, execution.ExecutionId)
+		}
+		executions[execution.ExecutionId] = true
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid %s genesis state, %d problems found:\n\t%s",
+			ModuleName, len(problems), strings.Join(problems, "\n\t"))
+	}
 	return nil
 }
 
@@ -34,6 +34,11 @@ type GenesisState struct {
 	ScopeSpecifications    []ScopeSpecification    `protobuf:"bytes,5,rep,name=scope_specifications,json=scopeSpecifications,proto3" json:"scope_specifications"`
 	ContractSpecifications []ContractSpecification `protobuf:"bytes,6,rep,name=contract_specifications,json=contractSpecifications,proto3" json:"contract_specifications"`
 	RecordSpecifications   []RecordSpecification   `protobuf:"bytes,7,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications"`
+	// Prior versions of records and sessions
+	RecordHistory  []RecordVersion  `protobuf:"bytes,8,rep,name=record_history,json=recordHistory,proto3" json:"record_history"`
+	SessionHistory []SessionVersion `protobuf:"bytes,9,rep,name=session_history,json=sessionHistory,proto3" json:"session_history"`
+	// Contract executions that have been recorded
+	Executions []Execution `protobuf:"bytes,10,rep,name=executions,proto3" json:"executions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,32 +83,37 @@ func init() {
 }
 
 var fileDescriptor_a835c20198efc302 = []byte{
-	// 399 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x6f, 0xda, 0x30,
-	0x18, 0xc6, 0x93, 0xc1, 0x02, 0x32, 0x3b, 0x79, 0x8c, 0x65, 0x48, 0x4b, 0x10, 0xda, 0x24, 0xc4,
-	0x44, 0x22, 0xd8, 0x4e, 0xdb, 0x34, 0x69, 0xec, 0xb0, 0x2b, 0x82, 0xdb, 0x2e, 0x93, 0x31, 0x26,
-	0xb5, 0x5a, 0xe2, 0x28, 0xaf, 0x8b, 0xda, 0x6f, 0xd0, 0x63, 0x3f, 0x02, 0x1f, 0x87, 0x23, 0xbd,
-	0xf5, 0x54, 0x55, 0x70, 0xe9, 0xc7, 0xa8, 0x70, 0xc2, 0x9f, 0x42, 0xcc, 0x2d, 0x91, 0x7f, 0xbf,
-	0xe7, 0x79, 0x5f, 0xcb, 0xe8, 0x53, 0x14, 0x8b, 0x29, 0x0b, 0x49, 0x48, 0x99, 0x3f, 0x61, 0x92,
-	0x8c, 0x88, 0x24, 0xfe, 0xb4, 0xed, 0x07, 0x2c, 0x64, 0xc0, 0xc1, 0x8b, 0x62, 0x21, 0x05, 0xae,
-	0xec, 0x28, 0x6f, 0x43, 0x79, 0xd3, 0x76, 0xb5, 0x1c, 0x88, 0x40, 0x28, 0xc4, 0x5f, 0x7f, 0x25,
-	0x74, 0xf5, 0xb3, 0x26, 0x73, 0x6b, 0x26, 0x58, 0x5d, 0x83, 0x01, 0x15, 0x11, 0x4b, 0x99, 0xa6,
-	0x8e, 0x89, 0x18, 0xe5, 0x63, 0x4e, 0x89, 0xe4, 0x22, 0x4c, 0xd8, 0xfa, 0x5d, 0x1e, 0xbd, 0xf9,
-	0x9b, 0x8c, 0x3d, 0x90, 0x44, 0x32, 0xfc, 0x13, 0x59, 0x11, 0x89, 0xc9, 0x04, 0x6c, 0xb3, 0x66,
-	0x36, 0x4a, 0x1d, 0xc7, 0xcb, 0x5e, 0xc3, 0xeb, 0x29, 0xaa, 0x9b, 0x9f, 0x3f, 0xb8, 0x46, 0x3f,
-	0x75, 0xf0, 0x0f, 0x64, 0xa9, 0x49, 0xc0, 0x7e, 0x55, 0xcb, 0x35, 0x4a, 0x9d, 0x8f, 0x3a, 0x7b,
-	0xb0, 0xa6, 0x36, 0x72, 0xa2, 0xe0, 0xdf, 0xa8, 0x08, 0x0c, 0x80, 0x8b, 0x10, 0xec, 0x9c, 0xd2,
-	0x5d, 0xad, 0x9e, 0x70, 0x69, 0xc0, 0x56, 0xc3, 0xbf, 0x50, 0x21, 0x66, 0x54, 0xc4, 0x23, 0xb0,
-	0xf3, 0x2a, 0x41, 0x3b, 0x7e, 0x5f, 0x61, 0x69, 0xc0, 0x46, 0xc2, 0x14, 0x95, 0xd5, 0x30, 0xff,
-	0x5f, 0xdc, 0x15, 0xd8, 0xaf, 0x55, 0x58, 0xf3, 0xe4, 0x36, 0x83, 0x7d, 0x25, 0x0d, 0x7e, 0x0b,
-	0x47, 0x27, 0x80, 0x2f, 0xd0, 0x7b, 0x2a, 0x42, 0x19, 0x13, 0x2a, 0x0f, 0x7b, 0x2c, 0xd5, 0xd3,
-	0xd2, 0xf5, 0xfc, 0x49, 0xb5, 0xac, 0xaa, 0x0a, 0xcd, 0x3a, 0x04, 0x3c, 0x46, 0xef, 0x92, 0xed,
-	0x0e, 0xbb, 0x0a, 0xaa, 0xeb, 0xcb, 0xe9, 0x0b, 0xca, 0x6a, 0x2a, 0xc7, 0xc7, 0x47, 0xf0, 0xbd,
-	0x78, 0x33, 0x73, 0x8d, 0xa7, 0x99, 0x6b, 0x74, 0xcf, 0xe7, 0x4b, 0xc7, 0x5c, 0x2c, 0x1d, 0xf3,
-	0x71, 0xe9, 0x98, 0xb7, 0x2b, 0xc7, 0x58, 0xac, 0x1c, 0xe3, 0x7e, 0xe5, 0x18, 0xe8, 0x03, 0x17,
-	0x9a, 0xba, 0x9e, 0xf9, 0xef, 0x5b, 0xc0, 0xe5, 0xd9, 0xe5, 0xd0, 0xa3, 0x62, 0xe2, 0xef, 0xa0,
-	0x16, 0x17, 0x7b, 0x7f, 0xfe, 0xd5, 0xee, 0x45, 0xcb, 0xeb, 0x88, 0xc1, 0xd0, 0x52, 0xef, 0xf8,
-	0xeb, 0x73, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd6, 0xd0, 0x53, 0x82, 0x94, 0x03, 0x00, 0x00,
+	// 468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x13, 0x3a, 0xb2, 0xe2, 0xc1, 0x90, 0x4c, 0x19, 0x61, 0x12, 0x49, 0x99, 0x18, 0x9a,
+	0x86, 0x96, 0x68, 0x83, 0x13, 0x20, 0x24, 0x86, 0xd0, 0x38, 0x4e, 0xad, 0xe0, 0xc0, 0x05, 0x79,
+	0x9e, 0x97, 0x59, 0xd0, 0x38, 0xf2, 0xe7, 0x55, 0xdb, 0x1b, 0x70, 0xe4, 0x11, 0xf6, 0x32, 0x48,
+	0x3b, 0xee, 0xc8, 0x09, 0xa1, 0xf6, 0xc2, 0x63, 0xa0, 0xd9, 0x4e, 0x9b, 0xb5, 0x71, 0xb8, 0xb5,
+	0xf2, 0xef, 0xff, 0xfb, 0x7f, 0xb6, 0x63, 0xf4, 0xa4, 0x90, 0x62, 0xc8, 0x72, 0x92, 0x53, 0x96,
+	0x0e, 0x98, 0x22, 0x87, 0x44, 0x91, 0x74, 0xb8, 0x9d, 0x66, 0x2c, 0x67, 0xc0, 0x21, 0x29, 0xa4,
+	0x50, 0x02, 0xaf, 0x4c, 0xa9, 0xa4, 0xa4, 0x92, 0xe1, 0xf6, 0x6a, 0x27, 0x13, 0x99, 0xd0, 0x48,
+	0x7a, 0xf5, 0xcb, 0xd0, 0xab, 0xeb, 0x0e, 0xe7, 0x24, 0x69, 0xb0, 0x35, 0x07, 0x06, 0x54, 0x14,
+	0xcc, 0x32, 0x9b, 0x2e, 0xa6, 0x60, 0x94, 0x1f, 0x71, 0x4a, 0x14, 0x17, 0xb9, 0x61, 0xd7, 0x7e,
+	0x06, 0xe8, 0xf6, 0x9e, 0x19, 0xbb, 0xaf, 0x88, 0x62, 0xf8, 0x35, 0x0a, 0x0a, 0x22, 0xc9, 0x00,
+	0x42, 0xbf, 0xeb, 0x6f, 0x2c, 0xed, 0x44, 0x49, 0xfd, 0x36, 0x92, 0x7d, 0x4d, 0xed, 0x2e, 0x5c,
+	0xfc, 0x8e, 0xbd, 0x9e, 0xcd, 0xe0, 0x57, 0x28, 0xd0, 0x93, 0x40, 0x78, 0xa3, 0xdb, 0xda, 0x58,
+	0xda, 0x79, 0xe4, 0x4a, 0xf7, 0xaf, 0xa8, 0x32, 0x6c, 0x22, 0xf8, 0x2d, 0x6a, 0x03, 0x03, 0xe0,
+	0x22, 0x87, 0xb0, 0xa5, 0xe3, 0xb1, 0x33, 0x6e, 0x38, 0x2b, 0x98, 0xc4, 0xf0, 0x1b, 0xb4, 0x28,
+	0x19, 0x15, 0xf2, 0x10, 0xc2, 0x85, 0x6e, 0xab, 0x69, 0xfc, 0x9e, 0xc6, 0xac, 0xa0, 0x0c, 0x61,
+	0x8a, 0x3a, 0x7a, 0x98, 0x2f, 0xd7, 0xce, 0x0a, 0xc2, 0x9b, 0x5a, 0xb6, 0xd9, 0xb8, 0x9b, 0x7e,
+	0x35, 0x62, 0xc5, 0xf7, 0x60, 0x6e, 0x05, 0xf0, 0x37, 0xf4, 0x80, 0x8a, 0x5c, 0x49, 0x42, 0xd5,
+	0x6c, 0x4f, 0xa0, 0x7b, 0xb6, 0x5c, 0x3d, 0xef, 0x6c, 0xac, 0xae, 0x6a, 0x85, 0xd6, 0x2d, 0x02,
+	0x3e, 0x42, 0xf7, 0xcd, 0xee, 0x66, 0xbb, 0x16, 0x75, 0xd7, 0xb3, 0xe6, 0x03, 0xaa, 0x6b, 0xea,
+	0xc8, 0xf9, 0x25, 0xc0, 0x3d, 0xb4, 0x6c, 0x7b, 0x8e, 0x39, 0x28, 0x21, 0xcf, 0xc2, 0xb6, 0x2e,
+	0x58, 0x6f, 0x2e, 0xf8, 0xc4, 0x64, 0xe5, 0x26, 0xef, 0x18, 0xc5, 0x07, 0x63, 0xc0, 0x1f, 0xd1,
+	0x5d, 0x7b, 0xb5, 0x13, 0xe9, 0x2d, 0x2d, 0x7d, 0xfa, 0x9f, 0x0f, 0xe3, 0xba, 0x75, 0xd9, 0x4a,
+	0x4a, 0xed, 0x1e, 0x42, 0xec, 0x94, 0xd1, 0x13, 0x73, 0x0e, 0x48, 0x1b, 0x1f, 0xbb, 0x8c, 0xef,
+	0x4b, 0xd2, 0xca, 0x2a, 0xd1, 0x97, 0xed, 0xef, 0xe7, 0xb1, 0xf7, 0xf7, 0x3c, 0xf6, 0x76, 0xbf,
+	0x5e, 0x8c, 0x22, 0xff, 0x72, 0x14, 0xf9, 0x7f, 0x46, 0x91, 0xff, 0x63, 0x1c, 0x79, 0x97, 0xe3,
+	0xc8, 0xfb, 0x35, 0x8e, 0x3c, 0xf4, 0x90, 0x0b, 0x87, 0x7a, 0xdf, 0xff, 0xfc, 0x22, 0xe3, 0xea,
+	0xf8, 0xe4, 0x20, 0xa1, 0x62, 0x90, 0x4e, 0xa1, 0x2d, 0x2e, 0x2a, 0xff, 0xd2, 0xd3, 0xe9, 0x2b,
+	0x56, 0x67, 0x05, 0x83, 0x83, 0x40, 0xbf, 0xdd, 0xe7, 0xff, 0x06, 0x00, 0x3b, 0xca, 0xfe, 0xed,
+	0x88, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +136,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Executions) > 0 {
+		for iNdEx := len(m.Executions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Executions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SessionHistory) > 0 {
+		for iNdEx := len(m.SessionHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RecordHistory) > 0 {
+		for iNdEx := len(m.RecordHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.RecordSpecifications) > 0 {
 		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -278,6 +330,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RecordHistory) > 0 {
+		for _, e := range m.RecordHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SessionHistory) > 0 {
+		for _, e := range m.SessionHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Executions) > 0 {
+		for _, e := range m.Executions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -553,6 +623,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordHistory = append(m.RecordHistory, RecordVersion{})
+			if err := m.RecordHistory[len(m.RecordHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionHistory = append(m.SessionHistory, SessionVersion{})
+			if err := m.SessionHistory[len(m.SessionHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executions = append(m.Executions, Execution{})
+			if err := m.Executions[len(m.Executions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
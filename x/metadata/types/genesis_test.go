@@ -0,0 +1,147 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// validGenesisState returns a genesis state with one of each entry, all referring to each other.
+func validGenesisState() *GenesisState {
+	owner := addr.String()
+	contractSpecUUID := uuid.New()
+	contractSpecID := ContractSpecMetadataAddress(contractSpecUUID)
+	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
+	scopeUUID := uuid.New()
+	sessionID := SessionMetadataAddress(scopeUUID, uuid.New())
+	parties := []Party{{Address: owner, Role: PartyType_PARTY_TYPE_OWNER}}
+	owners := []PartyType{PartyType_PARTY_TYPE_OWNER}
+
+	return NewGenesisState(
+		DefaultParams(),
+		[]Scope{*NewScope(ScopeMetadataAddress(scopeUUID), scopeSpecID, parties, nil, owner)},
+		[]Session{*NewSession("class", sessionID, contractSpecID, parties, nil)},
+		[]Record{*NewRecord("record", sessionID, *NewProcess("process", &Process_Hash{Hash: "hash"}, "method"), nil,
+			[]RecordOutput{*NewRecordOutput("hash", ResultStatus_RESULT_STATUS_PASS)})},
+		[]ScopeSpecification{*NewScopeSpecification(scopeSpecID, nil, []string{owner}, owners,
+			[]MetadataAddress{contractSpecID})},
+		[]ContractSpecification{*NewContractSpecification(contractSpecID, nil, []string{owner}, owners,
+			NewContractSpecificationSourceHash("hash"), "class")},
+		[]RecordSpecification{*NewRecordSpecification(RecordSpecMetadataAddress(contractSpecUUID, "record"), "record",
+			nil, "type", DefinitionType_DEFINITION_TYPE_RECORD, owners)},
+	)
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	require.NoError(t, DefaultGenesisState().Validate())
+	require.NoError(t, validGenesisState().Validate())
+	withHistory := validGenesisState()
+	withHistory.Scopes = nil // history is kept for entries that no longer exist
+	withHistory.Sessions = nil
+	withHistory.Records = nil
+	withHistory.RecordHistory = []RecordVersion{{Version: 1, Record: validGenesisState().Records[0], Height: 1}}
+	withHistory.SessionHistory = []SessionVersion{{Version: 1, Session: validGenesisState().Sessions[0], Height: 1}}
+	withHistory.Executions = []Execution{{ExecutionId: "execution", ScopeId: validGenesisState().Scopes[0].ScopeId, Height: 1}}
+	require.NoError(t, withHistory.Validate())
+
+	tests := []struct {
+		name   string
+		modify func(*GenesisState)
+		errs   []string
+	}{
+		{
+			"invalid scope id prefix",
+			func(gs *GenesisState) { gs.Scopes[0].ScopeId = gs.Sessions[0].SessionId },
+			[]string{"scopes[0]: invalid scope identifier (expected: scope, got session)", "sessions[0]: scope"},
+		},
+		{
+			"invalid contract specification",
+			func(gs *GenesisState) { gs.ContractSpecifications[0].OwnerAddresses = []string{} },
+			[]string{
+				"contract_specifications[0]: invalid owner addresses count",
+				"record_specifications[0]: contract specification",
+				"scope_specifications[0]: contract specification",
+				"sessions[0]: contract specification",
+			},
+		},
+		{
+			"missing scope specification",
+			func(gs *GenesisState) { gs.ScopeSpecifications = nil },
+			[]string{"scopes[0]: scope specification"},
+		},
+		{
+			"missing scope",
+			func(gs *GenesisState) { gs.Scopes = nil },
+			[]string{"sessions[0]: scope"},
+		},
+		{
+			"missing session",
+			func(gs *GenesisState) { gs.Sessions = nil },
+			[]string{"records[0]: session"},
+		},
+		{
+			"missing record specification",
+			func(gs *GenesisState) { gs.RecordSpecifications = nil },
+			[]string{"records[0]: record specification"},
+		},
+		{
+			"duplicates",
+			func(gs *GenesisState) {
+				gs.Scopes = append(gs.Scopes, gs.Scopes[0])
+				gs.Records = append(gs.Records, gs.Records[0])
+			},
+			[]string{"scopes[1]: duplicate id", "records[1]: duplicate id"},
+		},
+		{
+			"invalid history",
+			func(gs *GenesisState) {
+				gs.RecordHistory = []RecordVersion{
+					{Version: 0, Record: gs.Records[0]},
+					{Version: 1, Record: gs.Records[0]},
+					{Version: 1, Record: gs.Records[0]},
+				}
+				gs.SessionHistory = []SessionVersion{{Version: 1, Session: Session{}}}
+			},
+			[]string{
+				"record_history[0]: version must be greater than zero",
+				"record_history[2]: duplicate version 1",
+				"session_history[0]: ",
+			},
+		},
+		{
+			"invalid executions",
+			func(gs *GenesisState) {
+				gs.Executions = []Execution{
+					{ExecutionId: " ", ScopeId: gs.Scopes[0].ScopeId},
+					{ExecutionId: "execution", ScopeId: gs.Sessions[0].SessionId},
+					{ExecutionId: "execution", ScopeId: gs.Scopes[0].ScopeId},
+				}
+			},
+			[]string{
+				"executions[0]: execution id is empty",
+				"executions[1]: invalid scope id",
+				"executions[2]: duplicate execution id execution",
+			},
+		},
+		{
+			"invalid record",
+			func(gs *GenesisState) { gs.Records[0].Name = "" },
+			[]string{"records[0]: invalid/missing name for record"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			gs := validGenesisState()
+			tc.modify(gs)
+			err := gs.Validate()
+			require.Error(t, err)
+			for _, e := range tc.errs {
+				require.Contains(t, err.Error(), e)
+			}
+			require.Contains(t, err.Error(), "problems found")
+		})
+	}
+}
@@ -37,6 +37,12 @@ const (
 //
 // - 0x04<scope_specification_id_bytes>: ScopeSpecification
 //
+// - 0x06<scope_key_bytes><record_name_bytes><version>: RecordVersion
+//
+// - 0x07<scope_key_bytes><session_id_bytes><version>: SessionVersion
+//
+// - 0x08<execution_id_bytes>: Execution
+//
 // - 0x10<party_address><scope_key_bytes>: 0x01
 //
 // - 0x11<scope_spec_id><scope_id>: 0x01
@@ -48,6 +54,8 @@ const (
 // - 0x14<contract_spec_id><scope_spec_id>: 0x01
 //
 // - 0x15<owner_address><contract_spec_id>: 0x01
+//
+// - 0x16<reader_address><scope_id>: 0x01
 var (
 	// ScopeKeyPrefix is the key for scope records in metadata store
 	ScopeKeyPrefix = []byte{0x00}
@@ -61,6 +69,12 @@ var (
 	ScopeSpecificationKeyPrefix = []byte{0x04}
 	// RecordSpecificationKeyPrefix is the key for record specifications in metadata store
 	RecordSpecificationKeyPrefix = []byte{0x05}
+	// RecordHistoryKeyPrefix is the key for prior versions of records in metadata store
+	RecordHistoryKeyPrefix = []byte{0x06}
+	// SessionHistoryKeyPrefix is the key for prior versions of sessions in metadata store
+	SessionHistoryKeyPrefix = []byte{0x07}
+	// ExecutionKeyPrefix is the key for recorded contract executions in metadata store
+	ExecutionKeyPrefix = []byte{0x08}
 
 	// AddressScopeCacheKeyPrefix for scope to address cache lookup
 	AddressScopeCacheKeyPrefix = []byte{0x10}
@@ -75,6 +89,8 @@ var (
 	ContractSpecScopeSpecCacheKeyPrefix = []byte{0x14}
 	// AddressContractSpecCacheKeyPrefix for contract spec lookup by address
 	AddressContractSpecCacheKeyPrefix = []byte{0x15}
+	// DataAccessScopeCacheKeyPrefix for lookup of the scopes an address may read the data of
+	DataAccessScopeCacheKeyPrefix = []byte{0x16}
 )
 
 // GetAddressScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
@@ -136,3 +152,47 @@ func GetAddressContractSpecCacheIteratorPrefix(addr sdk.AccAddress) []byte {
 func GetAddressContractSpecCacheKey(addr sdk.AccAddress, contractSpecID MetadataAddress) []byte {
 	return append(GetAddressContractSpecCacheIteratorPrefix(addr), contractSpecID.Bytes()...)
 }
+
+// GetDataAccessScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries listing a given address
+// as an owner, data access party, or value owner
+func GetDataAccessScopeCacheIteratorPrefix(addr sdk.AccAddress) []byte {
+	return append(DataAccessScopeCacheKeyPrefix, addr.Bytes()...)
+}
+
+// GetDataAccessScopeCacheKey returns the store key for a reader address + scope cache entry
+func GetDataAccessScopeCacheKey(addr sdk.AccAddress, scopeID MetadataAddress) []byte {
+	return append(GetDataAccessScopeCacheIteratorPrefix(addr), scopeID.Bytes()...)
+}
+
+// GetRecordHistoryIteratorPrefix returns an iterator prefix for all prior versions of the record with the given id
+func GetRecordHistoryIteratorPrefix(recordID MetadataAddress) []byte {
+	return append(RecordHistoryKeyPrefix, recordID.Bytes()[1:]...)
+}
+
+// GetRecordHistoryKey returns the store key for a prior version of the record with the given id
+func GetRecordHistoryKey(recordID MetadataAddress, version uint64) []byte {
+	return append(GetRecordHistoryIteratorPrefix(recordID), sdk.Uint64ToBigEndian(version)...)
+}
+
+// GetSessionHistoryIteratorPrefix returns an iterator prefix for all prior versions of the session with the given id
+func GetSessionHistoryIteratorPrefix(sessionID MetadataAddress) []byte {
+	return append(SessionHistoryKeyPrefix, sessionID.Bytes()[1:]...)
+}
+
+// GetSessionHistoryKey returns the store key for a prior version of the session with the given id
+func GetSessionHistoryKey(sessionID MetadataAddress, version uint64) []byte {
+	return append(GetSessionHistoryIteratorPrefix(sessionID), sdk.Uint64ToBigEndian(version)...)
+}
+
+// GetExecutionKey returns the store key for a recorded contract execution
+func GetExecutionKey(executionID string) []byte {
+	return append(ExecutionKeyPrefix, []byte(executionID)...)
+}
+
+// GetHistoryKeyVersion returns the version from a record or session history key
+func GetHistoryKeyVersion(key []byte) uint64 {
+	if len(key) < 8 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
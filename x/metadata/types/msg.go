@@ -24,6 +24,7 @@ const (
 	TypeMsgAddRecordSpecificationRequest      = "add_record_specification_request"
 	TypeMsgDeleteRecordSpecificationRequest   = "delete_record_specification_request"
 	TypeMsgAddP8EContractSpecRequest          = "add_p8e_contract_spec_request"
+	TypeMsgP8EMemorializeContractRequest      = "p8e_memorialize_contract_request"
 )
 
 // Compile time interface checks.
@@ -42,6 +43,7 @@ var (
 	_ sdk.Msg = &MsgAddRecordSpecificationRequest{}
 	_ sdk.Msg = &MsgDeleteRecordSpecificationRequest{}
 	_ sdk.Msg = &MsgAddP8EContractSpecRequest{}
+	_ sdk.Msg = &MsgP8EMemorializeContractRequest{}
 )
 
 // private method to convert an array of strings into an array of Acc Addresses.
@@ -109,13 +111,35 @@ func (msg MsgMemorializeContractRequest) ValidateBasic() error {
 	if strings.TrimSpace(msg.Notary) == "" {
 		return fmt.Errorf("notary address is empty")
 	}
-	if err := msg.Contract.ValidateBasic(); err != nil {
+	scopeID, err := msg.ScopeMetadataAddress()
+	if err != nil {
+		return err
+	}
+	if _, err = parseSessionID(scopeID, msg.SessionId); err != nil {
 		return err
 	}
+	if len(msg.Signatures.Signatures) == 0 {
+		return fmt.Errorf("at least one contract signature is required")
+	}
 
 	return msg.Contract.ValidateBasic()
 }
 
+// ScopeMetadataAddress returns the address of the scope being modified, the scope id may be a scope address or uuid.
+func (msg MsgMemorializeContractRequest) ScopeMetadataAddress() (MetadataAddress, error) {
+	return parseScopeID(msg.ScopeId)
+}
+
+// SessionMetadataAddress returns the address of the session to record, the session id may be a session address or
+// a session uuid within the scope.
+func (msg MsgMemorializeContractRequest) SessionMetadataAddress() (MetadataAddress, error) {
+	scopeID, err := msg.ScopeMetadataAddress()
+	if err != nil {
+		return MetadataAddress{}, err
+	}
+	return parseSessionID(scopeID, msg.SessionId)
+}
+
 // ------------------  MsgChangeOwnershipRequest  ------------------
 
 // NewMsgChangeOwnershipRequest creates a new msg instance
@@ -157,7 +181,8 @@ func (msg MsgChangeOwnershipRequest) ValidateBasic() error {
 	if strings.TrimSpace(msg.ScopeId) == "" {
 		return fmt.Errorf("scope ID is empty")
 	}
-	if strings.TrimSpace(msg.SessionId) == "" {
+	// Only a contract based change is memorialized as a session.
+	if msg.Contract != nil && strings.TrimSpace(msg.SessionId) == "" {
 		return fmt.Errorf("session ID is empty")
 	}
 	if strings.TrimSpace(msg.ExecutionId) == "" {
@@ -167,6 +192,19 @@ func (msg MsgChangeOwnershipRequest) ValidateBasic() error {
 		return fmt.Errorf("notary address is empty")
 	}
 
+	scopeID, err := msg.ScopeMetadataAddress()
+	if err != nil {
+		return err
+	}
+	if len(msg.SessionId) > 0 {
+		if _, err = parseSessionID(scopeID, msg.SessionId); err != nil {
+			return err
+		}
+	}
+	if len(msg.Signatures.Signatures) == 0 {
+		return fmt.Errorf("at least one signature is required")
+	}
+
 	// Must have one of contract, recitals but not both.
 	if msg.Contract == nil && msg.Recitals == nil {
 		return fmt.Errorf("one of contract or recitals is required")
@@ -174,7 +212,48 @@ func (msg MsgChangeOwnershipRequest) ValidateBasic() error {
 	if msg.Contract != nil && msg.Recitals != nil {
 		return fmt.Errorf("only one of contract or recitals is allowed")
 	}
-	return msg.Contract.ValidateBasic()
+	parties := msg.GetRecitals().AsParties()
+	if len(parties) == 0 {
+		return fmt.Errorf("recitals must contain at least one party")
+	}
+	for _, p := range parties {
+		if err = p.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid recital: %w", err)
+		}
+	}
+	if msg.Contract != nil {
+		return msg.Contract.ValidateBasic()
+	}
+	return nil
+}
+
+// GetRecitals returns the recitals with the new scope parties, either given directly or from the contract.
+func (msg MsgChangeOwnershipRequest) GetRecitals() Recitals {
+	if msg.Contract != nil {
+		return msg.Contract.Recitals
+	}
+	if msg.Recitals != nil {
+		return *msg.Recitals
+	}
+	return Recitals{}
+}
+
+// ScopeMetadataAddress returns the address of the scope being modified, the scope id may be a scope address or uuid.
+func (msg MsgChangeOwnershipRequest) ScopeMetadataAddress() (MetadataAddress, error) {
+	return parseScopeID(msg.ScopeId)
+}
+
+// SessionMetadataAddress returns the address of the session for the change, the session id may be a session address
+// or a session uuid within the scope.  An empty address is returned when no session id is given.
+func (msg MsgChangeOwnershipRequest) SessionMetadataAddress() (MetadataAddress, error) {
+	scopeID, err := msg.ScopeMetadataAddress()
+	if err != nil {
+		return MetadataAddress{}, err
+	}
+	if len(msg.SessionId) == 0 {
+		return MetadataAddress{}, nil
+	}
+	return parseSessionID(scopeID, msg.SessionId)
 }
 
 // ------------------  MsgAddScopeRequest  ------------------
@@ -672,3 +751,90 @@ func (msg MsgDeleteRecordSpecificationRequest) ValidateBasic() error {
 	}
 	return nil
 }
+
+// ------------------  MsgP8EMemorializeContractRequest  ------------------
+
+// NewMsgP8EMemorializeContractRequest creates a new msg instance
+func NewMsgP8EMemorializeContractRequest() *MsgP8EMemorializeContractRequest {
+	return &MsgP8EMemorializeContractRequest{}
+}
+
+func (msg MsgP8EMemorializeContractRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgP8EMemorializeContractRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgP8EMemorializeContractRequest) Type() string {
+	return TypeMsgP8EMemorializeContractRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgP8EMemorializeContractRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Invoker)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgP8EMemorializeContractRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgP8EMemorializeContractRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.ExecutionId) == "" {
+		return fmt.Errorf("execution ID is empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Invoker); err != nil {
+		return fmt.Errorf("invalid invoker address: %w", err)
+	}
+	if _, err := msg.SessionMetadataAddress(); err != nil {
+		return err
+	}
+	if _, err := msg.ScopeSpecMetadataAddress(); err != nil {
+		return err
+	}
+	if len(msg.Signatures.Signatures) == 0 {
+		return fmt.Errorf("at least one contract signature is required")
+	}
+	if _, err := ConvertP8eContract(&msg.Contract); err != nil {
+		return fmt.Errorf("failed to convert p8e Contract %s", err)
+	}
+	// The recitals are not signed, owners of a new scope come from the signed contract recitals instead.
+	if msg.Recitals != nil {
+		return fmt.Errorf("only one of contract or recitals is allowed")
+	}
+	return nil
+}
+
+// ScopeMetadataAddress returns the address of the scope being modified, the scope id may be a scope address or uuid.
+func (msg MsgP8EMemorializeContractRequest) ScopeMetadataAddress() (MetadataAddress, error) {
+	return parseScopeID(msg.ScopeId)
+}
+
+// SessionMetadataAddress returns the address of the session to record, the group id may be a session address or
+// a session uuid within the scope.
+func (msg MsgP8EMemorializeContractRequest) SessionMetadataAddress() (MetadataAddress, error) {
+	scopeID, err := msg.ScopeMetadataAddress()
+	if err != nil {
+		return MetadataAddress{}, err
+	}
+	return parseSessionID(scopeID, msg.GroupId)
+}
+
+// ScopeSpecMetadataAddress returns the address of the scope specification for a new scope, the scope specification
+// id may be a scope specification address or uuid.  An empty address is returned when no id is given.
+func (msg MsgP8EMemorializeContractRequest) ScopeSpecMetadataAddress() (MetadataAddress, error) {
+	if strings.TrimSpace(msg.ScopeSpecificationId) == "" {
+		return MetadataAddress{}, nil
+	}
+	return parseScopeSpecID(msg.ScopeSpecificationId)
+}
@@ -2,9 +2,11 @@ package types
 
 import (
 	"encoding/hex"
+	"fmt"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/google/uuid"
 	p8e "github.com/provenance-io/provenance/x/metadata/types/p8e"
 	"github.com/stretchr/testify/require"
@@ -141,3 +143,72 @@ func TestAddP8eContractSpecValidation(t *testing.T) {
 	err = msg.ValidateBasic()
 	require.NoError(t, err)
 }
+
+func TestMemorializeContractValidation(t *testing.T) {
+	scopeUUID := uuid.MustParse("8d80b25a-c089-4446-956e-5d08cfe3e1a5")
+	sessionUUID := uuid.MustParse("22fc17a6-40dd-4d68-a95b-ec94e7572a09")
+	msg := NewMsgMemorializeContractRequest()
+	msg.ScopeId = scopeUUID.String()
+	msg.SessionId = sessionUUID.String()
+	msg.ExecutionId = "execution"
+	msg.Notary = "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	msg.Contract = Contract{Spec: RecordReference{Reference: Reference{ScopeId: scopeUUID.String(), Hash: "aGFzaA=="}}}
+
+	err := msg.ValidateBasic()
+	require.EqualError(t, err, "at least one contract signature is required")
+
+	msg.Signatures.Signatures = []*signing.SignatureDescriptor{{}}
+	require.NoError(t, msg.ValidateBasic())
+
+	scopeID, err := msg.ScopeMetadataAddress()
+	require.NoError(t, err)
+	require.Equal(t, ScopeMetadataAddress(scopeUUID), scopeID)
+	sessionID, err := msg.SessionMetadataAddress()
+	require.NoError(t, err)
+	require.Equal(t, SessionMetadataAddress(scopeUUID, sessionUUID), sessionID)
+
+	msg.ScopeId = scopeID.String()
+	msg.SessionId = sessionID.String()
+	require.NoError(t, msg.ValidateBasic(), "bech32 addresses are accepted")
+
+	msg.SessionId = SessionMetadataAddress(uuid.New(), sessionUUID).String()
+	require.EqualError(t, msg.ValidateBasic(), fmt.Sprintf("session %s is not part of scope %s", msg.SessionId, scopeID))
+
+	msg.ScopeId = "invalid"
+	require.EqualError(t, msg.ValidateBasic(), "invalid scope id invalid: expected a scope address or uuid")
+}
+
+func TestChangeOwnershipValidation(t *testing.T) {
+	msg := NewMsgChangeOwnershipRequest()
+	msg.ScopeId = "8d80b25a-c089-4446-956e-5d08cfe3e1a5"
+	msg.SessionId = "22fc17a6-40dd-4d68-a95b-ec94e7572a09"
+	msg.ExecutionId = "execution"
+	msg.Notary = "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	msg.Signatures.Signatures = []*signing.SignatureDescriptor{{}}
+
+	require.EqualError(t, msg.ValidateBasic(), "one of contract or recitals is required")
+
+	msg.Recitals = &Recitals{}
+	require.EqualError(t, msg.ValidateBasic(), "recitals must contain at least one party")
+
+	msg.Recitals.Parties = []*Recital{{Address: "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"}}
+	require.EqualError(t, msg.ValidateBasic(), "invalid recital: invalid party type;  party type not specified")
+
+	msg.Recitals.Parties[0].SignerRole = PartyType_PARTY_TYPE_OWNER
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []Party{{Address: "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck", Role: PartyType_PARTY_TYPE_OWNER}}, msg.GetRecitals().AsParties())
+
+	msg.Contract = &Contract{}
+	require.EqualError(t, msg.ValidateBasic(), "only one of contract or recitals is allowed")
+
+	// a session is only required when a contract is memorialized.
+	msg.Contract = nil
+	msg.SessionId = ""
+	require.NoError(t, msg.ValidateBasic())
+	sessionID, err := msg.SessionMetadataAddress()
+	require.NoError(t, err)
+	require.True(t, sessionID.Empty())
+	msg.Recitals = nil
+	msg.Contract = &Contract{}
+	require.EqualError(t, msg.ValidateBasic(), "session ID is empty")
+}
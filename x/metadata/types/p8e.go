@@ -3,8 +3,11 @@ package types
 import (
 	"encoding/base64"
 	fmt "fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/google/uuid"
+
 	p8e "github.com/provenance-io/provenance/x/metadata/types/p8e"
 )
 
@@ -111,3 +114,128 @@ func convertP8eInputSpecs(old []*p8e.DefinitionSpec) (inputs []*InputSpecificati
 	}
 	return inputs, nil
 }
+
+// ConvertP8eContract converts a v39 executed Contract into a v40 Contract
+func ConvertP8eContract(old *p8e.Contract) (newContract Contract, err error) {
+	if old.Definition == nil || old.Spec == nil || old.Spec.DataLocation == nil || old.Spec.DataLocation.Ref == nil {
+		return newContract, fmt.Errorf("contract definition and spec reference are required")
+	}
+	newContract = Contract{
+		Definition: Definition{
+			Name:           old.Definition.Name,
+			DefinitionType: DefinitionType(old.Definition.Type),
+		},
+		Spec: RecordReference{
+			Name:      old.Spec.Name,
+			Reference: convertP8eLocation(old.Spec.DataLocation),
+		},
+		Inputs:         make([]RecordReference, len(old.Inputs)),
+		Conditions:     make([]Condition, len(old.Conditions)),
+		Considerations: make([]Consideration, len(old.Considerations)),
+	}
+	if old.Definition.ResourceLocation != nil {
+		ref := convertP8eLocation(old.Definition.ResourceLocation)
+		newContract.Definition.ResourceLocation = &ref
+	}
+	for i, in := range old.Inputs {
+		newContract.Inputs[i] = RecordReference{Name: in.Name, Reference: convertP8eLocation(in.DataLocation)}
+	}
+	for i, c := range old.Conditions {
+		newContract.Conditions[i] = Condition{ConditionName: c.ConditionName, Result: convertP8eExecutionResult(c.Result)}
+	}
+	for i, c := range old.Considerations {
+		inputs := make([]ProposedRecord, len(c.Inputs))
+		for j, in := range c.Inputs {
+			inputs[j] = convertP8eProposedFact(in)
+		}
+		newContract.Considerations[i] = Consideration{
+			ConsiderationName: c.ConsiderationName,
+			Inputs:            inputs,
+			Result:            convertP8eExecutionResult(c.Result),
+		}
+	}
+	newContract.Recitals, err = ConvertP8eRecitals(old.Recitals)
+	if err != nil {
+		return Contract{}, err
+	}
+	return newContract, nil
+}
+
+// ConvertP8eRecitals converts a list of v39 Recital into v40 Recitals.  The v39 recital address is the raw account
+// address of the party.
+func ConvertP8eRecitals(old []*p8e.Recital) (Recitals, error) {
+	recitals := Recitals{Parties: make([]*Recital, 0, len(old))}
+	for i, r := range old {
+		if r == nil {
+			continue
+		}
+		if len(r.Address) == 0 {
+			return Recitals{}, fmt.Errorf("recital %d (%s) does not have an address", i, r.SignerRole)
+		}
+		recitals.Parties = append(recitals.Parties, &Recital{
+			SignerRole: PartyType(r.SignerRole),
+			Address:    sdk.AccAddress(r.Address).String(),
+		})
+	}
+	return recitals, nil
+}
+
+// convertP8eLocation converts a v39 Location into a v40 Reference
+func convertP8eLocation(old *p8e.Location) Reference {
+	if old == nil {
+		return Reference{}
+	}
+	ref := Reference{TypeName: old.Classname}
+	if old.Ref != nil {
+		ref.Hash = old.Ref.Hash
+		ref.Name = old.Ref.Name
+		if old.Ref.ScopeUuid != nil {
+			ref.ScopeId = old.Ref.ScopeUuid.Value
+		}
+		if old.Ref.GroupUuid != nil {
+			ref.GroupId = old.Ref.GroupUuid.Value
+		}
+	}
+	return ref
+}
+
+// convertP8eProposedFact converts a v39 ProposedFact into a v40 ProposedRecord
+func convertP8eProposedFact(old *p8e.ProposedFact) ProposedRecord {
+	if old == nil {
+		return ProposedRecord{}
+	}
+	record := ProposedRecord{
+		Name:     old.Name,
+		Hash:     old.Hash,
+		TypeName: old.Classname,
+	}
+	if old.Ancestor != nil {
+		if old.Ancestor.ScopeUuid != nil {
+			record.Ancestor.ScopeId = old.Ancestor.ScopeUuid.Value
+		}
+		if old.Ancestor.GroupUuid != nil {
+			record.Ancestor.GroupId = old.Ancestor.GroupUuid.Value
+		}
+		record.Ancestor.Hash = old.Ancestor.Hash
+		record.Ancestor.Name = old.Ancestor.Name
+	}
+	return record
+}
+
+// convertP8eExecutionResult converts a v39 ExecutionResult into a v40 ExecutionResult
+func convertP8eExecutionResult(old *p8e.ExecutionResult) ExecutionResult {
+	if old == nil {
+		return ExecutionResult{}
+	}
+	result := ExecutionResult{
+		Result:       ResultType(old.Result),
+		ErrorMessage: old.ErrorMessage,
+	}
+	if old.Output != nil {
+		result.Output = convertP8eProposedFact(old.Output)
+	}
+	if old.RecordedAt != nil {
+		result.RecordedAt = time.Unix(old.RecordedAt.Seconds, int64(old.RecordedAt.Nanos)).UTC()
+	}
+	return result
+}
@@ -135,3 +135,53 @@ func (s *P8eTestSuite) TestConvertP8eContractSpec() {
 	}
 
 }
+
+func (s *P8eTestSuite) TestConvertP8eContract() {
+	scopeUUID := uuid.New()
+	ancestorUUID := uuid.New()
+	contract := p8e.Contract{
+		Definition: &p8e.DefinitionSpec{Name: "ExampleContract"},
+		Spec: &p8e.Fact{
+			Name:         "spec",
+			DataLocation: &p8e.Location{Classname: "io.provenance.contracts.ExampleContract", Ref: &p8e.ProvenanceReference{Hash: "aGFzaA=="}},
+		},
+		Considerations: []*p8e.Consideration{
+			{
+				ConsiderationName: "additionalParties",
+				Inputs: []*p8e.ProposedFact{
+					{Name: "parties", Hash: "aW5wdXQ=", Classname: "io.provenance.Parties"},
+					{Name: "previous", Classname: "io.provenance.Parties", Ancestor: &p8e.ProvenanceReference{
+						ScopeUuid: &p8e.UUID{Value: ancestorUUID.String()}, Name: "previous"}},
+				},
+				Result: &p8e.ExecutionResult{
+					Output: &p8e.ProposedFact{Name: "additional_parties", Hash: "b3V0cHV0", Classname: "io.provenance.Parties"},
+					Result: p8e.ExecutionResultType_RESULT_TYPE_PASS,
+				},
+			},
+		},
+		Recitals: []*p8e.Recital{{SignerRole: p8e.PartyType_PARTY_TYPE_OWNER, Address: s.user1Addr}},
+	}
+
+	converted, err := ConvertP8eContract(&contract)
+	s.Require().NoError(err)
+	s.Equal(Recitals{Parties: []*Recital{{SignerRole: PartyType_PARTY_TYPE_OWNER, Address: s.user1}}}, converted.Recitals)
+	s.Equal("aGFzaA==", converted.Spec.Reference.Hash)
+
+	sessionID := SessionMetadataAddress(scopeUUID, uuid.New())
+	records, err := converted.Records(sessionID)
+	s.Require().NoError(err)
+	s.Require().Len(records, 1)
+	s.Equal("additional_parties", records[0].Name)
+	s.Require().Len(records[0].Inputs, 2)
+	s.Equal(RecordInputStatus_Proposed, records[0].Inputs[0].Status)
+	s.Equal(RecordInputStatus_Record, records[0].Inputs[1].Status)
+	s.Equal(&RecordInput_RecordId{RecordId: RecordMetadataAddress(ancestorUUID, "previous")}, records[0].Inputs[1].Source)
+
+	contract.Recitals = []*p8e.Recital{{SignerRole: p8e.PartyType_PARTY_TYPE_OWNER}}
+	_, err = ConvertP8eContract(&contract)
+	s.EqualError(err, "recital 0 (PARTY_TYPE_OWNER) does not have an address")
+
+	contract.Spec = nil
+	_, err = ConvertP8eContract(&contract)
+	s.EqualError(err, "contract definition and spec reference are required")
+}
@@ -72,6 +72,35 @@ func (s *Scope) ValidateBasic() error {
 	return nil
 }
 
+// ChangeOwnership returns a copy of the scope with the owners replaced by the given parties.  Existing data access
+// entries are kept and any party without data access is added to the list.  The value owner is reassigned when exactly
+// one of the parties performs the owner role.
+func (s Scope) ChangeOwnership(parties []Party) Scope {
+	changed := s
+	changed.Owners = parties
+	changed.DataAccess = append([]string{}, s.DataAccess...)
+	valueOwners := []string{}
+	for _, p := range parties {
+		found := false
+		for _, a := range changed.DataAccess {
+			if a == p.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			changed.DataAccess = append(changed.DataAccess, p.Address)
+		}
+		if p.Role == PartyType_PARTY_TYPE_OWNER {
+			valueOwners = append(valueOwners, p.Address)
+		}
+	}
+	if len(valueOwners) == 1 {
+		changed.ValueOwnerAddress = valueOwners[0]
+	}
+	return changed
+}
+
 // String implements stringer interface
 func (s Scope) String() string {
 	out, _ := yaml.Marshal(s)
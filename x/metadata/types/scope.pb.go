@@ -165,12 +165,11 @@ func (m *Scope) GetValueOwnerAddress() string {
 	return ""
 }
 
+// A Session is created for an execution context against a specific specification instance
 //
-//A Session is created for an execution context against a specific specification instance
-//
-//The context will have a specification and set of parties involved.  The Session may be updated several
-//times so long as the parties listed are signers on the transaction.  NOTE: When there are no Records within a Scope
-//that reference a Session it is removed.
+// The context will have a specification and set of parties involved.  The Session may be updated several
+// times so long as the parties listed are signers on the transaction.  NOTE: When there are no Records within a Scope
+// that reference a Session it is removed.
 type Session struct {
 	SessionId MetadataAddress `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,customtype=MetadataAddress" json:"session_id" json:"session_id" yaml:"session_id"`
 	// unique id of the contract specification that was used to create this session.
@@ -310,6 +309,192 @@ func (m *Record) GetOutputs() []RecordOutput {
 	return nil
 }
 
+// RecordVersion is a prior version of a record, kept when the record is replaced or removed.
+type RecordVersion struct {
+	// sequence number of this version within the history of the record, starting at 1
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// the record as it was before it was replaced or removed
+	Record Record `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+	// block height at which this version was replaced or removed
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RecordVersion) Reset()         { *m = RecordVersion{} }
+func (m *RecordVersion) String() string { return proto.CompactTextString(m) }
+func (*RecordVersion) ProtoMessage()    {}
+func (*RecordVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{3}
+}
+func (m *RecordVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordVersion.Merge(m, src)
+}
+func (m *RecordVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordVersion proto.InternalMessageInfo
+
+func (m *RecordVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RecordVersion) GetRecord() Record {
+	if m != nil {
+		return m.Record
+	}
+	return Record{}
+}
+
+func (m *RecordVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// SessionVersion is a prior version of a session, kept when the session is replaced or removed.
+type SessionVersion struct {
+	// sequence number of this version within the history of the session, starting at 1
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// the session as it was before it was replaced or removed
+	Session Session `protobuf:"bytes,2,opt,name=session,proto3" json:"session"`
+	// block height at which this version was replaced or removed
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SessionVersion) Reset()         { *m = SessionVersion{} }
+func (m *SessionVersion) String() string { return proto.CompactTextString(m) }
+func (*SessionVersion) ProtoMessage()    {}
+func (*SessionVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{4}
+}
+func (m *SessionVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionVersion.Merge(m, src)
+}
+func (m *SessionVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionVersion proto.InternalMessageInfo
+
+func (m *SessionVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SessionVersion) GetSession() Session {
+	if m != nil {
+		return m.Session
+	}
+	return Session{}
+}
+
+func (m *SessionVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Execution is a contract execution id that has been recorded, kept so that the same execution (and the signatures
+// made for it) cannot be recorded again.
+type Execution struct {
+	// the unique identifier of the contract execution instance
+	ExecutionId string `protobuf:"bytes,1,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty" yaml:"execution_id"`
+	// the scope the execution was recorded in
+	ScopeId MetadataAddress `protobuf:"bytes,2,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// block height at which the execution was recorded
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *Execution) Reset()         { *m = Execution{} }
+func (m *Execution) String() string { return proto.CompactTextString(m) }
+func (*Execution) ProtoMessage()    {}
+func (*Execution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{5}
+}
+func (m *Execution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Execution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Execution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Execution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Execution.Merge(m, src)
+}
+func (m *Execution) XXX_Size() int {
+	return m.Size()
+}
+func (m *Execution) XXX_DiscardUnknown() {
+	xxx_messageInfo_Execution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Execution proto.InternalMessageInfo
+
+func (m *Execution) GetExecutionId() string {
+	if m != nil {
+		return m.ExecutionId
+	}
+	return ""
+}
+
+func (m *Execution) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // Process contains information used to uniquely identify what was used to generate this record
 type Process struct {
 	// unique identifier for this process
@@ -327,7 +512,7 @@ type Process struct {
 func (m *Process) Reset()      { *m = Process{} }
 func (*Process) ProtoMessage() {}
 func (*Process) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{3}
+	return fileDescriptor_edeea634bfb18aba, []int{6}
 }
 func (m *Process) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +619,7 @@ type RecordInput struct {
 func (m *RecordInput) Reset()      { *m = RecordInput{} }
 func (*RecordInput) ProtoMessage() {}
 func (*RecordInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{4}
+	return fileDescriptor_edeea634bfb18aba, []int{7}
 }
 func (m *RecordInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -533,7 +718,7 @@ type RecordOutput struct {
 func (m *RecordOutput) Reset()      { *m = RecordOutput{} }
 func (*RecordOutput) ProtoMessage() {}
 func (*RecordOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{5}
+	return fileDescriptor_edeea634bfb18aba, []int{8}
 }
 func (m *RecordOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +772,7 @@ type Party struct {
 func (m *Party) Reset()      { *m = Party{} }
 func (*Party) ProtoMessage() {}
 func (*Party) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{6}
+	return fileDescriptor_edeea634bfb18aba, []int{9}
 }
 func (m *Party) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,7 +835,7 @@ func (m *AuditFields) Reset()         { *m = AuditFields{} }
 func (m *AuditFields) String() string { return proto.CompactTextString(m) }
 func (*AuditFields) ProtoMessage()    {}
 func (*AuditFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{7}
+	return fileDescriptor_edeea634bfb18aba, []int{10}
 }
 func (m *AuditFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,6 +912,9 @@ func init() {
 	proto.RegisterType((*Scope)(nil), "provenance.metadata.v1.Scope")
 	proto.RegisterType((*Session)(nil), "provenance.metadata.v1.Session")
 	proto.RegisterType((*Record)(nil), "provenance.metadata.v1.Record")
+	proto.RegisterType((*RecordVersion)(nil), "provenance.metadata.v1.RecordVersion")
+	proto.RegisterType((*SessionVersion)(nil), "provenance.metadata.v1.SessionVersion")
+	proto.RegisterType((*Execution)(nil), "provenance.metadata.v1.Execution")
 	proto.RegisterType((*Process)(nil), "provenance.metadata.v1.Process")
 	proto.RegisterType((*RecordInput)(nil), "provenance.metadata.v1.RecordInput")
 	proto.RegisterType((*RecordOutput)(nil), "provenance.metadata.v1.RecordOutput")
@@ -739,81 +927,88 @@ func init() {
 }
 
 var fileDescriptor_edeea634bfb18aba = []byte{
-	// 1182 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1a, 0x47,
-	0x14, 0x66, 0x01, 0x83, 0x79, 0xd0, 0x86, 0x8c, 0x5d, 0x87, 0xa0, 0x86, 0x25, 0x9b, 0x4a, 0x75,
-	0xdd, 0x06, 0x6a, 0x37, 0x51, 0x2a, 0xbb, 0x3f, 0x04, 0x31, 0x6e, 0x50, 0x53, 0x83, 0x06, 0x5b,
-	0x95, 0x22, 0x25, 0x68, 0xd9, 0x1d, 0xe3, 0x6d, 0x80, 0x59, 0xed, 0x2e, 0x4e, 0x51, 0xef, 0x3d,
-	0xf8, 0x94, 0x53, 0xd5, 0x8b, 0xa5, 0xf6, 0xd4, 0x7f, 0x25, 0xc7, 0x1c, 0xab, 0x1e, 0xb6, 0x91,
-	0x7d, 0xcb, 0x71, 0x7b, 0xea, 0xad, 0xda, 0x99, 0x59, 0x2f, 0x18, 0x70, 0x5b, 0xa9, 0xbd, 0xf1,
-	0xde, 0xfb, 0xde, 0x7b, 0xdf, 0x7c, 0xef, 0xcd, 0x2c, 0xa0, 0x98, 0x16, 0x3d, 0x22, 0x03, 0x75,
-	0xa0, 0x91, 0x72, 0x9f, 0x38, 0xaa, 0xae, 0x3a, 0x6a, 0xf9, 0x68, 0xbd, 0x6c, 0x6b, 0xd4, 0x24,
-	0x25, 0xd3, 0xa2, 0x0e, 0x45, 0x2b, 0x21, 0xa6, 0x14, 0x60, 0x4a, 0x47, 0xeb, 0xf9, 0xe5, 0x2e,
-	0xed, 0x52, 0x06, 0x29, 0xfb, 0xbf, 0x38, 0x3a, 0x2f, 0x77, 0x29, 0xed, 0xf6, 0x48, 0x99, 0x59,
-	0x9d, 0xe1, 0x41, 0xd9, 0x31, 0xfa, 0xc4, 0x76, 0xd4, 0xbe, 0x29, 0x00, 0xc5, 0x8b, 0x00, 0x9d,
-	0xd8, 0x9a, 0x65, 0x98, 0x0e, 0xb5, 0x04, 0x62, 0x6d, 0x1e, 0x29, 0x93, 0x68, 0xc6, 0x81, 0xa1,
-	0xa9, 0x8e, 0x41, 0x07, 0x1c, 0xab, 0xfc, 0x19, 0x85, 0x85, 0x96, 0x4f, 0x16, 0xd5, 0x60, 0x91,
-	0xb1, 0x6e, 0x1b, 0x7a, 0x4e, 0x2a, 0x4a, 0xab, 0x99, 0xea, 0xda, 0x0b, 0x57, 0x8e, 0xfc, 0xe6,
-	0xca, 0x57, 0xbe, 0x12, 0x45, 0x2a, 0xba, 0x6e, 0x11, 0xdb, 0xf6, 0x5c, 0xf9, 0xca, 0x48, 0xed,
-	0xf7, 0x36, 0x95, 0x20, 0x41, 0xc1, 0x49, 0xf6, 0xb3, 0xae, 0xa3, 0xc7, 0x90, 0x9d, 0xe8, 0xe3,
-	0x97, 0x8b, 0xb2, 0x72, 0x1b, 0xf3, 0xcb, 0x5d, 0x13, 0xe5, 0x2e, 0x24, 0x2a, 0xf8, 0xca, 0x84,
-	0xab, 0xae, 0xa3, 0x2d, 0x48, 0xd0, 0x67, 0x03, 0x62, 0xd9, 0xb9, 0x58, 0x31, 0xb6, 0x9a, 0xde,
-	0xb8, 0x51, 0x9a, 0xad, 0x6e, 0xa9, 0xa9, 0x5a, 0xce, 0xa8, 0x1a, 0xf7, 0x7b, 0x62, 0x91, 0x82,
-	0xee, 0x41, 0xda, 0x0f, 0xb7, 0x55, 0x4d, 0x23, 0xb6, 0x9d, 0x8b, 0x17, 0x63, 0xab, 0xa9, 0xea,
-	0x8a, 0xe7, 0xca, 0x88, 0xf7, 0x1f, 0x0b, 0x2a, 0x18, 0x18, 0x45, 0x66, 0xa0, 0x5d, 0x58, 0x3a,
-	0x52, 0x7b, 0x43, 0xd2, 0x66, 0x85, 0xda, 0x2a, 0x27, 0x9e, 0x5b, 0x28, 0x4a, 0xab, 0xa9, 0x6a,
-	0xc1, 0x73, 0xe5, 0x3c, 0x2f, 0x30, 0x03, 0xa4, 0xe0, 0xab, 0xcc, 0xdb, 0xf0, 0x9d, 0xe2, 0xc4,
-	0x9b, 0xf1, 0x1f, 0x7f, 0x92, 0x23, 0xca, 0x2f, 0x31, 0x48, 0xb6, 0x88, 0x6d, 0x1b, 0x74, 0x80,
-	0x1e, 0x03, 0xd8, 0xfc, 0x67, 0xa8, 0xff, 0x67, 0xf3, 0x05, 0xbb, 0xf5, 0x8d, 0x4d, 0x07, 0x9b,
-	0x4a, 0x98, 0xa2, 0x14, 0x85, 0x84, 0xa1, 0x07, 0xa7, 0x84, 0xf1, 0xff, 0x4f, 0xe5, 0x53, 0x48,
-	0x9a, 0xaa, 0xe5, 0x18, 0xe4, 0x5f, 0x8d, 0x25, 0xc8, 0x41, 0xef, 0x43, 0x7c, 0xa0, 0xf6, 0x49,
-	0x2e, 0xce, 0xf4, 0xbc, 0xf6, 0xda, 0x95, 0xe3, 0xce, 0xc8, 0x24, 0x9e, 0x2b, 0xa7, 0x39, 0x05,
-	0xdf, 0x52, 0x30, 0x03, 0xa1, 0x1e, 0x2c, 0xa8, 0x43, 0xdd, 0x70, 0x72, 0x5a, 0x51, 0x5a, 0x4d,
-	0x6f, 0xdc, 0x9a, 0xd7, 0xa9, 0xe2, 0x83, 0x76, 0x0c, 0xd2, 0xd3, 0xed, 0xea, 0xba, 0xe7, 0xca,
-	0xb7, 0xb9, 0x64, 0x2c, 0xf7, 0x03, 0xda, 0x37, 0x1c, 0xd2, 0x37, 0x9d, 0x51, 0xa0, 0xdb, 0x45,
-	0x37, 0xe6, 0x4d, 0xc4, 0xa4, 0x5e, 0x45, 0x21, 0x81, 0x89, 0x46, 0x2d, 0x1d, 0xbd, 0x2b, 0xb8,
-	0x4a, 0x8c, 0xeb, 0xd2, 0x6b, 0x57, 0x8e, 0x1a, 0xba, 0xe7, 0xca, 0x29, 0x5e, 0xc7, 0x97, 0x87,
-	0xf3, 0x9c, 0x9c, 0x68, 0xf4, 0xbf, 0x9e, 0xe8, 0xe7, 0x90, 0x34, 0x2d, 0xca, 0xf6, 0x38, 0xc6,
-	0x84, 0x90, 0xe7, 0x4a, 0xce, 0x61, 0xe7, 0xa2, 0x73, 0x13, 0x55, 0x20, 0x61, 0x0c, 0xcc, 0xa1,
-	0xc3, 0xef, 0xc1, 0x25, 0x42, 0xf2, 0x83, 0xd7, 0x7d, 0x6c, 0x70, 0x9f, 0x78, 0x22, 0xda, 0x86,
-	0x24, 0x1d, 0x3a, 0xac, 0xc6, 0x02, 0xab, 0xf1, 0xce, 0xe5, 0x35, 0x1a, 0x0c, 0x1c, 0x10, 0x11,
-	0xa9, 0x42, 0xe2, 0xef, 0x20, 0x29, 0x88, 0xa2, 0x3c, 0x24, 0x83, 0x1b, 0xc6, 0x54, 0x7e, 0x10,
-	0xc1, 0x81, 0x03, 0x2d, 0x43, 0xfc, 0x50, 0xb5, 0x0f, 0x99, 0x9e, 0x7e, 0x80, 0x59, 0x08, 0x89,
-	0xa1, 0xf8, 0x4a, 0xa4, 0x84, 0xfe, 0x2b, 0x90, 0xe8, 0x13, 0xe7, 0x90, 0xea, 0x7c, 0xad, 0xb0,
-	0xb0, 0x78, 0xbb, 0x6a, 0x06, 0x40, 0x08, 0xe1, 0x0b, 0xfb, 0x43, 0x14, 0xd2, 0x63, 0xc7, 0x3c,
-	0xaf, 0x27, 0x8d, 0xd5, 0xfb, 0x1a, 0x52, 0x16, 0x83, 0x84, 0xe3, 0xfc, 0x78, 0xf6, 0x28, 0x6f,
-	0xf2, 0x51, 0x9e, 0xa3, 0x83, 0x49, 0x86, 0x8e, 0x07, 0x11, 0xbc, 0xc8, 0xad, 0xba, 0x7e, 0x7e,
-	0xa4, 0xd8, 0xc4, 0x91, 0xd6, 0x21, 0xe5, 0x6f, 0x7d, 0x7b, 0xec, 0x62, 0x2c, 0x7b, 0xae, 0x9c,
-	0x0d, 0x2f, 0x04, 0x0b, 0x29, 0x78, 0xd1, 0xff, 0xbd, 0xeb, 0x33, 0xac, 0x40, 0xc2, 0x76, 0x54,
-	0x67, 0xc8, 0x1f, 0xa6, 0x37, 0x37, 0xde, 0xfb, 0x07, 0x13, 0x6d, 0xb1, 0x04, 0x2c, 0x12, 0x85,
-	0x38, 0x8b, 0x90, 0xb0, 0xe9, 0xd0, 0xd2, 0x88, 0x72, 0x00, 0x99, 0xf1, 0xd1, 0xf9, 0xc2, 0x30,
-	0xae, 0x42, 0x18, 0xc6, 0xf4, 0x93, 0xf3, 0xb6, 0x51, 0xd6, 0xf6, 0x92, 0x25, 0xb0, 0x87, 0xbd,
-	0x99, 0x1d, 0x95, 0x27, 0xb0, 0xc0, 0x5e, 0x06, 0x94, 0xbb, 0x30, 0xfb, 0x70, 0xf2, 0x77, 0x21,
-	0x6e, 0xd1, 0x1e, 0x11, 0x4d, 0x6e, 0x5e, 0xfa, 0xc0, 0xec, 0x8d, 0x4c, 0x82, 0x19, 0x5c, 0xd4,
-	0xff, 0x23, 0x06, 0xe9, 0xb1, 0x07, 0x01, 0x3d, 0x81, 0x8c, 0x66, 0x11, 0xd5, 0x21, 0x7a, 0x5b,
-	0x57, 0x1d, 0x3e, 0xe8, 0xf4, 0x46, 0xbe, 0xc4, 0xbf, 0xad, 0xa5, 0xe0, 0xdb, 0x5a, 0xda, 0x0b,
-	0x3e, 0xbe, 0x55, 0xd9, 0x5f, 0x5a, 0xcf, 0x95, 0x97, 0xf8, 0x00, 0xc6, 0xb3, 0x95, 0xe7, 0xbf,
-	0xcb, 0x12, 0x4e, 0x0b, 0xd7, 0xb6, 0xea, 0x10, 0x74, 0x07, 0x20, 0x40, 0x74, 0x46, 0x7c, 0x59,
-	0xab, 0x6f, 0x79, 0xae, 0x7c, 0x75, 0x32, 0xbb, 0x33, 0x52, 0x70, 0x4a, 0x18, 0xd5, 0x11, 0xfa,
-	0x5e, 0x82, 0xcc, 0xd0, 0xd4, 0x43, 0x5a, 0xb1, 0xbf, 0xa5, 0xf5, 0x85, 0xa0, 0xb5, 0xc5, 0x77,
-	0x6e, 0x3c, 0x7b, 0xfa, 0x91, 0x9b, 0x13, 0xe5, 0xf4, 0x45, 0x90, 0xd1, 0x7f, 0x04, 0x10, 0x60,
-	0x3b, 0x23, 0xb1, 0x7d, 0x5b, 0x9e, 0x2b, 0xdf, 0x9b, 0xec, 0xd2, 0x19, 0xcd, 0xef, 0x31, 0x11,
-	0xc3, 0x29, 0xe1, 0xae, 0xb2, 0x09, 0x1f, 0x11, 0xcb, 0x7f, 0xc5, 0xd8, 0x9a, 0xbe, 0x81, 0x03,
-	0x13, 0x35, 0x20, 0xd9, 0x27, 0xb6, 0xad, 0x76, 0x49, 0x2e, 0xc1, 0x5a, 0xde, 0xf5, 0x5c, 0x79,
-	0x9d, 0xb7, 0x14, 0x81, 0xe9, 0x7e, 0xd3, 0x01, 0x1c, 0x54, 0x59, 0xfb, 0x59, 0x82, 0xab, 0x53,
-	0xbb, 0x8e, 0x3e, 0x04, 0x19, 0xd7, 0xee, 0x37, 0xf0, 0x76, 0xbb, 0xbe, 0xdb, 0xdc, 0xdf, 0x6b,
-	0xb7, 0xf6, 0x2a, 0x7b, 0xfb, 0xad, 0xf6, 0xfe, 0x6e, 0xab, 0x59, 0xbb, 0x5f, 0xdf, 0xa9, 0xd7,
-	0xb6, 0xb3, 0x91, 0x7c, 0xfa, 0xf8, 0xa4, 0x98, 0xdc, 0x1f, 0x3c, 0x1d, 0xd0, 0x67, 0x03, 0x54,
-	0x82, 0xb7, 0x67, 0x65, 0x34, 0x71, 0xa3, 0xd9, 0x68, 0xd5, 0xb6, 0xb3, 0x52, 0x3e, 0x73, 0x7c,
-	0x52, 0x5c, 0x6c, 0x5a, 0xd4, 0xa4, 0x36, 0xd1, 0xd1, 0x1a, 0xe4, 0x67, 0xe1, 0xb9, 0x2f, 0x1b,
-	0xcd, 0xc3, 0xf1, 0x49, 0x51, 0x7c, 0x4f, 0xd6, 0x86, 0xfe, 0x0d, 0x0b, 0xef, 0x05, 0xba, 0x01,
-	0xd7, 0x71, 0xad, 0xb5, 0xff, 0x70, 0x36, 0x2f, 0xb4, 0x02, 0x68, 0x32, 0xdc, 0xac, 0xb4, 0x5a,
-	0x59, 0x69, 0xda, 0xdf, 0xfa, 0xb2, 0xde, 0xcc, 0x46, 0xa7, 0xfd, 0x3b, 0x95, 0xfa, 0xc3, 0x6c,
-	0xac, 0xfa, 0xf4, 0xc5, 0x69, 0x41, 0x7a, 0x79, 0x5a, 0x90, 0x5e, 0x9d, 0x16, 0xa4, 0xe7, 0x67,
-	0x85, 0xc8, 0xcb, 0xb3, 0x42, 0xe4, 0xd7, 0xb3, 0x42, 0x04, 0xae, 0x1b, 0x74, 0xce, 0xdd, 0x6a,
-	0x4a, 0x8f, 0xee, 0x74, 0x0d, 0xe7, 0x70, 0xd8, 0x29, 0x69, 0xb4, 0x5f, 0x0e, 0x41, 0xb7, 0x0d,
-	0x3a, 0x66, 0x95, 0xbf, 0x0d, 0xff, 0x75, 0xfa, 0x6f, 0x93, 0xdd, 0x49, 0xb0, 0xc5, 0xfd, 0xe8,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xf1, 0x1e, 0x3b, 0x2e, 0x0b, 0x00, 0x00,
+	// 1294 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xf7, 0xda, 0x8e, 0x1d, 0x3f, 0x1b, 0x30, 0x03, 0xdf, 0x60, 0xac, 0x2f, 0x5e, 0xb3, 0x54,
+	0x6a, 0x9a, 0x16, 0xbb, 0x49, 0x41, 0x54, 0x81, 0x16, 0xd9, 0xc4, 0x14, 0xab, 0x34, 0xb1, 0xc6,
+	0x49, 0x2b, 0x21, 0x81, 0xb5, 0xde, 0x1d, 0x9c, 0x2d, 0xb6, 0x67, 0xb5, 0x3b, 0x1b, 0xb0, 0x7a,
+	0xe8, 0xa1, 0x52, 0x0f, 0x9c, 0x38, 0x55, 0xbd, 0x20, 0xb5, 0x97, 0xf6, 0x5f, 0xe1, 0xc8, 0xb1,
+	0xea, 0x61, 0x8b, 0xe0, 0xc6, 0xd1, 0x3d, 0xf5, 0x56, 0xed, 0xcc, 0x6c, 0xd6, 0x4e, 0xec, 0xd0,
+	0x56, 0xed, 0x6d, 0xde, 0xef, 0xcf, 0x7c, 0xe6, 0xbd, 0xb7, 0x36, 0x68, 0xb6, 0x43, 0xf7, 0xc8,
+	0x50, 0x1f, 0x1a, 0xa4, 0x3a, 0x20, 0x4c, 0x37, 0x75, 0xa6, 0x57, 0xf7, 0x56, 0xab, 0xae, 0x41,
+	0x6d, 0x52, 0xb1, 0x1d, 0xca, 0x28, 0x5a, 0x8a, 0x7c, 0x2a, 0xa1, 0x4f, 0x65, 0x6f, 0xb5, 0x78,
+	0xba, 0x47, 0x7b, 0x94, 0xbb, 0x54, 0x83, 0x93, 0xf0, 0x2e, 0xaa, 0x3d, 0x4a, 0x7b, 0x7d, 0x52,
+	0xe5, 0x52, 0xd7, 0xbb, 0x5f, 0x65, 0xd6, 0x80, 0xb8, 0x4c, 0x1f, 0xd8, 0xd2, 0xa1, 0x7c, 0xd0,
+	0xc1, 0x24, 0xae, 0xe1, 0x58, 0x36, 0xa3, 0x8e, 0xf4, 0x58, 0x99, 0x07, 0xca, 0x26, 0x86, 0x75,
+	0xdf, 0x32, 0x74, 0x66, 0xd1, 0xa1, 0xf0, 0xd5, 0xfe, 0x88, 0xc3, 0x42, 0x3b, 0x00, 0x8b, 0x1a,
+	0xb0, 0xc8, 0x51, 0x77, 0x2c, 0xb3, 0xa0, 0x94, 0x95, 0xe5, 0x5c, 0x7d, 0xe5, 0x99, 0xaf, 0xc6,
+	0x7e, 0xf5, 0xd5, 0x13, 0x9f, 0xc9, 0x24, 0x35, 0xd3, 0x74, 0x88, 0xeb, 0x8e, 0x7d, 0xf5, 0xc4,
+	0x48, 0x1f, 0xf4, 0xd7, 0xb5, 0x30, 0x40, 0xc3, 0x69, 0x7e, 0x6c, 0x9a, 0xe8, 0x2e, 0xe4, 0xa7,
+	0xea, 0x04, 0xe9, 0xe2, 0x3c, 0xdd, 0xda, 0xfc, 0x74, 0x67, 0x64, 0xba, 0x03, 0x81, 0x1a, 0x3e,
+	0x31, 0xa5, 0x6a, 0x9a, 0xe8, 0x2a, 0xa4, 0xe8, 0xc3, 0x21, 0x71, 0xdc, 0x42, 0xa2, 0x9c, 0x58,
+	0xce, 0xae, 0x9d, 0xab, 0xcc, 0x66, 0xb7, 0xd2, 0xd2, 0x1d, 0x36, 0xaa, 0x27, 0x83, 0x9a, 0x58,
+	0x86, 0xa0, 0x2b, 0x90, 0x0d, 0xcc, 0x1d, 0xdd, 0x30, 0x88, 0xeb, 0x16, 0x92, 0xe5, 0xc4, 0x72,
+	0xa6, 0xbe, 0x34, 0xf6, 0x55, 0x24, 0xea, 0x4f, 0x18, 0x35, 0x0c, 0x1c, 0x22, 0x17, 0xd0, 0x26,
+	0x9c, 0xda, 0xd3, 0xfb, 0x1e, 0xe9, 0xf0, 0x44, 0x1d, 0x5d, 0x00, 0x2f, 0x2c, 0x94, 0x95, 0xe5,
+	0x4c, 0xbd, 0x34, 0xf6, 0xd5, 0xa2, 0x48, 0x30, 0xc3, 0x49, 0xc3, 0x27, 0xb9, 0x76, 0x2b, 0x50,
+	0xca, 0x1b, 0xaf, 0x27, 0xbf, 0xff, 0x41, 0x8d, 0x69, 0x3f, 0x27, 0x20, 0xdd, 0x26, 0xae, 0x6b,
+	0xd1, 0x21, 0xba, 0x0b, 0xe0, 0x8a, 0x63, 0xc4, 0xff, 0xc7, 0xf3, 0x09, 0xbb, 0xf0, 0xa5, 0x4b,
+	0x87, 0xeb, 0x5a, 0x14, 0xa2, 0x95, 0x25, 0x85, 0x91, 0x06, 0x67, 0xa4, 0xf0, 0xdf, 0xbf, 0xca,
+	0x47, 0x90, 0xb6, 0x75, 0x87, 0x59, 0xe4, 0x6f, 0x3d, 0x4b, 0x18, 0x83, 0xde, 0x85, 0xe4, 0x50,
+	0x1f, 0x90, 0x42, 0x92, 0xf3, 0x79, 0xe6, 0xb5, 0xaf, 0x26, 0xd9, 0xc8, 0x26, 0x63, 0x5f, 0xcd,
+	0x0a, 0x08, 0x81, 0xa4, 0x61, 0xee, 0x84, 0xfa, 0xb0, 0xa0, 0x7b, 0xa6, 0xc5, 0x0a, 0x46, 0x59,
+	0x59, 0xce, 0xae, 0x5d, 0x98, 0x57, 0xa9, 0x16, 0x38, 0xdd, 0xb4, 0x48, 0xdf, 0x74, 0xeb, 0xab,
+	0x63, 0x5f, 0xbd, 0x28, 0x28, 0xe3, 0xb1, 0xef, 0xd1, 0x81, 0xc5, 0xc8, 0xc0, 0x66, 0xa3, 0x90,
+	0xb7, 0x83, 0x6a, 0x2c, 0x8a, 0xc8, 0x97, 0x7a, 0x11, 0x87, 0x14, 0x26, 0x06, 0x75, 0x4c, 0xf4,
+	0xb6, 0xc4, 0xaa, 0x70, 0xac, 0xa7, 0x5e, 0xfb, 0x6a, 0xdc, 0x32, 0xc7, 0xbe, 0x9a, 0x11, 0x79,
+	0x02, 0x7a, 0x04, 0xce, 0xe9, 0x17, 0x8d, 0xff, 0xdb, 0x2f, 0x7a, 0x1d, 0xd2, 0xb6, 0x43, 0x79,
+	0x1f, 0x27, 0x38, 0x11, 0xea, 0x5c, 0xca, 0x85, 0xdb, 0x3e, 0xe9, 0x42, 0x44, 0x35, 0x48, 0x59,
+	0x43, 0xdb, 0x63, 0x62, 0x0e, 0x8e, 0x20, 0x52, 0x5c, 0xbc, 0x19, 0xf8, 0x86, 0xf3, 0x24, 0x02,
+	0xd1, 0x06, 0xa4, 0xa9, 0xc7, 0x78, 0x8e, 0x05, 0x9e, 0xe3, 0xad, 0xa3, 0x73, 0x6c, 0x79, 0x2c,
+	0x4a, 0x12, 0x86, 0x4a, 0x8a, 0xbf, 0x86, 0x63, 0xc2, 0xe9, 0x73, 0xe2, 0xf0, 0x89, 0x28, 0x40,
+	0x7a, 0x4f, 0x1c, 0x39, 0xd7, 0x49, 0x1c, 0x8a, 0xe8, 0x1a, 0xa4, 0x1c, 0xee, 0xca, 0x59, 0xcd,
+	0xae, 0x95, 0x8e, 0xae, 0x1a, 0x82, 0x16, 0x31, 0x68, 0x09, 0x52, 0xbb, 0xc4, 0xea, 0xed, 0x32,
+	0xce, 0x5b, 0x02, 0x4b, 0x49, 0xfb, 0x46, 0x81, 0xe3, 0x72, 0x1a, 0xdf, 0x0c, 0xe1, 0x3a, 0xa4,
+	0xe5, 0x53, 0x14, 0xe2, 0x47, 0xb3, 0x2f, 0x53, 0x86, 0x97, 0x96, 0x51, 0x73, 0x51, 0xfc, 0xa4,
+	0x40, 0xa6, 0xf1, 0x88, 0x18, 0x5e, 0x30, 0x59, 0x68, 0x1d, 0x72, 0x24, 0x14, 0xc2, 0xbd, 0x90,
+	0xa9, 0x9f, 0x19, 0xfb, 0xea, 0x29, 0xd1, 0x1c, 0x93, 0x56, 0x0d, 0x67, 0xf7, 0xc5, 0xa6, 0x39,
+	0xb5, 0xcf, 0xe3, 0xff, 0x7c, 0x9f, 0xcf, 0x03, 0xfa, 0x15, 0xa4, 0x65, 0x63, 0xa1, 0x22, 0xa4,
+	0xc3, 0x8d, 0xc8, 0x01, 0xde, 0x8a, 0xe1, 0x50, 0x81, 0x4e, 0x43, 0x72, 0x57, 0x77, 0x77, 0x0b,
+	0x71, 0x69, 0xe0, 0x12, 0x42, 0x72, 0x88, 0x82, 0x94, 0x19, 0x39, 0x2f, 0x4b, 0x90, 0x1a, 0x10,
+	0xb6, 0x4b, 0x4d, 0xb1, 0x06, 0xb0, 0x94, 0x44, 0x7b, 0xd4, 0x73, 0x00, 0xb2, 0x71, 0x03, 0x78,
+	0xdf, 0xc5, 0x21, 0x3b, 0xd1, 0x96, 0xfb, 0xf9, 0x94, 0x89, 0x7c, 0x5f, 0x40, 0x46, 0xbc, 0x78,
+	0x44, 0xc0, 0x87, 0xb3, 0x2f, 0x7f, 0x5e, 0x8c, 0xde, 0xbe, 0x77, 0x38, 0x79, 0x91, 0xe2, 0x56,
+	0x0c, 0x2f, 0x0a, 0xa9, 0x69, 0xee, 0x5f, 0x29, 0x31, 0x75, 0xa5, 0x55, 0xc8, 0x04, 0x5b, 0xaa,
+	0x33, 0xb1, 0xc8, 0x4e, 0x8f, 0x7d, 0x35, 0x1f, 0x2d, 0x30, 0x6e, 0xd2, 0xf0, 0x62, 0x70, 0xde,
+	0x0c, 0x10, 0xd6, 0x20, 0xe5, 0x32, 0x9d, 0x79, 0xe2, 0x43, 0x72, 0x7c, 0xed, 0x9d, 0xbf, 0x30,
+	0x81, 0x6d, 0x1e, 0x80, 0x65, 0xa0, 0x24, 0x67, 0x11, 0x52, 0x2e, 0xf5, 0x1c, 0x83, 0x68, 0xf7,
+	0x21, 0x37, 0x39, 0x6a, 0x01, 0x31, 0x1c, 0xab, 0x24, 0x86, 0x23, 0xbd, 0xb6, 0x5f, 0x36, 0xce,
+	0xcb, 0x1e, 0x31, 0xb4, 0xae, 0xd7, 0x9f, 0x59, 0x51, 0xbb, 0x07, 0x0b, 0x7c, 0x93, 0x07, 0x23,
+	0x32, 0xf5, 0xf6, 0xd1, 0xcb, 0x5f, 0x86, 0xa4, 0x43, 0xfb, 0x44, 0x16, 0x39, 0x7f, 0xe4, 0x07,
+	0x61, 0x7b, 0x64, 0x13, 0xcc, 0xdd, 0x65, 0xfe, 0xdf, 0x13, 0x90, 0x9d, 0x58, 0xe0, 0xe8, 0x1e,
+	0xe4, 0x0c, 0x87, 0xe8, 0x8c, 0x98, 0x1d, 0x53, 0x67, 0xe2, 0xa1, 0xb3, 0x6b, 0xc5, 0x8a, 0xf8,
+	0x2d, 0x54, 0x09, 0x7f, 0x0b, 0x55, 0xb6, 0xc3, 0x1f, 0x4b, 0x75, 0x35, 0x68, 0xf6, 0x68, 0x50,
+	0x26, 0xa3, 0xb5, 0x27, 0xbf, 0xa9, 0x0a, 0xce, 0x4a, 0xd5, 0x86, 0xce, 0x08, 0xba, 0x04, 0x10,
+	0x7a, 0x74, 0x47, 0xa2, 0x59, 0xeb, 0xff, 0x1b, 0xfb, 0xea, 0xc9, 0xe9, 0xe8, 0xee, 0x48, 0xc3,
+	0x19, 0x29, 0xd4, 0x47, 0xe8, 0x5b, 0x05, 0x72, 0x9e, 0x6d, 0x46, 0xb0, 0x12, 0x6f, 0x84, 0xf5,
+	0x89, 0x84, 0x75, 0x55, 0xf4, 0xdc, 0x64, 0xf4, 0xe1, 0x8f, 0xd2, 0x1c, 0xab, 0x80, 0x2f, 0x8d,
+	0x1c, 0xfe, 0x1d, 0x80, 0xd0, 0xb7, 0x3b, 0x92, 0xdd, 0x77, 0x75, 0xec, 0xab, 0x57, 0xa6, 0xab,
+	0x74, 0x47, 0xf3, 0x6b, 0x4c, 0xd9, 0x70, 0x46, 0xaa, 0xeb, 0xa3, 0xc9, 0x25, 0x18, 0xb4, 0xe9,
+	0xb1, 0x68, 0x09, 0x6e, 0x41, 0x7a, 0x40, 0x5c, 0x57, 0xef, 0x91, 0x42, 0x8a, 0x97, 0xbc, 0x3c,
+	0xf6, 0xd5, 0x55, 0x51, 0x52, 0x1a, 0x0e, 0xd7, 0x3b, 0x6c, 0xc0, 0x61, 0x96, 0x95, 0x1f, 0x15,
+	0x38, 0x79, 0xa8, 0xd7, 0xd1, 0xfb, 0xa0, 0xe2, 0xc6, 0x8d, 0x2d, 0xbc, 0xd1, 0x69, 0x6e, 0xb6,
+	0x76, 0xb6, 0x3b, 0xed, 0xed, 0xda, 0xf6, 0x4e, 0xbb, 0xb3, 0xb3, 0xd9, 0x6e, 0x35, 0x6e, 0x34,
+	0x6f, 0x36, 0x1b, 0x1b, 0xf9, 0x58, 0x31, 0xfb, 0xf8, 0x69, 0x39, 0xbd, 0x33, 0x7c, 0x30, 0xa4,
+	0x0f, 0x87, 0xa8, 0x02, 0xff, 0x9f, 0x15, 0xd1, 0xc2, 0x5b, 0xad, 0xad, 0x76, 0x63, 0x23, 0xaf,
+	0x14, 0x73, 0x8f, 0x9f, 0x96, 0x17, 0x5b, 0x0e, 0xb5, 0xa9, 0x4b, 0x4c, 0xb4, 0x02, 0xc5, 0x59,
+	0xfe, 0x42, 0x97, 0x8f, 0x17, 0xe1, 0xf1, 0xd3, 0xb2, 0xfc, 0xfe, 0xaf, 0x78, 0x90, 0x9b, 0x9c,
+	0x0b, 0x74, 0x0e, 0xce, 0xe2, 0x46, 0x7b, 0xe7, 0xf6, 0x6c, 0x5c, 0x68, 0x09, 0xd0, 0xb4, 0xb9,
+	0x55, 0x6b, 0xb7, 0xf3, 0xca, 0x61, 0x7d, 0xfb, 0xd3, 0x66, 0x2b, 0x1f, 0x3f, 0xac, 0xbf, 0x59,
+	0x6b, 0xde, 0xce, 0x27, 0xea, 0x0f, 0x9e, 0xbd, 0x2c, 0x29, 0xcf, 0x5f, 0x96, 0x94, 0x17, 0x2f,
+	0x4b, 0xca, 0x93, 0x57, 0xa5, 0xd8, 0xf3, 0x57, 0xa5, 0xd8, 0x2f, 0xaf, 0x4a, 0x31, 0x38, 0x6b,
+	0xd1, 0x39, 0xb3, 0xd5, 0x52, 0xee, 0x5c, 0xea, 0x59, 0x6c, 0xd7, 0xeb, 0x56, 0x0c, 0x3a, 0xa8,
+	0x46, 0x4e, 0x17, 0x2d, 0x3a, 0x21, 0x55, 0x1f, 0x45, 0xff, 0x12, 0x82, 0xdd, 0xe4, 0x76, 0x53,
+	0xbc, 0x71, 0x3f, 0xf8, 0x73, 0x00, 0x08, 0x4d, 0xdf, 0x40, 0xde, 0x0c, 0x00, 0x00,
 }
 
 func (m *Scope) Marshal() (dAtA []byte, err error) {
@@ -1045,6 +1240,137 @@ func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecordVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Version != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Version != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Execution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Execution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Execution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ExecutionId) > 0 {
+		i -= len(m.ExecutionId)
+		copy(dAtA[i:], m.ExecutionId)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.ExecutionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Process) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1312,12 +1638,12 @@ func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedDate):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedDate):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintScope(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintScope(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.CreatedBy) > 0 {
@@ -1327,12 +1653,12 @@ func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedDate):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedDate):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintScope(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintScope(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1434,60 +1760,112 @@ func (m *Record) Size() (n int) {
 	return n
 }
 
-func (m *Process) Size() (n int) {
+func (m *RecordVersion) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ProcessId != nil {
-		n += m.ProcessId.Size()
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovScope(uint64(m.Version))
 	}
-	l = len(m.Method)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	l = m.Record.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovScope(uint64(m.Height))
 	}
 	return n
 }
 
-func (m *Process_Address) Size() (n int) {
+func (m *SessionVersion) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	if m.Version != 0 {
+		n += 1 + sovScope(uint64(m.Version))
+	}
+	l = m.Session.Size()
 	n += 1 + l + sovScope(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovScope(uint64(m.Height))
+	}
 	return n
 }
-func (m *Process_Hash) Size() (n int) {
+
+func (m *Execution) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Hash)
+	l = len(m.ExecutionId)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = m.ScopeId.Size()
 	n += 1 + l + sovScope(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovScope(uint64(m.Height))
+	}
 	return n
 }
-func (m *RecordInput) Size() (n int) {
+
+func (m *Process) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.ProcessId != nil {
+		n += m.ProcessId.Size()
+	}
 	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovScope(uint64(l))
 	}
-	if m.Source != nil {
-		n += m.Source.Size()
-	}
-	l = len(m.TypeName)
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func (m *Process_Address) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+func (m *Process_Hash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+func (m *RecordInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.Source != nil {
+		n += m.Source.Size()
+	}
+	l = len(m.TypeName)
 	if l > 0 {
 		n += 1 + l + sovScope(uint64(l))
 	}
@@ -2231,6 +2609,382 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RecordVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Execution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Execution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Execution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Process) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1110,6 +1110,97 @@ func (m *MsgAddP8EContractSpecResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddP8EContractSpecResponse proto.InternalMessageInfo
 
+// MsgP8eMemorializeContractRequest records the result of a p8e v39 contract execution
+type MsgP8EMemorializeContractRequest struct {
+	// The scope id (uuid) of the object being added or modified on blockchain.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty" yaml:"scope_id"`
+	// The uuid of the contract execution, used as the session id.
+	GroupId string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty" yaml:"group_id"`
+	// Unique identifier for determining contract/session execution instance
+	ExecutionId string `protobuf:"bytes,3,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty" yaml:"execution_id"`
+	// The scope specification id (uuid) used when the scope does not exist yet.
+	ScopeSpecificationId string `protobuf:"bytes,4,opt,name=scope_specification_id,json=scopeSpecificationId,proto3" json:"scope_specification_id,omitempty" yaml:"scope_specification_id"`
+	// The new recitals of a direct ownership change.  These are not covered by the contract signatures so they are not
+	// accepted along with a contract, new scopes are owned by the contract recitals and existing scope ownership is
+	// changed with ChangeOwnership.
+	Recitals *p8e.Recitals `protobuf:"bytes,5,opt,name=recitals,proto3" json:"recitals,omitempty"`
+	// The executed p8e contract.
+	Contract p8e.Contract `protobuf:"bytes,6,opt,name=contract,proto3" json:"contract"`
+	// The p8e contract signatures
+	Signatures p8e.SignatureSet `protobuf:"bytes,7,opt,name=signatures,proto3" json:"signatures"`
+	// The address of the notary (ie the broadcaster of this message).
+	Invoker string `protobuf:"bytes,8,opt,name=invoker,proto3" json:"invoker,omitempty"`
+}
+
+func (m *MsgP8EMemorializeContractRequest) Reset()      { *m = MsgP8EMemorializeContractRequest{} }
+func (*MsgP8EMemorializeContractRequest) ProtoMessage() {}
+func (*MsgP8EMemorializeContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{28}
+}
+func (m *MsgP8EMemorializeContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgP8EMemorializeContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgP8EMemorializeContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgP8EMemorializeContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgP8EMemorializeContractRequest.Merge(m, src)
+}
+func (m *MsgP8EMemorializeContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgP8EMemorializeContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgP8EMemorializeContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgP8EMemorializeContractRequest proto.InternalMessageInfo
+
+// MsgP8eMemorializeContractResponse from a p8e contract execution memorialization request
+type MsgP8EMemorializeContractResponse struct {
+}
+
+func (m *MsgP8EMemorializeContractResponse) Reset()         { *m = MsgP8EMemorializeContractResponse{} }
+func (m *MsgP8EMemorializeContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgP8EMemorializeContractResponse) ProtoMessage()    {}
+func (*MsgP8EMemorializeContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{29}
+}
+func (m *MsgP8EMemorializeContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgP8EMemorializeContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgP8EMemorializeContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgP8EMemorializeContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgP8EMemorializeContractResponse.Merge(m, src)
+}
+func (m *MsgP8EMemorializeContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgP8EMemorializeContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgP8EMemorializeContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgP8EMemorializeContractResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgMemorializeContractRequest)(nil), "provenance.metadata.v1.MsgMemorializeContractRequest")
 	proto.RegisterType((*MsgMemorializeContractResponse)(nil), "provenance.metadata.v1.MsgMemorializeContractResponse")
@@ -1139,91 +1230,102 @@ func init() {
 	proto.RegisterType((*MsgDeleteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationResponse")
 	proto.RegisterType((*MsgAddP8EContractSpecRequest)(nil), "provenance.metadata.v1.MsgAddP8eContractSpecRequest")
 	proto.RegisterType((*MsgAddP8EContractSpecResponse)(nil), "provenance.metadata.v1.MsgAddP8eContractSpecResponse")
+	proto.RegisterType((*MsgP8EMemorializeContractRequest)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractRequest")
+	proto.RegisterType((*MsgP8EMemorializeContractResponse)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractResponse")
 }
 
 func init() { proto.RegisterFile("provenance/metadata/v1/tx.proto", fileDescriptor_3a3a0892f91e3036) }
 
 var fileDescriptor_3a3a0892f91e3036 = []byte{
-	// 1260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0xdc, 0x54,
-	0x14, 0x9d, 0x97, 0x69, 0xf3, 0x71, 0x13, 0x48, 0x79, 0x6d, 0x92, 0xc9, 0xd0, 0x8c, 0xa7, 0x6e,
-	0x53, 0xa2, 0x7c, 0xd8, 0xca, 0x90, 0x96, 0x34, 0x2d, 0x48, 0x99, 0x16, 0x89, 0x08, 0x8d, 0xa8,
-	0x26, 0x02, 0x04, 0x12, 0x42, 0x8e, 0xfd, 0xea, 0x58, 0x24, 0xf6, 0xe0, 0xe7, 0x84, 0x09, 0x08,
-	0x89, 0x05, 0x42, 0x15, 0x12, 0x08, 0x09, 0x09, 0xb1, 0x40, 0x28, 0x1b, 0xd4, 0x05, 0x0b, 0x10,
-	0x4b, 0x76, 0x6c, 0x50, 0x97, 0x5d, 0x22, 0x84, 0x22, 0x94, 0x6c, 0x58, 0xe7, 0x17, 0x20, 0xdb,
-	0xd7, 0x33, 0xf6, 0xc4, 0x1f, 0x33, 0x51, 0x16, 0x5d, 0x44, 0x8a, 0xed, 0x73, 0xdf, 0x3b, 0xe7,
-	0x9e, 0x77, 0x7d, 0xaf, 0x07, 0x84, 0x86, 0x6d, 0xed, 0x32, 0x53, 0x31, 0x55, 0x26, 0x6f, 0x33,
-	0x47, 0xd1, 0x14, 0x47, 0x91, 0x77, 0x17, 0x65, 0xa7, 0x29, 0x35, 0x6c, 0xcb, 0xb1, 0xe8, 0x78,
-	0x1b, 0x20, 0x05, 0x00, 0x69, 0x77, 0xb1, 0x78, 0x49, 0xb7, 0x74, 0xcb, 0x83, 0xc8, 0xee, 0x7f,
-	0x3e, 0xba, 0x28, 0x26, 0x2c, 0xc7, 0x55, 0xab, 0xc1, 0x10, 0x33, 0x9d, 0x80, 0x51, 0x2d, 0xd3,
-	0xb1, 0x15, 0xd5, 0x41, 0xd8, 0x6c, 0xd2, 0x52, 0x0d, 0xa6, 0x1a, 0x0f, 0x0c, 0x55, 0x71, 0x0c,
-	0xcb, 0x44, 0xec, 0xb5, 0x04, 0x6c, 0x63, 0x99, 0xb9, 0x7f, 0x88, 0x7a, 0x41, 0xb5, 0xf8, 0xb6,
-	0xc5, 0x65, 0xa7, 0x29, 0x73, 0x43, 0x37, 0x0d, 0x53, 0x97, 0x77, 0x17, 0x37, 0x98, 0xa3, 0x2c,
-	0x06, 0xd7, 0x3e, 0x50, 0xfc, 0xa3, 0x0f, 0xa6, 0x6a, 0x5c, 0xaf, 0xb1, 0x6d, 0xcb, 0x36, 0x94,
-	0x2d, 0xe3, 0x63, 0x76, 0x17, 0xb9, 0xd5, 0xd9, 0x87, 0x3b, 0x8c, 0x3b, 0x74, 0x12, 0x06, 0x3d,
-	0x49, 0xef, 0x1b, 0x5a, 0x81, 0x94, 0xc9, 0xcc, 0x50, 0x7d, 0xc0, 0xbb, 0x5e, 0xd3, 0xe8, 0x14,
-	0x00, 0x67, 0x9c, 0x1b, 0x96, 0xe9, 0x3e, 0xec, 0xf3, 0x1e, 0x0e, 0xe1, 0x9d, 0x35, 0x8d, 0x5e,
-	0x81, 0x11, 0xd6, 0x64, 0xea, 0x8e, 0x83, 0x80, 0xbc, 0x07, 0x18, 0x6e, 0xdd, 0x5b, 0xd3, 0x68,
-	0x15, 0x06, 0x83, 0x5c, 0x14, 0xce, 0x95, 0xc9, 0xcc, 0x70, 0xa5, 0x2c, 0xc5, 0xbb, 0x20, 0x05,
-	0xbc, 0xaa, 0xe7, 0x1e, 0x1f, 0x08, 0xb9, 0x7a, 0x2b, 0x8e, 0xbe, 0x09, 0xe0, 0x6a, 0x52, 0x9c,
-	0x1d, 0x9b, 0xf1, 0xc2, 0x79, 0x6f, 0x15, 0x59, 0xf2, 0x13, 0x20, 0x39, 0x4d, 0x29, 0x10, 0x8c,
-	0x09, 0x90, 0xd6, 0x03, 0xf0, 0x3d, 0xc6, 0x55, 0xdb, 0x68, 0x38, 0x96, 0xcd, 0x71, 0xd1, 0xd0,
-	0x42, 0x74, 0x1c, 0xfa, 0x4d, 0xcb, 0x51, 0xec, 0xbd, 0x42, 0xbf, 0xc7, 0x1b, 0xaf, 0x56, 0x2e,
-	0x3c, 0xdc, 0x17, 0x72, 0xdf, 0xef, 0x0b, 0xb9, 0xff, 0xf6, 0x85, 0xdc, 0x67, 0xff, 0x94, 0x73,
-	0x62, 0x19, 0x4a, 0x49, 0x29, 0xe4, 0x0d, 0xcb, 0xe4, 0x4c, 0xfc, 0x3d, 0x0f, 0x93, 0x35, 0xae,
-	0xdf, 0xdd, 0x54, 0x4c, 0x9d, 0xbd, 0xf1, 0x91, 0xc9, 0x6c, 0xbe, 0x69, 0x34, 0x82, 0x0c, 0x4b,
-	0x9d, 0x19, 0xae, 0x5e, 0x3c, 0x3e, 0x10, 0x46, 0xf7, 0x94, 0xed, 0xad, 0x15, 0x31, 0x78, 0x22,
-	0xb6, 0xd3, 0xbe, 0x74, 0x32, 0xed, 0xd5, 0xb1, 0xe3, 0x03, 0xe1, 0x39, 0x8c, 0x68, 0x3d, 0x13,
-	0xc3, 0x6e, 0xac, 0xc4, 0xb9, 0x51, 0x9d, 0x38, 0x3e, 0x10, 0x2e, 0xfa, 0x71, 0xe1, 0xa7, 0x62,
-	0xd4, 0xa6, 0x3b, 0x30, 0x68, 0x33, 0xd5, 0x70, 0x94, 0x2d, 0x9e, 0x65, 0x53, 0x1d, 0x71, 0xf5,
-	0x56, 0x84, 0x1b, 0xdd, 0x32, 0xf9, 0x7c, 0x77, 0x26, 0x27, 0xda, 0xdb, 0x7f, 0xf6, 0xf6, 0x0e,
-	0x64, 0xd8, 0x7b, 0x19, 0x8a, 0x71, 0xde, 0xa1, 0xb5, 0x9f, 0x00, 0xad, 0x71, 0x7d, 0x55, 0xd3,
-	0xd6, 0x5d, 0x77, 0x02, 0x4b, 0x6f, 0xc1, 0x79, 0xcf, 0x2d, 0xcf, 0xcf, 0xe1, 0xca, 0x54, 0x92,
-	0x5e, 0x2f, 0x08, 0xd9, 0xf9, 0x11, 0xb4, 0x00, 0x03, 0x2e, 0x4d, 0x66, 0xf3, 0x42, 0x5f, 0x39,
-	0xef, 0x95, 0x9b, 0x7f, 0x19, 0x43, 0x6d, 0x0c, 0x2e, 0x46, 0x36, 0x47, 0x4e, 0x5f, 0x12, 0x18,
-	0xab, 0x71, 0xfd, 0x1e, 0xdb, 0x62, 0x0e, 0x8b, 0xf0, 0x7a, 0xb5, 0xe3, 0xa8, 0x8d, 0x54, 0x67,
-	0xdd, 0xbd, 0xff, 0x3e, 0x10, 0x46, 0x6b, 0x48, 0x6b, 0x55, 0xd3, 0x6c, 0xc6, 0x79, 0xea, 0x09,
-	0xec, 0x85, 0x63, 0x01, 0xc6, 0x3b, 0xb9, 0x20, 0xcd, 0x4f, 0xe1, 0x12, 0xb2, 0xf7, 0x0f, 0x69,
-	0x3b, 0x79, 0x03, 0x78, 0x6c, 0x31, 0x7d, 0x42, 0x62, 0xfa, 0x30, 0x30, 0xc0, 0xf7, 0x44, 0x6c,
-	0xc2, 0x4b, 0x52, 0x78, 0x7b, 0xe4, 0xf5, 0x27, 0x09, 0xd2, 0x5a, 0x67, 0xaa, 0x65, 0x6b, 0x01,
-	0xaf, 0xd7, 0x23, 0x75, 0xe7, 0xa7, 0x6f, 0x3e, 0x39, 0x7d, 0xe9, 0xe5, 0x78, 0x13, 0xfa, 0x6d,
-	0x6f, 0x75, 0xaf, 0x80, 0x87, 0x2b, 0xa5, 0x94, 0x82, 0x72, 0x39, 0x20, 0x3a, 0xac, 0x30, 0x9f,
-	0xa5, 0x70, 0x3c, 0x48, 0x70, 0xa0, 0x03, 0x05, 0x7e, 0x4d, 0x42, 0x9e, 0x44, 0x35, 0xbe, 0x06,
-	0x43, 0xfe, 0x46, 0x6d, 0x89, 0x73, 0xc9, 0x12, 0x2f, 0xf8, 0x12, 0x5b, 0x11, 0xa2, 0x57, 0xf5,
-	0x96, 0xad, 0xf5, 0x78, 0x46, 0x26, 0x61, 0xe2, 0x04, 0x1f, 0xe4, 0xfa, 0x13, 0x01, 0x21, 0x74,
-	0xc6, 0xd7, 0xc3, 0x2d, 0x31, 0x20, 0xfd, 0x16, 0x3c, 0x13, 0x69, 0x95, 0x78, 0x6c, 0x66, 0x53,
-	0xab, 0x2e, 0xb2, 0x12, 0x96, 0x60, 0x74, 0x99, 0x9e, 0x24, 0x88, 0x50, 0x4e, 0xa6, 0x89, 0x5a,
-	0x7e, 0x21, 0x20, 0x46, 0x6b, 0x21, 0x56, 0xce, 0x7b, 0x70, 0x21, 0xc2, 0xa3, 0x6d, 0x45, 0x25,
-	0xd9, 0x8a, 0x09, 0x3c, 0x6d, 0x1d, 0x81, 0x62, 0x7d, 0x34, 0x72, 0xab, 0x47, 0x63, 0xa6, 0xe1,
-	0x6a, 0x2a, 0x61, 0x14, 0xf6, 0xb3, 0x2f, 0x6c, 0x55, 0xd3, 0x82, 0x17, 0x78, 0xac, 0xb0, 0x77,
-	0xe2, 0x7d, 0x5a, 0xc8, 0xea, 0x06, 0x67, 0x6c, 0x95, 0x2f, 0x2a, 0x99, 0x2c, 0x8a, 0xfa, 0x8d,
-	0xc0, 0x74, 0x4b, 0x7c, 0xaa, 0xae, 0xa7, 0xc8, 0xb0, 0x19, 0xb8, 0x9e, 0xc5, 0x19, 0xe5, 0x3d,
-	0x22, 0xc1, 0x89, 0xf5, 0x2b, 0x2e, 0x56, 0xd9, 0xdb, 0xf1, 0x8e, 0xcd, 0xa5, 0xbf, 0xac, 0xce,
-	0xd8, 0xaf, 0xab, 0x70, 0x25, 0x85, 0x28, 0xca, 0xf9, 0x95, 0x84, 0x8e, 0x6a, 0x8a, 0xa2, 0xa7,
-	0xc8, 0xab, 0xeb, 0x70, 0x2d, 0x9d, 0x31, 0x4a, 0xfb, 0x91, 0xc0, 0x65, 0x3f, 0x01, 0xf7, 0x97,
-	0x23, 0xa6, 0x06, 0x9a, 0xea, 0x30, 0x12, 0x8c, 0x4b, 0x2e, 0x1f, 0x34, 0x69, 0x26, 0xc9, 0x24,
-	0xf7, 0x33, 0x21, 0xbc, 0x0c, 0x3a, 0x14, 0x59, 0xa3, 0x27, 0x21, 0x82, 0xf7, 0x0d, 0x11, 0xc7,
-	0xcf, 0x57, 0x50, 0x79, 0xf4, 0x2c, 0xe4, 0x6b, 0x5c, 0xa7, 0x9f, 0xbb, 0x9d, 0xf5, 0xe4, 0x9c,
-	0x4c, 0x6f, 0x24, 0x51, 0x4d, 0xfd, 0x34, 0x29, 0xde, 0xec, 0x35, 0xcc, 0xa7, 0x43, 0x9b, 0x30,
-	0xda, 0x31, 0xce, 0xd1, 0xc5, 0x94, 0xa5, 0xe2, 0xc7, 0xf6, 0x62, 0xa5, 0x97, 0x10, 0xdc, 0x59,
-	0x85, 0xc1, 0xa0, 0x45, 0xd0, 0xd9, 0x94, 0xf8, 0x8e, 0x79, 0xb2, 0x38, 0xd7, 0x15, 0x16, 0x37,
-	0xd9, 0x82, 0xe1, 0xd0, 0x1b, 0x9b, 0x2e, 0xa4, 0xc4, 0x9e, 0x1c, 0x11, 0x8b, 0x52, 0xb7, 0x70,
-	0xdc, 0xcd, 0x00, 0x68, 0xcf, 0x50, 0x74, 0x3e, 0x83, 0x68, 0x64, 0xd2, 0x2b, 0x2e, 0x74, 0x89,
-	0xc6, 0xad, 0x1e, 0xc0, 0x50, 0xeb, 0x2d, 0x40, 0x33, 0x52, 0x12, 0x19, 0x6b, 0x8a, 0xf3, 0xdd,
-	0x81, 0x71, 0x1f, 0x0b, 0x46, 0xc2, 0x55, 0x49, 0xb3, 0x53, 0x12, 0xdd, 0x4d, 0xee, 0x1a, 0x8f,
-	0x1b, 0xba, 0x03, 0x7b, 0xec, 0xe8, 0x40, 0x5f, 0xea, 0xc2, 0xf8, 0xb8, 0xf7, 0x5c, 0x71, 0xb9,
-	0xf7, 0x40, 0x24, 0xf3, 0x2d, 0x81, 0x42, 0x52, 0xc7, 0xa7, 0x2b, 0xdd, 0x9d, 0x8e, 0x58, 0x4a,
-	0xb7, 0x4f, 0x15, 0x1b, 0x62, 0x95, 0xd4, 0xb2, 0x53, 0x59, 0x65, 0x0c, 0x25, 0xa9, 0xac, 0xb2,
-	0x66, 0x04, 0xfa, 0x03, 0x81, 0xe7, 0x53, 0x9a, 0x2d, 0x7d, 0x39, 0x53, 0x72, 0x2a, 0xb7, 0x57,
-	0x4e, 0x1b, 0x8e, 0xf4, 0xbe, 0x22, 0x30, 0x1e, 0xdf, 0x37, 0xe9, 0x72, 0x37, 0x15, 0x11, 0x4b,
-	0xea, 0xd6, 0x29, 0x22, 0x91, 0xcf, 0x77, 0x04, 0x26, 0x13, 0xfb, 0x1d, 0xbd, 0xdd, 0x65, 0xd9,
-	0xc4, 0xb2, 0xba, 0x73, 0xba, 0x60, 0x24, 0xf6, 0x05, 0x01, 0x7a, 0xb2, 0x7f, 0xd1, 0xa5, 0x74,
-	0xa9, 0xf1, 0xed, 0xb8, 0x78, 0xa3, 0xc7, 0x28, 0x6c, 0xf3, 0xf9, 0x87, 0x7d, 0xa4, 0xfa, 0xc1,
-	0xe3, 0xc3, 0x12, 0x79, 0x72, 0x58, 0x22, 0xff, 0x1e, 0x96, 0xc8, 0x37, 0x47, 0xa5, 0xdc, 0x93,
-	0xa3, 0x52, 0xee, 0xaf, 0xa3, 0x52, 0x0e, 0x26, 0x0d, 0x2b, 0x61, 0xdd, 0xfb, 0xe4, 0xdd, 0x25,
-	0xdd, 0x70, 0x36, 0x77, 0x36, 0x24, 0xd5, 0xda, 0x96, 0xdb, 0xa0, 0x05, 0xc3, 0x0a, 0x5d, 0xc9,
-	0xcd, 0xf6, 0x0f, 0x86, 0xce, 0x5e, 0x83, 0xf1, 0x8d, 0x7e, 0xef, 0x37, 0xc0, 0x17, 0xff, 0x0f,
-	0x00, 0x00, 0xff, 0xff, 0x89, 0x1d, 0x65, 0xfc, 0x1a, 0x15, 0x00, 0x00,
+	// 1396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0xd5,
+	0x17, 0xf5, 0xc4, 0xa9, 0xed, 0xdc, 0xe4, 0xa7, 0xf4, 0xf7, 0xda, 0x38, 0xce, 0xd0, 0x78, 0xdc,
+	0xe9, 0x07, 0x51, 0xda, 0x8c, 0x15, 0xd3, 0x96, 0x34, 0x2d, 0x88, 0xba, 0x05, 0x11, 0x21, 0x43,
+	0xe5, 0x08, 0x2a, 0x90, 0x10, 0x9a, 0xce, 0xbc, 0x4e, 0x47, 0x4d, 0x66, 0xcc, 0xbc, 0x49, 0x70,
+	0x41, 0x48, 0x2c, 0x10, 0xaa, 0x90, 0x40, 0x08, 0x24, 0xc4, 0x02, 0x50, 0x37, 0x88, 0x05, 0x0b,
+	0x10, 0x4b, 0xc4, 0x86, 0x0d, 0xea, 0xb2, 0x4b, 0x84, 0x90, 0x85, 0x9a, 0x0d, 0xeb, 0xfc, 0x05,
+	0x68, 0x66, 0xee, 0xd8, 0x33, 0xf6, 0x7c, 0xd8, 0x26, 0x8b, 0x2e, 0x22, 0x65, 0x3c, 0xf7, 0xde,
+	0x77, 0xce, 0x3d, 0xf7, 0xf9, 0x9d, 0x67, 0x10, 0x5a, 0x96, 0xb9, 0x4b, 0x0d, 0xd9, 0x50, 0x68,
+	0x75, 0x9b, 0xda, 0xb2, 0x2a, 0xdb, 0x72, 0x75, 0x77, 0xb5, 0x6a, 0xb7, 0xa5, 0x96, 0x65, 0xda,
+	0x26, 0x29, 0xf6, 0x02, 0x24, 0x3f, 0x40, 0xda, 0x5d, 0xe5, 0x8f, 0x6a, 0xa6, 0x66, 0xba, 0x21,
+	0x55, 0xe7, 0x3f, 0x2f, 0x9a, 0x17, 0x63, 0xca, 0x31, 0xc5, 0x6c, 0x51, 0x8c, 0x39, 0x15, 0x13,
+	0xa3, 0x98, 0x86, 0x6d, 0xc9, 0x8a, 0x8d, 0x61, 0xcb, 0x71, 0xa5, 0x5a, 0x54, 0xd1, 0x6f, 0xe9,
+	0x8a, 0x6c, 0xeb, 0xa6, 0x81, 0xb1, 0x27, 0x63, 0x62, 0x5b, 0x6b, 0xd4, 0xf9, 0xc3, 0xa8, 0x27,
+	0x15, 0x93, 0x6d, 0x9b, 0xac, 0x6a, 0xb7, 0xab, 0x4c, 0xd7, 0x0c, 0xdd, 0xd0, 0xaa, 0xbb, 0xab,
+	0x37, 0xa9, 0x2d, 0xaf, 0xfa, 0xcf, 0x5e, 0xa0, 0xf8, 0xdb, 0x04, 0x2c, 0x36, 0x98, 0xd6, 0xa0,
+	0xdb, 0xa6, 0xa5, 0xcb, 0x5b, 0xfa, 0xbb, 0xf4, 0x2a, 0x62, 0x6b, 0xd2, 0xb7, 0x77, 0x28, 0xb3,
+	0xc9, 0x02, 0x14, 0x5c, 0x4a, 0x6f, 0xe9, 0x6a, 0x89, 0xab, 0x70, 0x4b, 0x53, 0xcd, 0xbc, 0xfb,
+	0xbc, 0xa1, 0x92, 0x45, 0x00, 0x46, 0x19, 0xd3, 0x4d, 0xc3, 0x79, 0x39, 0xe1, 0xbe, 0x9c, 0xc2,
+	0x4f, 0x36, 0x54, 0x72, 0x1c, 0x66, 0x68, 0x9b, 0x2a, 0x3b, 0x36, 0x06, 0x64, 0xdd, 0x80, 0xe9,
+	0xee, 0x67, 0x1b, 0x2a, 0xa9, 0x43, 0xc1, 0xef, 0x45, 0x69, 0xb2, 0xc2, 0x2d, 0x4d, 0xd7, 0x2a,
+	0x52, 0xb4, 0x0a, 0x92, 0x8f, 0xab, 0x3e, 0xf9, 0xa0, 0x23, 0x64, 0x9a, 0xdd, 0x3c, 0xf2, 0x2a,
+	0x80, 0xc3, 0x49, 0xb6, 0x77, 0x2c, 0xca, 0x4a, 0x87, 0xdc, 0x2a, 0x55, 0xc9, 0x6b, 0x80, 0x64,
+	0xb7, 0x25, 0x9f, 0x30, 0x36, 0x40, 0xda, 0xf4, 0x83, 0xaf, 0x51, 0xa6, 0x58, 0x7a, 0xcb, 0x36,
+	0x2d, 0x86, 0x45, 0x03, 0x85, 0x48, 0x11, 0x72, 0x86, 0x69, 0xcb, 0xd6, 0xdd, 0x52, 0xce, 0xc5,
+	0x8d, 0x4f, 0xeb, 0x87, 0xef, 0xdd, 0x17, 0x32, 0x5f, 0xdd, 0x17, 0x32, 0xff, 0xdc, 0x17, 0x32,
+	0x1f, 0xfc, 0x55, 0xc9, 0x88, 0x15, 0x28, 0xc7, 0xb5, 0x90, 0xb5, 0x4c, 0x83, 0x51, 0xf1, 0x97,
+	0x2c, 0x2c, 0x34, 0x98, 0x76, 0xf5, 0xb6, 0x6c, 0x68, 0xf4, 0x95, 0x77, 0x0c, 0x6a, 0xb1, 0xdb,
+	0x7a, 0xcb, 0xef, 0xb0, 0xd4, 0xdf, 0xe1, 0xfa, 0x91, 0xfd, 0x8e, 0x30, 0x7b, 0x57, 0xde, 0xde,
+	0x5a, 0x17, 0xfd, 0x37, 0x62, 0xaf, 0xed, 0xe7, 0x06, 0xdb, 0x5e, 0x9f, 0xdb, 0xef, 0x08, 0xff,
+	0xc7, 0x8c, 0xee, 0x3b, 0x31, 0xa8, 0xc6, 0x7a, 0x94, 0x1a, 0xf5, 0xf9, 0xfd, 0x8e, 0x70, 0xc4,
+	0xcb, 0x0b, 0xbe, 0x15, 0xc3, 0x32, 0x5d, 0x86, 0x82, 0x45, 0x15, 0xdd, 0x96, 0xb7, 0x58, 0x9a,
+	0x4c, 0x4d, 0x8c, 0x6b, 0x76, 0x33, 0x9c, 0xec, 0xae, 0xc8, 0x87, 0x86, 0x13, 0x39, 0x56, 0xde,
+	0xdc, 0xc1, 0xcb, 0x9b, 0x4f, 0x91, 0xf7, 0x18, 0xf0, 0x51, 0xda, 0xa1, 0xb4, 0xef, 0x01, 0x69,
+	0x30, 0xed, 0x8a, 0xaa, 0x6e, 0x3a, 0xea, 0xf8, 0x92, 0x5e, 0x84, 0x43, 0xae, 0x5a, 0xae, 0x9e,
+	0xd3, 0xb5, 0xc5, 0x38, 0xbe, 0x6e, 0x12, 0xa2, 0xf3, 0x32, 0x48, 0x09, 0xf2, 0x0e, 0x4c, 0x6a,
+	0xb1, 0xd2, 0x44, 0x25, 0xeb, 0x6e, 0x37, 0xef, 0x31, 0x02, 0xda, 0x1c, 0x1c, 0x09, 0x2d, 0x8e,
+	0x98, 0x3e, 0xe6, 0x60, 0xae, 0xc1, 0xb4, 0x6b, 0x74, 0x8b, 0xda, 0x34, 0x84, 0xeb, 0xf9, 0xbe,
+	0x51, 0x9b, 0xa9, 0x2f, 0x3b, 0x6b, 0xff, 0xd9, 0x11, 0x66, 0x1b, 0x08, 0xeb, 0x8a, 0xaa, 0x5a,
+	0x94, 0xb1, 0xc4, 0x09, 0x1c, 0x05, 0x63, 0x09, 0x8a, 0xfd, 0x58, 0x10, 0xe6, 0xfb, 0x70, 0x14,
+	0xd1, 0x7b, 0x43, 0xda, 0x6b, 0x5e, 0x1e, 0xc7, 0x16, 0xdb, 0x27, 0xc4, 0xb6, 0x0f, 0x13, 0xfd,
+	0xf8, 0x91, 0x80, 0xcd, 0xc3, 0x5c, 0xdf, 0xf2, 0x88, 0xeb, 0x77, 0xce, 0x6f, 0x6b, 0x93, 0x2a,
+	0xa6, 0xa5, 0xfa, 0xb8, 0x5e, 0x0a, 0xed, 0x3b, 0xaf, 0x7d, 0x67, 0xe3, 0xdb, 0x97, 0xbc, 0x1d,
+	0x2f, 0x40, 0xce, 0x72, 0xab, 0xbb, 0x1b, 0x78, 0xba, 0x56, 0x4e, 0xd8, 0x50, 0x0e, 0x06, 0x8c,
+	0x0e, 0x32, 0xcc, 0xa6, 0x31, 0x2c, 0xfa, 0x0d, 0xf6, 0x79, 0x20, 0xc1, 0x4f, 0xb9, 0x80, 0x26,
+	0x61, 0x8e, 0x2f, 0xc2, 0x94, 0xb7, 0x50, 0x8f, 0xe2, 0x99, 0x78, 0x8a, 0x87, 0x3d, 0x8a, 0xdd,
+	0x0c, 0xd1, 0xdd, 0xf5, 0xa6, 0xa5, 0x8e, 0x38, 0x23, 0x0b, 0x30, 0x3f, 0x80, 0x07, 0xb1, 0x7e,
+	0xc7, 0x81, 0x10, 0x98, 0xf1, 0xcd, 0xe0, 0x91, 0xe8, 0x83, 0x7e, 0x0d, 0xfe, 0x17, 0x3a, 0x2a,
+	0x71, 0x6c, 0x96, 0x13, 0x77, 0x5d, 0xa8, 0x12, 0x6e, 0xc1, 0x70, 0x99, 0x91, 0x28, 0x88, 0x50,
+	0x89, 0x87, 0x89, 0x5c, 0x7e, 0xe4, 0x40, 0x0c, 0xef, 0x85, 0x48, 0x3a, 0x6f, 0xc2, 0xe1, 0x10,
+	0x8e, 0x9e, 0x14, 0xb5, 0x78, 0x29, 0xe6, 0x71, 0xda, 0xfa, 0x12, 0xc5, 0xe6, 0x6c, 0xe8, 0xa3,
+	0x11, 0x85, 0x39, 0x05, 0x27, 0x12, 0x01, 0x23, 0xb1, 0x1f, 0x3c, 0x62, 0x57, 0x54, 0xd5, 0xff,
+	0x02, 0x8f, 0x24, 0xf6, 0x7a, 0xb4, 0x4e, 0x2b, 0x69, 0xa7, 0xc1, 0x01, 0x4b, 0xe5, 0x91, 0x8a,
+	0x07, 0x8b, 0xa4, 0x7e, 0xe6, 0xe0, 0x54, 0x97, 0x7c, 0x22, 0xaf, 0xc7, 0x48, 0xb0, 0x25, 0x38,
+	0x9d, 0x86, 0x19, 0xe9, 0x7d, 0xcf, 0xf9, 0x13, 0xeb, 0xed, 0xb8, 0x48, 0x66, 0x37, 0xa2, 0x15,
+	0x3b, 0x93, 0xfc, 0x65, 0x75, 0xc0, 0x7a, 0x9d, 0x80, 0xe3, 0x09, 0x40, 0x91, 0xce, 0x4f, 0x5c,
+	0x60, 0x54, 0x13, 0x18, 0x3d, 0x46, 0x5a, 0x9d, 0x86, 0x93, 0xc9, 0x88, 0x91, 0xda, 0xb7, 0x1c,
+	0x1c, 0xf3, 0x1a, 0x70, 0x7d, 0x2d, 0x24, 0xaa, 0xcf, 0xa9, 0x09, 0x33, 0xbe, 0x5d, 0x72, 0xf0,
+	0xa0, 0x48, 0x4b, 0x71, 0x22, 0x39, 0xd7, 0x84, 0x60, 0x19, 0x54, 0x28, 0x54, 0x63, 0x24, 0x22,
+	0x02, 0x2c, 0xc6, 0xe0, 0x43, 0x06, 0xdf, 0x4c, 0xba, 0xb3, 0x76, 0x7d, 0x8d, 0x26, 0x5c, 0x34,
+	0x46, 0xb5, 0xc1, 0x12, 0x14, 0x34, 0xcb, 0xdc, 0x69, 0xf5, 0x4c, 0x70, 0x20, 0xde, 0x7f, 0x23,
+	0x36, 0xf3, 0xee, 0xbf, 0xff, 0xd1, 0x00, 0xdf, 0x80, 0xa2, 0x87, 0x60, 0x60, 0x76, 0x26, 0xdd,
+	0x2a, 0xc7, 0xf7, 0x3b, 0xc2, 0x62, 0x10, 0xe9, 0xe0, 0xa8, 0x1c, 0x65, 0x03, 0xdf, 0x9f, 0x1b,
+	0x2a, 0x79, 0x2e, 0xe0, 0xac, 0x3d, 0x6f, 0x7c, 0x32, 0x49, 0xb6, 0x08, 0x77, 0xfd, 0x42, 0xc0,
+	0x5d, 0xe7, 0xd2, 0x2b, 0xc4, 0x5e, 0xa3, 0x5e, 0x0e, 0xf9, 0xec, 0x7c, 0xfa, 0x08, 0x75, 0x8d,
+	0xf6, 0x26, 0xb5, 0x23, 0x0c, 0x76, 0x09, 0xf2, 0xba, 0xb1, 0x6b, 0xde, 0xa1, 0x56, 0xa9, 0xe0,
+	0x5d, 0x1b, 0xf1, 0x31, 0x76, 0x87, 0xc7, 0x8d, 0x87, 0x37, 0x44, 0xb5, 0x5f, 0x67, 0x21, 0xdb,
+	0x60, 0x1a, 0xf9, 0xd0, 0xb1, 0x67, 0x83, 0x71, 0xe4, 0x7c, 0x1c, 0xd8, 0xc4, 0xfb, 0x2d, 0x7f,
+	0x61, 0xd4, 0x34, 0x0f, 0x0e, 0x69, 0xc3, 0x6c, 0xdf, 0x9d, 0x80, 0xac, 0x26, 0x94, 0x8a, 0xbe,
+	0xfb, 0xf1, 0xb5, 0x51, 0x52, 0x70, 0x65, 0x05, 0x0a, 0xbe, 0xcf, 0x20, 0xcb, 0x09, 0xf9, 0x7d,
+	0x97, 0x12, 0xfe, 0xcc, 0x50, 0xb1, 0xb8, 0xc8, 0x16, 0x4c, 0x07, 0x8e, 0x7d, 0xb2, 0x92, 0x90,
+	0x3b, 0x78, 0xcf, 0xe0, 0xa5, 0x61, 0xc3, 0x71, 0x35, 0x1d, 0xa0, 0x67, 0xc4, 0xc9, 0xd9, 0x14,
+	0xa0, 0xa1, 0xeb, 0x02, 0xbf, 0x32, 0x64, 0x34, 0x2e, 0x75, 0x0b, 0xa6, 0xba, 0x47, 0x09, 0x49,
+	0x69, 0x49, 0xc8, 0x1b, 0xf3, 0x67, 0x87, 0x0b, 0xc6, 0x75, 0x4c, 0x98, 0x09, 0x7e, 0xb5, 0x93,
+	0xf4, 0x96, 0x84, 0x57, 0xab, 0x0e, 0x1d, 0x8f, 0x0b, 0x3a, 0xb7, 0xbe, 0x48, 0xff, 0x49, 0x9e,
+	0x1e, 0x42, 0xf8, 0xa8, 0xc3, 0x92, 0x5f, 0x1b, 0x3d, 0x11, 0xc1, 0x7c, 0xc1, 0x41, 0x29, 0xce,
+	0x36, 0x92, 0xf5, 0xe1, 0xa6, 0x23, 0x12, 0xd2, 0xa5, 0xb1, 0x72, 0x03, 0xa8, 0xe2, 0x7c, 0x5f,
+	0x22, 0xaa, 0x14, 0x67, 0xcb, 0x5f, 0x1a, 0x2b, 0x17, 0x51, 0x7d, 0xcd, 0xc1, 0x13, 0x09, 0x8e,
+	0x8d, 0x3c, 0x93, 0x4a, 0x39, 0x11, 0xdb, 0xb3, 0xe3, 0xa6, 0x23, 0xbc, 0x4f, 0x38, 0x28, 0x46,
+	0x9b, 0x2f, 0xb2, 0x36, 0xcc, 0x8e, 0x88, 0x04, 0x75, 0x71, 0x8c, 0x4c, 0xc4, 0xf3, 0x25, 0x07,
+	0x0b, 0xb1, 0xa6, 0x89, 0x5c, 0x1a, 0x72, 0xdb, 0x44, 0xa2, 0xba, 0x3c, 0x5e, 0x32, 0x02, 0xfb,
+	0x88, 0x03, 0x32, 0x68, 0x82, 0xc8, 0xb9, 0x64, 0xaa, 0xd1, 0x9e, 0x8e, 0x3f, 0x3f, 0x62, 0x16,
+	0x3a, 0xad, 0xec, 0xbd, 0x09, 0x8e, 0x7c, 0xce, 0x41, 0x31, 0xfa, 0x30, 0x4d, 0x54, 0x2c, 0xd1,
+	0x9e, 0xf1, 0x17, 0xc7, 0xc8, 0x0c, 0x80, 0xaa, 0xdf, 0x79, 0xf0, 0xa8, 0xcc, 0x3d, 0x7c, 0x54,
+	0xe6, 0xfe, 0x7e, 0x54, 0xe6, 0x3e, 0xdb, 0x2b, 0x67, 0x1e, 0xee, 0x95, 0x33, 0x7f, 0xec, 0x95,
+	0x33, 0xb0, 0xa0, 0x9b, 0x31, 0xb5, 0xaf, 0x73, 0x6f, 0x9c, 0xd3, 0x74, 0xfb, 0xf6, 0xce, 0x4d,
+	0x49, 0x31, 0xb7, 0xab, 0xbd, 0xa0, 0x15, 0xdd, 0x0c, 0x3c, 0x55, 0xdb, 0xbd, 0x9f, 0xc2, 0xed,
+	0xbb, 0x2d, 0xca, 0x6e, 0xe6, 0xdc, 0x5f, 0xb7, 0x9f, 0xfa, 0x77, 0x00, 0xbb, 0xe8, 0xba, 0xb3,
+	0xf4, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1266,6 +1368,8 @@ type MsgClient interface {
 	DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error)
 	// AddP8eContractSpec adds a P8e v39 contract spec as a v40 ContractSpecification
 	AddP8EContractSpec(ctx context.Context, in *MsgAddP8EContractSpecRequest, opts ...grpc.CallOption) (*MsgAddP8EContractSpecResponse, error)
+	// P8eMemorializeContract records the results of a P8e v39 contract execution as a scope, session and set of records
+	P8EMemorializeContract(ctx context.Context, in *MsgP8EMemorializeContractRequest, opts ...grpc.CallOption) (*MsgP8EMemorializeContractResponse, error)
 }
 
 type msgClient struct {
@@ -1403,6 +1507,16 @@ func (c *msgClient) AddP8EContractSpec(ctx context.Context, in *MsgAddP8EContrac
 	return out, nil
 }
 
+// Deprecated: Do not use.
+func (c *msgClient) P8EMemorializeContract(ctx context.Context, in *MsgP8EMemorializeContractRequest, opts ...grpc.CallOption) (*MsgP8EMemorializeContractResponse, error) {
+	out := new(MsgP8EMemorializeContractResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/P8eMemorializeContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// MemorializeContract records the results of a P8e contract execution as a session and set of records in a scope
@@ -1433,6 +1547,8 @@ type MsgServer interface {
 	DeleteRecordSpecification(context.Context, *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error)
 	// AddP8eContractSpec adds a P8e v39 contract spec as a v40 ContractSpecification
 	AddP8EContractSpec(context.Context, *MsgAddP8EContractSpecRequest) (*MsgAddP8EContractSpecResponse, error)
+	// P8eMemorializeContract records the results of a P8e v39 contract execution as a scope, session and set of records
+	P8EMemorializeContract(context.Context, *MsgP8EMemorializeContractRequest) (*MsgP8EMemorializeContractResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1481,6 +1597,9 @@ func (*UnimplementedMsgServer) DeleteRecordSpecification(ctx context.Context, re
 func (*UnimplementedMsgServer) AddP8EContractSpec(ctx context.Context, req *MsgAddP8EContractSpecRequest) (*MsgAddP8EContractSpecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddP8EContractSpec not implemented")
 }
+func (*UnimplementedMsgServer) P8EMemorializeContract(ctx context.Context, req *MsgP8EMemorializeContractRequest) (*MsgP8EMemorializeContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method P8EMemorializeContract not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1738,6 +1857,24 @@ func _Msg_AddP8EContractSpec_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_P8EMemorializeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgP8EMemorializeContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).P8EMemorializeContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/P8EMemorializeContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).P8EMemorializeContract(ctx, req.(*MsgP8EMemorializeContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.metadata.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1798,6 +1935,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddP8eContractSpec",
 			Handler:    _Msg_AddP8EContractSpec_Handler,
 		},
+		{
+			MethodName: "P8eMemorializeContract",
+			Handler:    _Msg_P8EMemorializeContract_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/metadata/v1/tx.proto",
@@ -2799,6 +2940,119 @@ func (m *MsgAddP8EContractSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgP8EMemorializeContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgP8EMemorializeContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Invoker) > 0 {
+		i -= len(m.Invoker)
+		copy(dAtA[i:], m.Invoker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Invoker)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.Signatures.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Recitals != nil {
+		{
+			size, err := m.Recitals.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ScopeSpecificationId) > 0 {
+		i -= len(m.ScopeSpecificationId)
+		copy(dAtA[i:], m.ScopeSpecificationId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeSpecificationId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ExecutionId) > 0 {
+		i -= len(m.ExecutionId)
+		copy(dAtA[i:], m.ExecutionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgP8EMemorializeContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgP8EMemorializeContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -3210,6 +3464,52 @@ func (m *MsgAddP8EContractSpecResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgP8EMemorializeContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExecutionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScopeSpecificationId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Recitals != nil {
+		l = m.Recitals.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Contract.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Signatures.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Invoker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgP8EMemorializeContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5862,6 +6162,368 @@ func (m *MsgAddP8EContractSpecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgP8EMemorializeContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgP8eMemorializeContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgP8eMemorializeContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeSpecificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recitals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Recitals == nil {
+				m.Recitals = &p8e.Recitals{}
+			}
+			if err := m.Recitals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Signatures.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invoker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invoker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgP8EMemorializeContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgP8eMemorializeContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgP8eMemorializeContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
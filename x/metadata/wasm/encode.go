@@ -0,0 +1,288 @@
+// Package wasm supports smart contract integration with the provenance metadata module.
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/provenance-io/provenance/internal/provwasm"
+	"github.com/provenance-io/provenance/x/metadata/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Compile time interface check
+var _ provwasm.Encoder = Encoder
+
+// MetadataMsgParams are params for encoding []sdk.Msg types from the metadata module.
+// Only one field should be set per request.
+type MetadataMsgParams struct {
+	// Params for encoding a MsgAddScopeRequest
+	AddScope *AddScopeParams `json:"add_scope,omitempty"`
+	// Params for encoding a MsgDeleteScopeRequest
+	DeleteScope *DeleteScopeParams `json:"delete_scope,omitempty"`
+	// Params for encoding a MsgAddSessionRequest
+	AddSession *AddSessionParams `json:"add_session,omitempty"`
+	// Params for encoding a MsgAddRecordRequest
+	AddRecord *AddRecordParams `json:"add_record,omitempty"`
+	// Params for encoding a MsgDeleteRecordRequest
+	DeleteRecord *DeleteRecordParams `json:"delete_record,omitempty"`
+	// Params for encoding a MsgAddScopeSpecificationRequest
+	AddScopeSpec *AddScopeSpecParams `json:"add_scope_spec,omitempty"`
+	// Params for encoding a MsgDeleteScopeSpecificationRequest
+	DeleteScopeSpec *DeleteScopeSpecParams `json:"delete_scope_spec,omitempty"`
+	// Params for encoding a MsgAddContractSpecificationRequest
+	AddContractSpec *AddContractSpecParams `json:"add_contract_spec,omitempty"`
+	// Params for encoding a MsgDeleteContractSpecificationRequest
+	DeleteContractSpec *DeleteContractSpecParams `json:"delete_contract_spec,omitempty"`
+	// Params for encoding a MsgAddRecordSpecificationRequest
+	AddRecordSpec *AddRecordSpecParams `json:"add_record_spec,omitempty"`
+	// Params for encoding a MsgDeleteRecordSpecificationRequest
+	DeleteRecordSpec *DeleteRecordSpecParams `json:"delete_record_spec,omitempty"`
+}
+
+// AddScopeParams are params for encoding a MsgAddScopeRequest.
+type AddScopeParams struct {
+	// The scope to add or update
+	Scope Scope `json:"scope"`
+}
+
+// DeleteScopeParams are params for encoding a MsgDeleteScopeRequest.
+type DeleteScopeParams struct {
+	// The bech32 address of the scope to delete
+	ScopeID string `json:"scope_id"`
+}
+
+// AddSessionParams are params for encoding a MsgAddSessionRequest.
+type AddSessionParams struct {
+	// The session to add or update
+	Session Session `json:"session"`
+}
+
+// AddRecordParams are params for encoding a MsgAddRecordRequest.
+type AddRecordParams struct {
+	// The record to add or update
+	Record Record `json:"record"`
+}
+
+// DeleteRecordParams are params for encoding a MsgDeleteRecordRequest.
+type DeleteRecordParams struct {
+	// The bech32 address of the record to delete
+	RecordID string `json:"record_id"`
+}
+
+// AddScopeSpecParams are params for encoding a MsgAddScopeSpecificationRequest.
+type AddScopeSpecParams struct {
+	// The scope specification to add or update
+	Specification ScopeSpecification `json:"specification"`
+}
+
+// DeleteScopeSpecParams are params for encoding a MsgDeleteScopeSpecificationRequest.
+type DeleteScopeSpecParams struct {
+	// The bech32 address of the scope specification to delete
+	SpecificationID string `json:"specification_id"`
+}
+
+// AddContractSpecParams are params for encoding a MsgAddContractSpecificationRequest.
+type AddContractSpecParams struct {
+	// The contract specification to add or update
+	Specification ContractSpecification `json:"specification"`
+}
+
+// DeleteContractSpecParams are params for encoding a MsgDeleteContractSpecificationRequest.
+type DeleteContractSpecParams struct {
+	// The bech32 address of the contract specification to delete
+	SpecificationID string `json:"specification_id"`
+}
+
+// AddRecordSpecParams are params for encoding a MsgAddRecordSpecificationRequest.
+type AddRecordSpecParams struct {
+	// The record specification to add or update
+	Specification RecordSpecification `json:"specification"`
+}
+
+// DeleteRecordSpecParams are params for encoding a MsgDeleteRecordSpecificationRequest.
+type DeleteRecordSpecParams struct {
+	// The bech32 address of the record specification to delete
+	SpecificationID string `json:"specification_id"`
+}
+
+// Encoder returns a smart contract message encoder for the metadata module.
+func Encoder(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sdk.Msg, error) {
+	wrapper := struct {
+		Params *MetadataMsgParams `json:"metadata"`
+	}{}
+	if err := json.Unmarshal(msg, &wrapper); err != nil {
+		return nil, fmt.Errorf("wasm: failed to unmarshal metadata encode params: %w", err)
+	}
+	params := wrapper.Params
+	if params == nil {
+		return nil, fmt.Errorf("wasm: nil metadata encode params")
+	}
+	switch {
+	case params.AddScope != nil:
+		return params.AddScope.Encode(contract)
+	case params.DeleteScope != nil:
+		return params.DeleteScope.Encode(contract)
+	case params.AddSession != nil:
+		return params.AddSession.Encode(contract)
+	case params.AddRecord != nil:
+		return params.AddRecord.Encode(contract)
+	case params.DeleteRecord != nil:
+		return params.DeleteRecord.Encode(contract)
+	case params.AddScopeSpec != nil:
+		return params.AddScopeSpec.Encode(contract)
+	case params.DeleteScopeSpec != nil:
+		return params.DeleteScopeSpec.Encode(contract)
+	case params.AddContractSpec != nil:
+		return params.AddContractSpec.Encode(contract)
+	case params.DeleteContractSpec != nil:
+		return params.DeleteContractSpec.Encode(contract)
+	case params.AddRecordSpec != nil:
+		return params.AddRecordSpec.Encode(contract)
+	case params.DeleteRecordSpec != nil:
+		return params.DeleteRecordSpec.Encode(contract)
+	default:
+		return nil, fmt.Errorf("wasm: invalid metadata encode request: %s", string(msg))
+	}
+}
+
+// Encode creates a MsgAddScopeRequest.
+// The contract must be an owner of an existing scope.
+func (params *AddScopeParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	scope, err := params.Scope.convert()
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid scope in AddScopeParams: %w", err)
+	}
+	msg := types.NewMsgAddScopeRequest(scope, []string{contract.String()})
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgDeleteScopeRequest.
+// The contract must be an owner of the scope.
+func (params *DeleteScopeParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid scope id in DeleteScopeParams: %w", err)
+	}
+	msg := types.NewMsgDeleteScopeRequest(scopeID, []string{contract.String()})
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgAddSessionRequest.
+// The contract must be an owner of the scope the session belongs to.
+func (params *AddSessionParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	session, err := params.Session.convert()
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid session in AddSessionParams: %w", err)
+	}
+	msg := types.NewMsgAddSessionRequest()
+	msg.Session = &session
+	msg.Signers = []string{contract.String()}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgAddRecordRequest.
+// The contract must be an owner of the scope the record belongs to.
+func (params *AddRecordParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	record, err := params.Record.convert()
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid record in AddRecordParams: %w", err)
+	}
+	msg := types.NewMsgAddRecordRequest()
+	msg.SessionId = record.SessionId
+	msg.Record = &record
+	msg.Signers = []string{contract.String()}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgDeleteRecordRequest.
+// The contract must be an owner of the scope the record belongs to.
+func (params *DeleteRecordParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	recordID, err := types.MetadataAddressFromBech32(params.RecordID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid record id in DeleteRecordParams: %w", err)
+	}
+	msg := types.NewMsgDeleteRecordRequest()
+	msg.RecordId = recordID
+	msg.Signers = []string{contract.String()}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgAddScopeSpecificationRequest.
+// The contract must be an owner of an existing scope specification.
+func (params *AddScopeSpecParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	spec, err := params.Specification.convert()
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid specification in AddScopeSpecParams: %w", err)
+	}
+	msg := types.NewMsgAddScopeSpecificationRequest()
+	msg.Specification = spec
+	msg.Signers = []string{contract.String()}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgDeleteScopeSpecificationRequest.
+// The contract must be an owner of the scope specification.
+func (params *DeleteScopeSpecParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid specification id in DeleteScopeSpecParams: %w", err)
+	}
+	msg := types.NewMsgDeleteScopeSpecificationRequest()
+	msg.SpecificationId = specID
+	msg.Signers = []string{contract.String()}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgAddContractSpecificationRequest.
+// The contract must be an owner of an existing contract specification.
+func (params *AddContractSpecParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	spec, err := params.Specification.convert()
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid specification in AddContractSpecParams: %w", err)
+	}
+	msg := types.NewMsgAddContractSpecificationRequest()
+	msg.Specification = spec
+	msg.Signers = []string{contract.String()}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgDeleteContractSpecificationRequest.
+// The contract must be an owner of the contract specification.
+func (params *DeleteContractSpecParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid specification id in DeleteContractSpecParams: %w", err)
+	}
+	msg := types.NewMsgDeleteContractSpecificationRequest()
+	msg.SpecificationId = specID
+	msg.Signers = []string{contract.String()}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgAddRecordSpecificationRequest.
+// The contract must be an owner of the contract specification the record specification belongs to.
+func (params *AddRecordSpecParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	spec, err := params.Specification.convert()
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid specification in AddRecordSpecParams: %w", err)
+	}
+	msg := types.NewMsgAddRecordSpecificationRequest()
+	msg.Specification = spec
+	msg.Signers = []string{contract.String()}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgDeleteRecordSpecificationRequest.
+// The contract must be an owner of the contract specification the record specification belongs to.
+func (params *DeleteRecordSpecParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid specification id in DeleteRecordSpecParams: %w", err)
+	}
+	msg := types.NewMsgDeleteRecordSpecificationRequest()
+	msg.SpecificationId = specID
+	msg.Signers = []string{contract.String()}
+	return []sdk.Msg{msg}, nil
+}
@@ -0,0 +1,340 @@
+package wasm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestEncoder(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	owner := sdk.AccAddress("owner_______________")
+	signers := []string{contract.String()}
+
+	scopeUUID := uuid.MustParse("8d80b25a-c089-4446-956e-5d08cfe3e1a5")
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.MustParse("c25c7bd4-c639-4367-a842-f64fa5fccc19"))
+	recordID := types.RecordMetadataAddress(scopeUUID, "record")
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.MustParse("22fc17a6-40dd-4d68-a95b-ec94e7572a09"))
+	contractSpecUUID := uuid.MustParse("e2c6e5b8-7c3a-4a2b-9bd7-1d3d8a6a9a51")
+	contractSpecID := types.ContractSpecMetadataAddress(contractSpecUUID)
+	recordSpecID := types.RecordSpecMetadataAddress(contractSpecUUID, "record")
+
+	owners := []types.Party{{Address: owner.String(), Role: types.PartyType_PARTY_TYPE_OWNER}}
+	scope := types.NewScope(scopeID, scopeSpecID, owners, []string{contract.String()}, owner.String())
+	session := types.NewSession("session", sessionID, contractSpecID, owners, nil)
+	record := types.NewRecord("record", sessionID, types.Process{
+		ProcessId: &types.Process_Hash{Hash: "PROCESS"}, Name: "process", Method: "run",
+	}, []types.RecordInput{
+		{Name: "input", Source: &types.RecordInput_Hash{Hash: "INPUT"}, TypeName: "string",
+			Status: types.RecordInputStatus_Proposed},
+	}, []types.RecordOutput{*types.NewRecordOutput("OUTPUT", types.ResultStatus_RESULT_STATUS_PASS)})
+	scopeSpec := types.NewScopeSpecification(scopeSpecID, types.NewDescription("scope spec", "", "", ""),
+		[]string{contract.String()}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		[]types.MetadataAddress{contractSpecID})
+	contractSpec := types.NewContractSpecification(contractSpecID, nil, []string{contract.String()},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, types.NewContractSpecificationSourceHash("CONTRACT"), "Contract")
+	recordSpec := types.NewRecordSpecification(recordSpecID, "record", []*types.InputSpecification{
+		types.NewInputSpecification("input", "string", types.NewInputSpecificationSourceHash("INPUT")),
+	}, "string", types.DefinitionType_DEFINITION_TYPE_PROPOSED, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER})
+
+	addSession := types.NewMsgAddSessionRequest()
+	addSession.Session = session
+	addSession.Signers = signers
+	addRecord := types.NewMsgAddRecordRequest()
+	addRecord.SessionId = sessionID
+	addRecord.Record = record
+	addRecord.Signers = signers
+	deleteRecord := types.NewMsgDeleteRecordRequest()
+	deleteRecord.RecordId = recordID
+	deleteRecord.Signers = signers
+	addScopeSpec := types.NewMsgAddScopeSpecificationRequest()
+	addScopeSpec.Specification = *scopeSpec
+	addScopeSpec.Signers = signers
+	deleteScopeSpec := types.NewMsgDeleteScopeSpecificationRequest()
+	deleteScopeSpec.SpecificationId = scopeSpecID
+	deleteScopeSpec.Signers = signers
+	addContractSpec := types.NewMsgAddContractSpecificationRequest()
+	addContractSpec.Specification = *contractSpec
+	addContractSpec.Signers = signers
+	deleteContractSpec := types.NewMsgDeleteContractSpecificationRequest()
+	deleteContractSpec.SpecificationId = contractSpecID
+	deleteContractSpec.Signers = signers
+	addRecordSpec := types.NewMsgAddRecordSpecificationRequest()
+	addRecordSpec.Specification = *recordSpec
+	addRecordSpec.Signers = signers
+	deleteRecordSpec := types.NewMsgDeleteRecordSpecificationRequest()
+	deleteRecordSpec.SpecificationId = recordSpecID
+	deleteRecordSpec.Signers = signers
+
+	tests := []struct {
+		name string
+		msg  string
+		want []sdk.Msg
+		err  string
+	}{
+		{
+			"add scope",
+			fmt.Sprintf(`{"metadata":{"add_scope":{"scope":{"scope_id":"%s","specification_id":"%s",
+				"owners":[{"address":"%s","role":"owner"}],"data_access":["%s"],"value_owner_address":"%s"}}}}`,
+				scopeID, scopeSpecID, owner, contract, owner),
+			[]sdk.Msg{types.NewMsgAddScopeRequest(*scope, signers)},
+			"",
+		},
+		{
+			"add scope invalid scope id",
+			fmt.Sprintf(`{"metadata":{"add_scope":{"scope":{"scope_id":"invalid","specification_id":"%s",
+				"owners":[{"address":"%s","role":"owner"}],"value_owner_address":"%s"}}}}`, scopeSpecID, owner, owner),
+			nil,
+			"wasm: invalid scope in AddScopeParams: invalid scope id: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"add scope missing owner role",
+			fmt.Sprintf(`{"metadata":{"add_scope":{"scope":{"scope_id":"%s","specification_id":"%s",
+				"owners":[{"address":"%s"}],"value_owner_address":"%s"}}}}`, scopeID, scopeSpecID, owner, owner),
+			nil,
+			`wasm: invalid scope in AddScopeParams: invalid party role: unknown value ""`,
+		},
+		{
+			"delete scope",
+			fmt.Sprintf(`{"metadata":{"delete_scope":{"scope_id":"%s"}}}`, scopeID),
+			[]sdk.Msg{types.NewMsgDeleteScopeRequest(scopeID, signers)},
+			"",
+		},
+		{
+			"delete scope invalid scope id",
+			`{"metadata":{"delete_scope":{"scope_id":"invalid"}}}`,
+			nil,
+			"wasm: invalid scope id in DeleteScopeParams: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"delete scope missing scope id",
+			`{"metadata":{"delete_scope":{}}}`,
+			nil,
+			"wasm: invalid scope id in DeleteScopeParams: empty address string is not allowed",
+		},
+		{
+			"add session",
+			fmt.Sprintf(`{"metadata":{"add_session":{"session":{"session_id":"%s","specification_id":"%s",
+				"parties":[{"address":"%s","role":"owner"}],"name":"session"}}}}`, sessionID, contractSpecID, owner),
+			[]sdk.Msg{addSession},
+			"",
+		},
+		{
+			"add session invalid session id",
+			fmt.Sprintf(`{"metadata":{"add_session":{"session":{"session_id":"invalid","specification_id":"%s",
+				"parties":[{"address":"%s","role":"owner"}],"name":"session"}}}}`, contractSpecID, owner),
+			nil,
+			"wasm: invalid session in AddSessionParams: invalid session id: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"add session missing specification id",
+			fmt.Sprintf(`{"metadata":{"add_session":{"session":{"session_id":"%s",
+				"parties":[{"address":"%s","role":"owner"}],"name":"session"}}}}`, sessionID, owner),
+			nil,
+			"wasm: invalid session in AddSessionParams: invalid contract specification id: empty address string is not allowed",
+		},
+		{
+			"add record",
+			fmt.Sprintf(`{"metadata":{"add_record":{"record":{"name":"record","session_id":"%s",
+				"process":{"hash":"PROCESS","name":"process","method":"run"},
+				"inputs":[{"name":"input","hash":"INPUT","type_name":"string","status":"proposed"}],
+				"outputs":[{"hash":"OUTPUT","status":"pass"}]}}}}`, sessionID),
+			[]sdk.Msg{addRecord},
+			"",
+		},
+		{
+			"add record invalid session id",
+			`{"metadata":{"add_record":{"record":{"name":"record","session_id":"invalid",
+				"process":{"hash":"PROCESS","name":"process","method":"run"}}}}}`,
+			nil,
+			"wasm: invalid record in AddRecordParams: invalid session id: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"add record missing process",
+			fmt.Sprintf(`{"metadata":{"add_record":{"record":{"name":"record","session_id":"%s"}}}}`, sessionID),
+			nil,
+			"wasm: invalid record in AddRecordParams: missing record process",
+		},
+		{
+			"add record missing input status",
+			fmt.Sprintf(`{"metadata":{"add_record":{"record":{"name":"record","session_id":"%s",
+				"process":{"hash":"PROCESS","name":"process","method":"run"},
+				"inputs":[{"name":"input","hash":"INPUT","type_name":"string"}]}}}}`, sessionID),
+			nil,
+			`wasm: invalid record in AddRecordParams: invalid record input status: unknown value ""`,
+		},
+		{
+			"delete record",
+			fmt.Sprintf(`{"metadata":{"delete_record":{"record_id":"%s"}}}`, recordID),
+			[]sdk.Msg{deleteRecord},
+			"",
+		},
+		{
+			"delete record invalid record id",
+			`{"metadata":{"delete_record":{"record_id":"invalid"}}}`,
+			nil,
+			"wasm: invalid record id in DeleteRecordParams: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"delete record missing record id",
+			`{"metadata":{"delete_record":{}}}`,
+			nil,
+			"wasm: invalid record id in DeleteRecordParams: empty address string is not allowed",
+		},
+		{
+			"add scope spec",
+			fmt.Sprintf(`{"metadata":{"add_scope_spec":{"specification":{"specification_id":"%s",
+				"description":{"name":"scope spec"},"owner_addresses":["%s"],"parties_involved":["owner"],
+				"contract_spec_ids":["%s"]}}}}`, scopeSpecID, contract, contractSpecID),
+			[]sdk.Msg{addScopeSpec},
+			"",
+		},
+		{
+			"add scope spec invalid contract spec id",
+			fmt.Sprintf(`{"metadata":{"add_scope_spec":{"specification":{"specification_id":"%s",
+				"owner_addresses":["%s"],"parties_involved":["owner"],"contract_spec_ids":["invalid"]}}}}`,
+				scopeSpecID, contract),
+			nil,
+			"wasm: invalid specification in AddScopeSpecParams: invalid contract specification id: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"add scope spec missing specification id",
+			fmt.Sprintf(`{"metadata":{"add_scope_spec":{"specification":{
+				"owner_addresses":["%s"],"parties_involved":["owner"]}}}}`, contract),
+			nil,
+			"wasm: invalid specification in AddScopeSpecParams: invalid scope specification id: empty address string is not allowed",
+		},
+		{
+			"delete scope spec",
+			fmt.Sprintf(`{"metadata":{"delete_scope_spec":{"specification_id":"%s"}}}`, scopeSpecID),
+			[]sdk.Msg{deleteScopeSpec},
+			"",
+		},
+		{
+			"delete scope spec invalid specification id",
+			`{"metadata":{"delete_scope_spec":{"specification_id":"invalid"}}}`,
+			nil,
+			"wasm: invalid specification id in DeleteScopeSpecParams: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"delete scope spec missing specification id",
+			`{"metadata":{"delete_scope_spec":{}}}`,
+			nil,
+			"wasm: invalid specification id in DeleteScopeSpecParams: empty address string is not allowed",
+		},
+		{
+			"add contract spec",
+			fmt.Sprintf(`{"metadata":{"add_contract_spec":{"specification":{"specification_id":"%s",
+				"owner_addresses":["%s"],"parties_involved":["owner"],"hash":"CONTRACT","class_name":"Contract"}}}}`,
+				contractSpecID, contract),
+			[]sdk.Msg{addContractSpec},
+			"",
+		},
+		{
+			"add contract spec invalid resource id",
+			fmt.Sprintf(`{"metadata":{"add_contract_spec":{"specification":{"specification_id":"%s",
+				"owner_addresses":["%s"],"parties_involved":["owner"],"resource_id":"invalid","class_name":"Contract"}}}}`,
+				contractSpecID, contract),
+			nil,
+			"wasm: invalid specification in AddContractSpecParams: invalid contract specification resource id: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"add contract spec missing specification id",
+			fmt.Sprintf(`{"metadata":{"add_contract_spec":{"specification":{
+				"owner_addresses":["%s"],"parties_involved":["owner"],"hash":"CONTRACT","class_name":"Contract"}}}}`, contract),
+			nil,
+			"wasm: invalid specification in AddContractSpecParams: invalid contract specification id: empty address string is not allowed",
+		},
+		{
+			"delete contract spec",
+			fmt.Sprintf(`{"metadata":{"delete_contract_spec":{"specification_id":"%s"}}}`, contractSpecID),
+			[]sdk.Msg{deleteContractSpec},
+			"",
+		},
+		{
+			"delete contract spec invalid specification id",
+			`{"metadata":{"delete_contract_spec":{"specification_id":"invalid"}}}`,
+			nil,
+			"wasm: invalid specification id in DeleteContractSpecParams: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"delete contract spec missing specification id",
+			`{"metadata":{"delete_contract_spec":{}}}`,
+			nil,
+			"wasm: invalid specification id in DeleteContractSpecParams: empty address string is not allowed",
+		},
+		{
+			"add record spec",
+			fmt.Sprintf(`{"metadata":{"add_record_spec":{"specification":{"specification_id":"%s","name":"record",
+				"inputs":[{"name":"input","type_name":"string","hash":"INPUT"}],"type_name":"string",
+				"result_type":"proposed","responsible_parties":["owner"]}}}}`, recordSpecID),
+			[]sdk.Msg{addRecordSpec},
+			"",
+		},
+		{
+			"add record spec invalid input record id",
+			fmt.Sprintf(`{"metadata":{"add_record_spec":{"specification":{"specification_id":"%s","name":"record",
+				"inputs":[{"name":"input","type_name":"string","record_id":"invalid"}],"type_name":"string",
+				"result_type":"proposed","responsible_parties":["owner"]}}}}`, recordSpecID),
+			nil,
+			"wasm: invalid specification in AddRecordSpecParams: invalid input specification record id: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"add record spec missing result type",
+			fmt.Sprintf(`{"metadata":{"add_record_spec":{"specification":{"specification_id":"%s","name":"record",
+				"type_name":"string","responsible_parties":["owner"]}}}}`, recordSpecID),
+			nil,
+			`wasm: invalid specification in AddRecordSpecParams: invalid record specification result type: unknown value ""`,
+		},
+		{
+			"delete record spec",
+			fmt.Sprintf(`{"metadata":{"delete_record_spec":{"specification_id":"%s"}}}`, recordSpecID),
+			[]sdk.Msg{deleteRecordSpec},
+			"",
+		},
+		{
+			"delete record spec invalid specification id",
+			`{"metadata":{"delete_record_spec":{"specification_id":"invalid"}}}`,
+			nil,
+			"wasm: invalid specification id in DeleteRecordSpecParams: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"delete record spec missing specification id",
+			`{"metadata":{"delete_record_spec":{}}}`,
+			nil,
+			"wasm: invalid specification id in DeleteRecordSpecParams: empty address string is not allowed",
+		},
+		{
+			"empty params",
+			`{"metadata":{}}`,
+			nil,
+			`wasm: invalid metadata encode request: {"metadata":{}}`,
+		},
+		{
+			"missing params",
+			`{}`,
+			nil,
+			"wasm: nil metadata encode params",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := Encoder(contract, []byte(tc.msg), "")
+			if len(tc.err) > 0 {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, msgs)
+		})
+	}
+}
@@ -0,0 +1,345 @@
+// Package wasm supports smart contract integration with the provenance metadata module.
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/provenance-io/provenance/internal/provwasm"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MetadataQueryParams represents the query request type for the metadata module sent by smart contracts.
+// Only one query field should be set.
+type MetadataQueryParams struct {
+	// Get a scope by id.
+	GetScope *GetScopeParams `json:"get_scope,omitempty"`
+	// Get the sessions of a scope.
+	GetSessions *GetSessionsParams `json:"get_sessions,omitempty"`
+	// Get the records of a scope.
+	GetRecords *GetRecordsParams `json:"get_records,omitempty"`
+	// Get a scope specification by id.
+	GetScopeSpec *GetScopeSpecParams `json:"get_scope_spec,omitempty"`
+	// Get a contract specification by id.
+	GetContractSpec *GetContractSpecParams `json:"get_contract_spec,omitempty"`
+	// Get a record specification by id.
+	GetRecordSpec *GetRecordSpecParams `json:"get_record_spec,omitempty"`
+	// Get the record specifications of a contract specification.
+	GetRecordSpecs *GetRecordSpecsParams `json:"get_record_specs,omitempty"`
+	// Get the ids of scopes an address is an owner, data access, or value owner party on.
+	GetOwnership *GetOwnershipParams `json:"get_ownership,omitempty"`
+	// Get the ids of scopes an address is the value owner of.
+	GetValueOwnership *GetValueOwnershipParams `json:"get_value_ownership,omitempty"`
+	// Get the ids of scopes an address may read the data of as an owner, data access, or value owner party.
+	GetDataAccess *GetDataAccessParams `json:"get_data_access,omitempty"`
+	// Check whether an address may receive the data of a scope.
+	CanAccess *CanAccessParams `json:"can_access,omitempty"`
+}
+
+// GetScopeParams are params for querying a scope.
+type GetScopeParams struct {
+	// The bech32 address of the scope
+	ScopeID string `json:"scope_id"`
+}
+
+// GetSessionsParams are params for querying the sessions of a scope.
+type GetSessionsParams struct {
+	// The bech32 address of the scope
+	ScopeID string `json:"scope_id"`
+}
+
+// GetRecordsParams are params for querying the records of a scope.
+type GetRecordsParams struct {
+	// The bech32 address of the scope
+	ScopeID string `json:"scope_id"`
+	// An optional record name, all records are returned when empty
+	Name string `json:"name,omitempty"`
+}
+
+// GetScopeSpecParams are params for querying a scope specification.
+type GetScopeSpecParams struct {
+	// The bech32 address of the scope specification
+	SpecificationID string `json:"specification_id"`
+}
+
+// GetContractSpecParams are params for querying a contract specification.
+type GetContractSpecParams struct {
+	// The bech32 address of the contract specification
+	SpecificationID string `json:"specification_id"`
+}
+
+// GetRecordSpecParams are params for querying a record specification.
+type GetRecordSpecParams struct {
+	// The bech32 address of the record specification
+	SpecificationID string `json:"specification_id"`
+}
+
+// GetRecordSpecsParams are params for querying the record specifications of a contract specification.
+type GetRecordSpecsParams struct {
+	// The bech32 address of the contract specification
+	ContractSpecificationID string `json:"contract_specification_id"`
+}
+
+// GetOwnershipParams are params for querying the scopes associated with an address.
+type GetOwnershipParams struct {
+	// The account address
+	Address string `json:"address"`
+}
+
+// GetValueOwnershipParams are params for querying the scopes an address is the value owner of.
+type GetValueOwnershipParams struct {
+	// The account address
+	Address string `json:"address"`
+}
+
+// GetDataAccessParams are params for querying the scopes an address may read the data of.
+type GetDataAccessParams struct {
+	// The account address
+	Address string `json:"address"`
+}
+
+// CanAccessParams are params for checking whether an address may receive the data of a scope.
+type CanAccessParams struct {
+	// The bech32 address of the scope, or of a session or record in it
+	ID string `json:"id"`
+	// The account address
+	Address string `json:"address"`
+}
+
+// Querier returns a smart contract querier for the metadata module.
+func Querier(keeper keeper.Keeper) provwasm.Querier {
+	return func(ctx sdk.Context, query json.RawMessage, version string) ([]byte, error) {
+		wrapper := struct {
+			Params *MetadataQueryParams `json:"metadata"`
+		}{}
+		if err := json.Unmarshal(query, &wrapper); err != nil {
+			return nil, fmt.Errorf("wasm: invalid query: %w", err)
+		}
+		params := wrapper.Params
+		if params == nil {
+			return nil, fmt.Errorf("wasm: nil metadata query params")
+		}
+		switch {
+		case params.GetScope != nil:
+			return params.GetScope.Run(ctx, keeper)
+		case params.GetSessions != nil:
+			return params.GetSessions.Run(ctx, keeper)
+		case params.GetRecords != nil:
+			return params.GetRecords.Run(ctx, keeper)
+		case params.GetScopeSpec != nil:
+			return params.GetScopeSpec.Run(ctx, keeper)
+		case params.GetContractSpec != nil:
+			return params.GetContractSpec.Run(ctx, keeper)
+		case params.GetRecordSpec != nil:
+			return params.GetRecordSpec.Run(ctx, keeper)
+		case params.GetRecordSpecs != nil:
+			return params.GetRecordSpecs.Run(ctx, keeper)
+		case params.GetOwnership != nil:
+			return params.GetOwnership.Run(ctx, keeper)
+		case params.GetValueOwnership != nil:
+			return params.GetValueOwnership.Run(ctx, keeper)
+		case params.GetDataAccess != nil:
+			return params.GetDataAccess.Run(ctx, keeper)
+		case params.CanAccess != nil:
+			return params.CanAccess.Run(ctx, keeper)
+		default:
+			return nil, fmt.Errorf("wasm: invalid metadata query: %s", string(query))
+		}
+	}
+}
+
+// Run queries for a scope by id.
+func (params *GetScopeParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	scopeID, err := parseScopeID(params.ScopeID)
+	if err != nil {
+		return nil, err
+	}
+	scope, found := keeper.GetScope(ctx, scopeID)
+	if !found {
+		return nil, fmt.Errorf("wasm: scope not found: %s", scopeID)
+	}
+	return marshalResponse(scopeFor(scope))
+}
+
+// Run queries for the sessions of a scope.
+func (params *GetSessionsParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	scopeID, err := parseScopeID(params.ScopeID)
+	if err != nil {
+		return nil, err
+	}
+	res := Sessions{Sessions: []*Session{}}
+	err = keeper.IterateSessions(ctx, scopeID, func(session types.Session) (stop bool) {
+		res.Sessions = append(res.Sessions, sessionFor(session))
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: session query failed: %w", err)
+	}
+	return marshalResponse(res)
+}
+
+// Run queries for the records of a scope, optionally filtered by name.
+func (params *GetRecordsParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	scopeID, err := parseScopeID(params.ScopeID)
+	if err != nil {
+		return nil, err
+	}
+	records, err := keeper.GetRecords(ctx, scopeID, params.Name)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: record query failed: %w", err)
+	}
+	res := Records{Records: []*Record{}}
+	for _, r := range records {
+		res.Records = append(res.Records, recordFor(*r))
+	}
+	return marshalResponse(res)
+}
+
+// Run queries for a scope specification by id.
+func (params *GetScopeSpecParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil || !specID.IsScopeSpecificationAddress() {
+		return nil, fmt.Errorf("wasm: invalid scope specification id: %s", params.SpecificationID)
+	}
+	spec, found := keeper.GetScopeSpecification(ctx, specID)
+	if !found {
+		return nil, fmt.Errorf("wasm: scope specification not found: %s", specID)
+	}
+	return marshalResponse(scopeSpecFor(spec))
+}
+
+// Run queries for a contract specification by id.
+func (params *GetContractSpecParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil || !specID.IsContractSpecificationAddress() {
+		return nil, fmt.Errorf("wasm: invalid contract specification id: %s", params.SpecificationID)
+	}
+	spec, found := keeper.GetContractSpecification(ctx, specID)
+	if !found {
+		return nil, fmt.Errorf("wasm: contract specification not found: %s", specID)
+	}
+	return marshalResponse(contractSpecFor(spec))
+}
+
+// Run queries for a record specification by id.
+func (params *GetRecordSpecParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil || !specID.IsRecordSpecificationAddress() {
+		return nil, fmt.Errorf("wasm: invalid record specification id: %s", params.SpecificationID)
+	}
+	spec, found := keeper.GetRecordSpecification(ctx, specID)
+	if !found {
+		return nil, fmt.Errorf("wasm: record specification not found: %s", specID)
+	}
+	return marshalResponse(recordSpecFor(spec))
+}
+
+// Run queries for the record specifications of a contract specification.
+func (params *GetRecordSpecsParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	specID, err := types.MetadataAddressFromBech32(params.ContractSpecificationID)
+	if err != nil || !specID.IsContractSpecificationAddress() {
+		return nil, fmt.Errorf("wasm: invalid contract specification id: %s", params.ContractSpecificationID)
+	}
+	specs, err := keeper.GetRecordSpecificationsForContractSpecificationID(ctx, specID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: record specification query failed: %w", err)
+	}
+	res := RecordSpecifications{RecordSpecifications: []*RecordSpecification{}}
+	for _, s := range specs {
+		res.RecordSpecifications = append(res.RecordSpecifications, recordSpecFor(*s))
+	}
+	return marshalResponse(res)
+}
+
+// Run queries for the ids of scopes associated with an address.
+func (params *GetOwnershipParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	address, err := sdk.AccAddressFromBech32(params.Address)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid address: %w", err)
+	}
+	res := ScopeIDs{ScopeIDs: []string{}}
+	err = keeper.IterateScopesForAddress(ctx, address, func(scopeID types.MetadataAddress) (stop bool) {
+		res.ScopeIDs = append(res.ScopeIDs, scopeID.String())
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: ownership query failed: %w", err)
+	}
+	return marshalResponse(res)
+}
+
+// Run queries for the ids of scopes an address is the value owner of.
+func (params *GetValueOwnershipParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	address, err := sdk.AccAddressFromBech32(params.Address)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid address: %w", err)
+	}
+	res := ScopeIDs{ScopeIDs: []string{}}
+	err = keeper.IterateScopesForValueOwner(ctx, address, func(scopeID types.MetadataAddress) (stop bool) {
+		res.ScopeIDs = append(res.ScopeIDs, scopeID.String())
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: value ownership query failed: %w", err)
+	}
+	return marshalResponse(res)
+}
+
+// Run queries for the ids of scopes an address may read the data of as an owner, data access, or value owner party.
+func (params *GetDataAccessParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	address, err := sdk.AccAddressFromBech32(params.Address)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid address: %w", err)
+	}
+	res := ScopeIDs{ScopeIDs: []string{}}
+	err = keeper.IterateScopesForDataAccess(ctx, address, func(scopeID types.MetadataAddress) (stop bool) {
+		res.ScopeIDs = append(res.ScopeIDs, scopeID.String())
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: data access query failed: %w", err)
+	}
+	return marshalResponse(res)
+}
+
+// Run checks whether an address may receive the data of a scope.
+func (params *CanAccessParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	id, err := types.MetadataAddressFromBech32(params.ID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid id: %s", params.ID)
+	}
+	scopeID, err := id.AsScopeAddress()
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid id: %s", params.ID)
+	}
+	address, err := sdk.AccAddressFromBech32(params.Address)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid address: %w", err)
+	}
+	scope, found := keeper.GetScope(ctx, scopeID)
+	if !found {
+		return nil, fmt.Errorf("wasm: scope not found: %s", scopeID)
+	}
+	return marshalResponse(scopeAccessFor(keeper.GetScopeAccess(ctx, scope, address.String())))
+}
+
+// Parse a bech32 scope address.
+func parseScopeID(scopeID string) (types.MetadataAddress, error) {
+	addr, err := types.MetadataAddressFromBech32(scopeID)
+	if err != nil || !addr.IsScopeAddress() {
+		return nil, fmt.Errorf("wasm: invalid scope id: %s", scopeID)
+	}
+	return addr, nil
+}
+
+// Create a JSON response from the results of a metadata query.
+func marshalResponse(res interface{}) ([]byte, error) {
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal response failed: %w", err)
+	}
+	return bz, nil
+}
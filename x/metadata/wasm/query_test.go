@@ -0,0 +1,94 @@
+package wasm_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/types"
+	"github.com/provenance-io/provenance/x/metadata/wasm"
+)
+
+func TestQuerier(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	querier := wasm.Querier(app.MetadataKeeper)
+
+	owner := sdk.AccAddress("owner_______________")
+	valueOwner := sdk.AccAddress("value_owner_________")
+	other := sdk.AccAddress("other_______________")
+	scopeID := types.ScopeMetadataAddress(uuid.MustParse("8d80b25a-c089-4446-956e-5d08cfe3e1a5"))
+	missingID := types.ScopeMetadataAddress(uuid.MustParse("0a4d5b6c-0c2f-4a26-9a0d-3c5f2e7b1d44"))
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.MustParse("22fc17a6-40dd-4d68-a95b-ec94e7572a09"))
+	app.MetadataKeeper.SetScope(ctx, *types.NewScope(scopeID, scopeSpecID,
+		[]types.Party{{Address: owner.String(), Role: types.PartyType_PARTY_TYPE_OWNER}}, nil, valueOwner.String()))
+
+	query := func(q string) ([]byte, error) {
+		return querier(ctx, json.RawMessage(q), "")
+	}
+
+	t.Run("get scope", func(t *testing.T) {
+		bz, err := query(fmt.Sprintf(`{"metadata":{"get_scope":{"scope_id":"%s"}}}`, scopeID))
+		require.NoError(t, err)
+		var scope wasm.Scope
+		require.NoError(t, json.Unmarshal(bz, &scope))
+		require.Equal(t, wasm.Scope{
+			ScopeID:           scopeID.String(),
+			SpecificationID:   scopeSpecID.String(),
+			Owners:            []*wasm.Party{{Address: owner.String(), Role: "owner"}},
+			ValueOwnerAddress: valueOwner.String(),
+		}, scope)
+	})
+
+	t.Run("get scope not found", func(t *testing.T) {
+		_, err := query(fmt.Sprintf(`{"metadata":{"get_scope":{"scope_id":"%s"}}}`, missingID))
+		require.EqualError(t, err, fmt.Sprintf("wasm: scope not found: %s", missingID))
+	})
+
+	t.Run("get scope invalid scope id", func(t *testing.T) {
+		_, err := query(fmt.Sprintf(`{"metadata":{"get_scope":{"scope_id":"%s"}}}`, scopeSpecID))
+		require.EqualError(t, err, fmt.Sprintf("wasm: invalid scope id: %s", scopeSpecID))
+	})
+
+	scopeIDs := func(t *testing.T, q string) []string {
+		bz, err := query(q)
+		require.NoError(t, err)
+		var res wasm.ScopeIDs
+		require.NoError(t, json.Unmarshal(bz, &res))
+		return res.ScopeIDs
+	}
+
+	t.Run("get ownership", func(t *testing.T) {
+		require.Equal(t, []string{scopeID.String()},
+			scopeIDs(t, fmt.Sprintf(`{"metadata":{"get_ownership":{"address":"%s"}}}`, owner)))
+		require.Empty(t, scopeIDs(t, fmt.Sprintf(`{"metadata":{"get_ownership":{"address":"%s"}}}`, other)))
+	})
+
+	t.Run("get ownership invalid address", func(t *testing.T) {
+		_, err := query(`{"metadata":{"get_ownership":{"address":""}}}`)
+		require.EqualError(t, err, "wasm: invalid address: empty address string is not allowed")
+	})
+
+	t.Run("get value ownership", func(t *testing.T) {
+		require.Equal(t, []string{scopeID.String()},
+			scopeIDs(t, fmt.Sprintf(`{"metadata":{"get_value_ownership":{"address":"%s"}}}`, valueOwner)))
+		require.Empty(t, scopeIDs(t, fmt.Sprintf(`{"metadata":{"get_value_ownership":{"address":"%s"}}}`, owner)))
+	})
+
+	t.Run("empty params", func(t *testing.T) {
+		_, err := query(`{"metadata":{}}`)
+		require.EqualError(t, err, `wasm: invalid metadata query: {"metadata":{}}`)
+	})
+
+	t.Run("missing params", func(t *testing.T) {
+		_, err := query(`{}`)
+		require.EqualError(t, err, "wasm: nil metadata query params")
+	})
+}
@@ -0,0 +1,545 @@
+// Package wasm supports smart contract integration with the provenance metadata module.
+package wasm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// Types in this file mirror the metadata protobuf types in a provwasm supported format.  Metadata addresses are
+// represented as bech32 strings and enums as lower case names without their type prefix (ie "owner" for
+// PARTY_TYPE_OWNER).
+
+// Scope is a root reference for a collection of records owned by one or more parties.
+type Scope struct {
+	ScopeID           string   `json:"scope_id"`
+	SpecificationID   string   `json:"specification_id"`
+	Owners            []*Party `json:"owners"`
+	DataAccess        []string `json:"data_access,omitempty"`
+	ValueOwnerAddress string   `json:"value_owner_address"`
+}
+
+// Sessions is a list of sessions for a scope.
+type Sessions struct {
+	Sessions []*Session `json:"sessions"`
+}
+
+// Session is the state of an execution context for a specification instance.
+type Session struct {
+	SessionID       string   `json:"session_id"`
+	SpecificationID string   `json:"specification_id"`
+	Parties         []*Party `json:"parties"`
+	Name            string   `json:"name"`
+}
+
+// Records is a list of records for a scope.
+type Records struct {
+	Records []*Record `json:"records"`
+}
+
+// Record is a record of fact for a session.
+type Record struct {
+	Name      string          `json:"name"`
+	SessionID string          `json:"session_id"`
+	Process   *Process        `json:"process"`
+	Inputs    []*RecordInput  `json:"inputs,omitempty"`
+	Outputs   []*RecordOutput `json:"outputs,omitempty"`
+}
+
+// Process is the entity that generated a record.  Only one of address or hash should be set.
+type Process struct {
+	Address string `json:"address,omitempty"`
+	Hash    string `json:"hash,omitempty"`
+	Name    string `json:"name"`
+	Method  string `json:"method"`
+}
+
+// RecordInput is a proposed value or a reference to an existing record used as input to a record.
+// Only one of record id or hash should be set.
+type RecordInput struct {
+	Name     string `json:"name"`
+	RecordID string `json:"record_id,omitempty"`
+	Hash     string `json:"hash,omitempty"`
+	TypeName string `json:"type_name"`
+	Status   string `json:"status"`
+}
+
+// RecordOutput is the result of a process execution.
+type RecordOutput struct {
+	Hash   string `json:"hash"`
+	Status string `json:"status"`
+}
+
+// Party is an address performing a role.
+type Party struct {
+	Address string `json:"address"`
+	Role    string `json:"role"`
+}
+
+// ScopeIDs is a list of scope identifiers.
+type ScopeIDs struct {
+	ScopeIDs []string `json:"scope_ids"`
+}
+
+// ScopeAccess is the grounds an address has to receive the data of a scope.
+type ScopeAccess struct {
+	ScopeID      string `json:"scope_id"`
+	Address      string `json:"address"`
+	Allowed      bool   `json:"allowed"`
+	Owner        bool   `json:"owner"`
+	DataAccess   bool   `json:"data_access"`
+	ValueOwner   bool   `json:"value_owner"`
+	MarkerAccess bool   `json:"marker_access"`
+}
+
+// ScopeSpecification defines the contract specifications and parties allowed in a scope.
+type ScopeSpecification struct {
+	SpecificationID string       `json:"specification_id"`
+	Description     *Description `json:"description,omitempty"`
+	OwnerAddresses  []string     `json:"owner_addresses"`
+	PartiesInvolved []string     `json:"parties_involved"`
+	ContractSpecIDs []string     `json:"contract_spec_ids"`
+}
+
+// ContractSpecification defines the parties and source of a contract.  Only one of resource id or hash should be set.
+type ContractSpecification struct {
+	SpecificationID string       `json:"specification_id"`
+	Description     *Description `json:"description,omitempty"`
+	OwnerAddresses  []string     `json:"owner_addresses"`
+	PartiesInvolved []string     `json:"parties_involved"`
+	ResourceID      string       `json:"resource_id,omitempty"`
+	Hash            string       `json:"hash,omitempty"`
+	ClassName       string       `json:"class_name"`
+}
+
+// RecordSpecifications is a list of record specifications for a contract specification.
+type RecordSpecifications struct {
+	RecordSpecifications []*RecordSpecification `json:"record_specifications"`
+}
+
+// RecordSpecification defines the inputs, result type, and responsible parties of a record.
+type RecordSpecification struct {
+	SpecificationID    string                `json:"specification_id"`
+	Name               string                `json:"name"`
+	Inputs             []*InputSpecification `json:"inputs,omitempty"`
+	TypeName           string                `json:"type_name"`
+	ResultType         string                `json:"result_type"`
+	ResponsibleParties []string              `json:"responsible_parties"`
+}
+
+// InputSpecification defines a record input.  Only one of record id or hash should be set.
+type InputSpecification struct {
+	Name     string `json:"name"`
+	TypeName string `json:"type_name"`
+	RecordID string `json:"record_id,omitempty"`
+	Hash     string `json:"hash,omitempty"`
+}
+
+// Description is general information about a specification.
+type Description struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	WebsiteURL  string `json:"website_url,omitempty"`
+	IconURL     string `json:"icon_url,omitempty"`
+}
+
+// Enum type prefixes removed from (and added back to) names exchanged with smart contracts.
+const (
+	partyTypePrefix         = "PARTY_TYPE_"
+	recordInputStatusPrefix = "RECORD_INPUT_STATUS_"
+	resultStatusPrefix      = "RESULT_STATUS_"
+	definitionTypePrefix    = "DEFINITION_TYPE_"
+)
+
+// Adapt a protobuf enum name to provwasm format.
+func enumNameFor(name, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
+}
+
+// Adapt a provwasm enum name to the protobuf enum value.
+func enumValueFor(name, prefix string, values map[string]int32) (int32, error) {
+	value, ok := values[prefix+strings.ToUpper(strings.TrimSpace(name))]
+	if !ok {
+		return 0, fmt.Errorf("unknown value %q", name)
+	}
+	return value, nil
+}
+
+// Parse an optional metadata address, an empty string is an empty address.
+func parseMetadataAddress(address string) (types.MetadataAddress, error) {
+	if len(strings.TrimSpace(address)) == 0 {
+		return types.MetadataAddress{}, nil
+	}
+	return types.MetadataAddressFromBech32(address)
+}
+
+// Convert a core scope to provwasm supported format.
+func scopeFor(input types.Scope) *Scope {
+	return &Scope{
+		ScopeID:           input.ScopeId.String(),
+		SpecificationID:   input.SpecificationId.String(),
+		Owners:            partiesFor(input.Owners),
+		DataAccess:        input.DataAccess,
+		ValueOwnerAddress: input.ValueOwnerAddress,
+	}
+}
+
+// Convert a core scope access report to provwasm supported format.
+func scopeAccessFor(input types.CanAccessResponse) *ScopeAccess {
+	return &ScopeAccess{
+		ScopeID:      input.ScopeId,
+		Address:      input.Address,
+		Allowed:      input.Allowed,
+		Owner:        input.Owner,
+		DataAccess:   input.DataAccess,
+		ValueOwner:   input.ValueOwner,
+		MarkerAccess: input.MarkerAccess,
+	}
+}
+
+// Convert a provwasm scope to the core type.
+func (scope *Scope) convert() (types.Scope, error) {
+	scopeID, err := types.MetadataAddressFromBech32(scope.ScopeID)
+	if err != nil {
+		return types.Scope{}, fmt.Errorf("invalid scope id: %w", err)
+	}
+	specID, err := parseMetadataAddress(scope.SpecificationID)
+	if err != nil {
+		return types.Scope{}, fmt.Errorf("invalid scope specification id: %w", err)
+	}
+	owners, err := convertParties(scope.Owners)
+	if err != nil {
+		return types.Scope{}, err
+	}
+	return *types.NewScope(scopeID, specID, owners, scope.DataAccess, scope.ValueOwnerAddress), nil
+}
+
+// Convert a core session to provwasm supported format.
+func sessionFor(input types.Session) *Session {
+	return &Session{
+		SessionID:       input.SessionId.String(),
+		SpecificationID: input.SpecificationId.String(),
+		Parties:         partiesFor(input.Parties),
+		Name:            input.Name,
+	}
+}
+
+// Convert a provwasm session to the core type.  Audit fields are maintained by the keeper.
+func (session *Session) convert() (types.Session, error) {
+	sessionID, err := types.MetadataAddressFromBech32(session.SessionID)
+	if err != nil {
+		return types.Session{}, fmt.Errorf("invalid session id: %w", err)
+	}
+	specID, err := types.MetadataAddressFromBech32(session.SpecificationID)
+	if err != nil {
+		return types.Session{}, fmt.Errorf("invalid contract specification id: %w", err)
+	}
+	parties, err := convertParties(session.Parties)
+	if err != nil {
+		return types.Session{}, err
+	}
+	return *types.NewSession(session.Name, sessionID, specID, parties, nil), nil
+}
+
+// Convert a core record to provwasm supported format.
+func recordFor(input types.Record) *Record {
+	record := &Record{
+		Name:      input.Name,
+		SessionID: input.SessionId.String(),
+		Process: &Process{
+			Name:   input.Process.Name,
+			Method: input.Process.Method,
+		},
+	}
+	switch id := input.Process.ProcessId.(type) {
+	case *types.Process_Address:
+		record.Process.Address = id.Address
+	case *types.Process_Hash:
+		record.Process.Hash = id.Hash
+	}
+	for _, in := range input.Inputs {
+		ri := &RecordInput{
+			Name:     in.Name,
+			TypeName: in.TypeName,
+			Status:   enumNameFor(in.Status.String(), recordInputStatusPrefix),
+		}
+		switch source := in.Source.(type) {
+		case *types.RecordInput_RecordId:
+			ri.RecordID = source.RecordId.String()
+		case *types.RecordInput_Hash:
+			ri.Hash = source.Hash
+		}
+		record.Inputs = append(record.Inputs, ri)
+	}
+	for _, out := range input.Outputs {
+		record.Outputs = append(record.Outputs, &RecordOutput{
+			Hash:   out.Hash,
+			Status: enumNameFor(out.Status.String(), resultStatusPrefix),
+		})
+	}
+	return record
+}
+
+// Convert a provwasm record to the core type.
+func (record *Record) convert() (types.Record, error) {
+	sessionID, err := types.MetadataAddressFromBech32(record.SessionID)
+	if err != nil {
+		return types.Record{}, fmt.Errorf("invalid session id: %w", err)
+	}
+	if record.Process == nil {
+		return types.Record{}, fmt.Errorf("missing record process")
+	}
+	process := types.Process{Name: record.Process.Name, Method: record.Process.Method}
+	switch {
+	case len(record.Process.Address) > 0 && len(record.Process.Hash) > 0:
+		return types.Record{}, fmt.Errorf("only one of process address or hash is allowed")
+	case len(record.Process.Address) > 0:
+		process.ProcessId = &types.Process_Address{Address: record.Process.Address}
+	default:
+		process.ProcessId = &types.Process_Hash{Hash: record.Process.Hash}
+	}
+	inputs := make([]types.RecordInput, len(record.Inputs))
+	for i, in := range record.Inputs {
+		if in == nil {
+			return types.Record{}, fmt.Errorf("invalid record input %d: empty input", i)
+		}
+		status, err := enumValueFor(in.Status, recordInputStatusPrefix, types.RecordInputStatus_value)
+		if err != nil {
+			return types.Record{}, fmt.Errorf("invalid record input status: %w", err)
+		}
+		inputs[i] = types.RecordInput{Name: in.Name, TypeName: in.TypeName, Status: types.RecordInputStatus(status)}
+		if len(in.RecordID) > 0 {
+			recordID, err := types.MetadataAddressFromBech32(in.RecordID)
+			if err != nil {
+				return types.Record{}, fmt.Errorf("invalid record input record id: %w", err)
+			}
+			inputs[i].Source = &types.RecordInput_RecordId{RecordId: recordID}
+		} else {
+			inputs[i].Source = &types.RecordInput_Hash{Hash: in.Hash}
+		}
+	}
+	outputs := make([]types.RecordOutput, len(record.Outputs))
+	for i, out := range record.Outputs {
+		if out == nil {
+			return types.Record{}, fmt.Errorf("invalid record output %d: empty output", i)
+		}
+		status, err := enumValueFor(out.Status, resultStatusPrefix, types.ResultStatus_value)
+		if err != nil {
+			return types.Record{}, fmt.Errorf("invalid record output status: %w", err)
+		}
+		outputs[i] = *types.NewRecordOutput(out.Hash, types.ResultStatus(status))
+	}
+	return *types.NewRecord(record.Name, sessionID, process, inputs, outputs), nil
+}
+
+// Convert core parties to provwasm supported format.
+func partiesFor(input []types.Party) []*Party {
+	parties := make([]*Party, len(input))
+	for i, p := range input {
+		parties[i] = &Party{Address: p.Address, Role: enumNameFor(p.Role.String(), partyTypePrefix)}
+	}
+	return parties
+}
+
+// Convert provwasm parties to the core type.
+func convertParties(input []*Party) ([]types.Party, error) {
+	parties := make([]types.Party, len(input))
+	for i, p := range input {
+		if p == nil {
+			return nil, fmt.Errorf("invalid party %d: empty party", i)
+		}
+		role, err := enumValueFor(p.Role, partyTypePrefix, types.PartyType_value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid party role: %w", err)
+		}
+		parties[i] = types.Party{Address: p.Address, Role: types.PartyType(role)}
+	}
+	return parties, nil
+}
+
+// Convert core party types to provwasm supported format.
+func partyTypesFor(input []types.PartyType) []string {
+	partyTypes := make([]string, len(input))
+	for i, p := range input {
+		partyTypes[i] = enumNameFor(p.String(), partyTypePrefix)
+	}
+	return partyTypes
+}
+
+// Convert provwasm party types to the core type.
+func convertPartyTypes(input []string) ([]types.PartyType, error) {
+	partyTypes := make([]types.PartyType, len(input))
+	for i, p := range input {
+		role, err := enumValueFor(p, partyTypePrefix, types.PartyType_value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid party type: %w", err)
+		}
+		partyTypes[i] = types.PartyType(role)
+	}
+	return partyTypes, nil
+}
+
+// Convert a core description to provwasm supported format.
+func descriptionFor(input *types.Description) *Description {
+	if input == nil {
+		return nil
+	}
+	return &Description{
+		Name:        input.Name,
+		Description: input.Description,
+		WebsiteURL:  input.WebsiteUrl,
+		IconURL:     input.IconUrl,
+	}
+}
+
+// Convert a provwasm description to the core type.
+func (description *Description) convert() *types.Description {
+	if description == nil {
+		return nil
+	}
+	return types.NewDescription(description.Name, description.Description, description.WebsiteURL, description.IconURL)
+}
+
+// Convert a core scope specification to provwasm supported format.
+func scopeSpecFor(input types.ScopeSpecification) *ScopeSpecification {
+	spec := &ScopeSpecification{
+		SpecificationID: input.SpecificationId.String(),
+		Description:     descriptionFor(input.Description),
+		OwnerAddresses:  input.OwnerAddresses,
+		PartiesInvolved: partyTypesFor(input.PartiesInvolved),
+		ContractSpecIDs: make([]string, len(input.ContractSpecIds)),
+	}
+	for i, id := range input.ContractSpecIds {
+		spec.ContractSpecIDs[i] = id.String()
+	}
+	return spec
+}
+
+// Convert a provwasm scope specification to the core type.
+func (spec *ScopeSpecification) convert() (types.ScopeSpecification, error) {
+	specID, err := types.MetadataAddressFromBech32(spec.SpecificationID)
+	if err != nil {
+		return types.ScopeSpecification{}, fmt.Errorf("invalid scope specification id: %w", err)
+	}
+	partiesInvolved, err := convertPartyTypes(spec.PartiesInvolved)
+	if err != nil {
+		return types.ScopeSpecification{}, err
+	}
+	contractSpecIDs := make([]types.MetadataAddress, len(spec.ContractSpecIDs))
+	for i, id := range spec.ContractSpecIDs {
+		if contractSpecIDs[i], err = types.MetadataAddressFromBech32(id); err != nil {
+			return types.ScopeSpecification{}, fmt.Errorf("invalid contract specification id: %w", err)
+		}
+	}
+	return *types.NewScopeSpecification(
+		specID, spec.Description.convert(), spec.OwnerAddresses, partiesInvolved, contractSpecIDs,
+	), nil
+}
+
+// Convert a core contract specification to provwasm supported format.
+func contractSpecFor(input types.ContractSpecification) *ContractSpecification {
+	spec := &ContractSpecification{
+		SpecificationID: input.SpecificationId.String(),
+		Description:     descriptionFor(input.Description),
+		OwnerAddresses:  input.OwnerAddresses,
+		PartiesInvolved: partyTypesFor(input.PartiesInvolved),
+		ClassName:       input.ClassName,
+	}
+	switch source := input.Source.(type) {
+	case *types.ContractSpecification_ResourceId:
+		spec.ResourceID = source.ResourceId.String()
+	case *types.ContractSpecification_Hash:
+		spec.Hash = source.Hash
+	}
+	return spec
+}
+
+// Convert a provwasm contract specification to the core type.
+func (spec *ContractSpecification) convert() (types.ContractSpecification, error) {
+	specID, err := types.MetadataAddressFromBech32(spec.SpecificationID)
+	if err != nil {
+		return types.ContractSpecification{}, fmt.Errorf("invalid contract specification id: %w", err)
+	}
+	partiesInvolved, err := convertPartyTypes(spec.PartiesInvolved)
+	if err != nil {
+		return types.ContractSpecification{}, err
+	}
+	contractSpec := types.NewContractSpecification(
+		specID, spec.Description.convert(), spec.OwnerAddresses, partiesInvolved, nil, spec.ClassName,
+	)
+	switch {
+	case len(spec.ResourceID) > 0 && len(spec.Hash) > 0:
+		return types.ContractSpecification{}, fmt.Errorf("only one of resource id or hash is allowed")
+	case len(spec.ResourceID) > 0:
+		resourceID, err := types.MetadataAddressFromBech32(spec.ResourceID)
+		if err != nil {
+			return types.ContractSpecification{}, fmt.Errorf("invalid contract specification resource id: %w", err)
+		}
+		contractSpec.Source = types.NewContractSpecificationSourceResourceID(resourceID)
+	default:
+		contractSpec.Source = types.NewContractSpecificationSourceHash(spec.Hash)
+	}
+	return *contractSpec, nil
+}
+
+// Convert a core record specification to provwasm supported format.
+func recordSpecFor(input types.RecordSpecification) *RecordSpecification {
+	spec := &RecordSpecification{
+		SpecificationID:    input.SpecificationId.String(),
+		Name:               input.Name,
+		TypeName:           input.TypeName,
+		ResultType:         enumNameFor(input.ResultType.String(), definitionTypePrefix),
+		ResponsibleParties: partyTypesFor(input.ResponsibleParties),
+	}
+	for _, in := range input.Inputs {
+		if in == nil {
+			continue
+		}
+		is := &InputSpecification{Name: in.Name, TypeName: in.TypeName}
+		switch source := in.Source.(type) {
+		case *types.InputSpecification_RecordId:
+			is.RecordID = source.RecordId.String()
+		case *types.InputSpecification_Hash:
+			is.Hash = source.Hash
+		}
+		spec.Inputs = append(spec.Inputs, is)
+	}
+	return spec
+}
+
+// Convert a provwasm record specification to the core type.
+func (spec *RecordSpecification) convert() (types.RecordSpecification, error) {
+	specID, err := types.MetadataAddressFromBech32(spec.SpecificationID)
+	if err != nil {
+		return types.RecordSpecification{}, fmt.Errorf("invalid record specification id: %w", err)
+	}
+	resultType, err := enumValueFor(spec.ResultType, definitionTypePrefix, types.DefinitionType_value)
+	if err != nil {
+		return types.RecordSpecification{}, fmt.Errorf("invalid record specification result type: %w", err)
+	}
+	responsibleParties, err := convertPartyTypes(spec.ResponsibleParties)
+	if err != nil {
+		return types.RecordSpecification{}, err
+	}
+	inputs := make([]*types.InputSpecification, len(spec.Inputs))
+	for i, in := range spec.Inputs {
+		if in == nil {
+			return types.RecordSpecification{}, fmt.Errorf("invalid input specification %d: empty input", i)
+		}
+		inputs[i] = types.NewInputSpecification(in.Name, in.TypeName, nil)
+		if len(in.RecordID) > 0 {
+			recordID, err := types.MetadataAddressFromBech32(in.RecordID)
+			if err != nil {
+				return types.RecordSpecification{}, fmt.Errorf("invalid input specification record id: %w", err)
+			}
+			inputs[i].Source = types.NewInputSpecificationSourceRecordID(recordID)
+		} else {
+			inputs[i].Source = types.NewInputSpecificationSourceHash(in.Hash)
+		}
+	}
+	return *types.NewRecordSpecification(
+		specID, spec.Name, inputs, spec.TypeName, types.DefinitionType(resultType), responsibleParties,
+	), nil
+}
@@ -189,6 +189,43 @@ func (s *IntegrationTestSuite) TestReverseLookupCommand() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestChildrenCommand() {
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedOutput string
+	}{
+		{
+			"query children, json output",
+			[]string{"attribute", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			fmt.Sprintf("{\"records\":[{\"name\":\"example.attribute\",\"address\":\"%s\",\"restricted\":false}],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}", s.accountAddr.String()),
+		},
+		{
+			"query children, text output",
+			[]string{"attribute", fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
+			fmt.Sprintf("pagination:\n  next_key: null\n  total: \"0\"\nrecords:\n- address: %s\n  name: example.attribute\n  restricted: false", s.accountAddr.String()),
+		},
+		{
+			"query name without children, json output",
+			[]string{"example.attribute", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			"{\"records\":[],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := namecli.ChildrenCommand()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetBindNameCommand() {
 
 	testCases := []struct {
@@ -326,6 +363,78 @@ func (s *IntegrationTestSuite) TestGetDeleteNameCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetModifyAndTransferNameCmd() {
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name         string
+		cmd          *cobra.Command
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"bind name for modification",
+			namecli.GetBindNameCmd(),
+			append([]string{"tomodify", s.testnet.Validators[0].Address.String(), "attribute"}, txFlags...),
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should modify name restriction",
+			namecli.GetModifyNameCmd(),
+			append([]string{"tomodify.attribute", s.testnet.Validators[0].Address.String(), "--restrict=false"}, txFlags...),
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to modify name that does not exist",
+			namecli.GetModifyNameCmd(),
+			append([]string{"dne.attribute", s.testnet.Validators[0].Address.String()}, txFlags...),
+			false, &sdk.TxResponse{}, 18,
+		},
+		{
+			"should transfer name",
+			namecli.GetTransferNameCmd(),
+			append([]string{"tomodify.attribute", s.testnet.Validators[0].Address.String()}, txFlags...),
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to transfer name, not authorized",
+			namecli.GetTransferNameCmd(),
+			append([]string{"example.attribute", s.testnet.Validators[0].Address.String()}, txFlags...),
+			false, &sdk.TxResponse{}, 4,
+		},
+		{
+			"should fail to transfer name, invalid address",
+			namecli.GetTransferNameCmd(),
+			append([]string{"tomodify.attribute", "invalid"}, txFlags...),
+			true, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			clientCtx := s.testnet.Validators[0].ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -29,6 +29,7 @@ func GetQueryCmd() *cobra.Command {
 		QueryParamsCmd(),
 		ResolveNameCommand(),
 		ReverseLookupCommand(),
+		ChildrenCommand(),
 	)
 
 	return queryCmd
@@ -157,3 +158,50 @@ $ %s query name loopup pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --page=2 --limi
 
 	return cmd
 }
+
+// ChildrenCommand returns the command handler for listing the names bound directly under a parent name.
+func ChildrenCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "children [name]",
+		Short: "List all names bound directly under a given name",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the name records bound directly under a given parent name:
+
+Example:
+$ %s query name children provenance.io
+$ %s query name children provenance.io --page=2 --limit=100
+`,
+				version.AppName, version.AppName,
+			)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			name := strings.ToLower(strings.TrimSpace(args[0]))
+
+			var response *types.QueryChildrenResponse
+			if response, err = queryClient.Children(
+				context.Background(),
+				&types.QueryChildrenRequest{Name: name, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query children of name \"%s\": %v\n", name, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "children")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -31,6 +31,8 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetBindNameCmd(),
 		GetDeleteNameCmd(),
+		GetModifyNameCmd(),
+		GetTransferNameCmd(),
 	)
 	return txCmd
 }
@@ -103,3 +105,84 @@ func GetDeleteNameCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetModifyNameCmd is the CLI command for updating the address and restriction of a bound name.
+func GetModifyNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "modify [name] [address]",
+		Short: "Update the address and restriction of a bound name in the provenance blockchain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the address and restriction of a bound name. Must be signed by the current owner of the
+name or by the owner of its restricted parent name:
+
+Example:
+$ %s tx name modify sample.root.example pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --restrict=false
+`,
+				version.AppName,
+			)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			restricted, err := cmd.Flags().GetBool(flagRestricted)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgModifyNameRequest(
+				clientCtx.FromAddress,
+				types.NewNameRecord(
+					strings.TrimSpace(strings.ToLower(args[0])),
+					address,
+					restricted,
+				),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().BoolP(flagRestricted, "r", true, "Restrict creation of child names to owner only")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTransferNameCmd is the CLI command for moving a bound name to a new address.
+func GetTransferNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [name] [new-address]",
+		Short: "Transfer a bound name to a new address in the provenance blockchain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer a bound name to a new address, keeping its restriction. Must be signed by the current
+owner of the name or by the owner of its restricted parent name:
+
+Example:
+$ %s tx name transfer sample.root.example pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgTransferNameRequest(
+				clientCtx.FromAddress,
+				strings.TrimSpace(strings.ToLower(args[0])),
+				address,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -101,7 +101,8 @@ func (keeper Keeper) setNameRecord(ctx sdk.Context, name string, addr sdk.AccAdd
 	}
 	indexKey := append(addrPrefix, key...) // [0x02] :: [addr-bytes] :: [name-key-bytes]
 	store.Set(indexKey, bz)
-	return nil
+	// Index by parent name as well
+	return keeper.setChildIndex(store, name, key, bz)
 }
 
 // setGenesisRecord will allow a record to be created for an address that does not exist if in proper format
@@ -137,7 +138,65 @@ func (keeper Keeper) setGenesisRecord(ctx sdk.Context, name string, addr sdk.Acc
 	}
 	indexKey := append(addrPrefix, key...) // [0x02] :: [addr-bytes] :: [name-key-bytes]
 	store.Set(indexKey, bz)
-	return nil
+	// Index by parent name as well
+	return keeper.setChildIndex(store, name, key, bz)
+}
+
+// updateNameRecord rebinds an existing name to an address and restriction. The address and parent name index entries
+// are moved along with the record. An error is returned if no account exists for the address.
+func (keeper Keeper) updateNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool) error {
+	existing, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	if account := keeper.authKeeper.GetAccount(ctx, addr); account == nil {
+		return types.ErrInvalidAddress
+	}
+	existingAddr, err := sdk.AccAddressFromBech32(existing.Address)
+	if err != nil {
+		return err
+	}
+	key, err := types.GetNameKeyPrefix(existing.Name)
+	if err != nil {
+		return err
+	}
+	record := types.NewNameRecord(existing.Name, addr, restrict)
+	bz, err := types.ModuleCdc.MarshalBinaryBare(&record)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(key, bz)
+	// Move the address index from the previous owner to the new one
+	existingPrefix, err := types.GetAddressKeyPrefix(existingAddr)
+	if err != nil {
+		return err
+	}
+	store.Delete(append(existingPrefix, key...)) // [0x02] :: [addr-bytes] :: [name-key-bytes]
+	addrPrefix, err := types.GetAddressKeyPrefix(addr)
+	if err != nil {
+		return err
+	}
+	store.Set(append(addrPrefix, key...), bz) // [0x02] :: [addr-bytes] :: [name-key-bytes]
+	// The parent name index holds a copy of the record
+	return keeper.setChildIndex(store, existing.Name, key, bz)
+}
+
+// canModifyName determines whether an address is allowed to update a name record. The current owner of a name and
+// the owner of its parent name (when the parent is restricted) may update it.
+func (keeper Keeper) canModifyName(ctx sdk.Context, record types.NameRecord, addr sdk.AccAddress) bool {
+	if record.Address == addr.String() {
+		return true
+	}
+	parentName := types.GetParentName(record.Name)
+	if parentName == "" {
+		return false
+	}
+	parent, err := keeper.GetRecordByName(ctx, parentName)
+	if err != nil {
+		return false
+	}
+	return parent.Restricted && parent.Address == addr.String()
 }
 
 // GetRecordByName resolves a record by name.
@@ -216,9 +275,77 @@ func (keeper Keeper) deleteRecord(ctx sdk.Context, name string) error {
 	if store.Has(indexKey) {
 		store.Delete(indexKey)
 	}
+	// Delete the parent name index record
+	parent := types.GetParentName(record.Name)
+	if parent == "" {
+		return nil
+	}
+	childPrefix, err := types.GetChildKeyPrefix(parent)
+	if err != nil {
+		return err
+	}
+	store.Delete(append(childPrefix, key...)) // [0x03] :: [parent-name-hash] :: [name-key-bytes]
+	return nil
+}
+
+// setChildIndex indexes a name record under its parent name. Root names are not indexed.
+func (keeper Keeper) setChildIndex(store sdk.KVStore, name string, key, bz []byte) error {
+	parent := types.GetParentName(name)
+	if parent == "" {
+		return nil
+	}
+	childPrefix, err := types.GetChildKeyPrefix(parent)
+	if err != nil {
+		return err
+	}
+	store.Set(append(childPrefix, key...), bz) // [0x03] :: [parent-name-hash] :: [name-key-bytes]
 	return nil
 }
 
+// BuildChildIndex indexes every stored name record under its parent name.  Records bound before the child index
+// existed are only found by the Children query once this has run.
+func (keeper Keeper) BuildChildIndex(ctx sdk.Context) error {
+	store := ctx.KVStore(keeper.storeKey)
+	records := types.NameRecords{}
+	if err := keeper.IterateRecords(ctx, types.NameKeyPrefix, func(record types.NameRecord) error {
+		records = append(records, record)
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, record := range records {
+		key, err := types.GetNameKeyPrefix(record.Name)
+		if err != nil {
+			return err
+		}
+		bz, err := types.ModuleCdc.MarshalBinaryBare(&record)
+		if err != nil {
+			return err
+		}
+		if err = keeper.setChildIndex(store, record.Name, key, bz); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetChildRecords looks up all name records bound directly under a parent name.
+func (keeper Keeper) GetChildRecords(ctx sdk.Context, parent string) (types.NameRecords, error) {
+	records := types.NameRecords{}
+	childPrefix, err := types.GetChildKeyPrefix(parent)
+	if err != nil {
+		return nil, err
+	}
+	appendToRecords := func(record types.NameRecord) error {
+		records = append(records, record)
+		return nil
+	}
+	if err := keeper.IterateRecords(ctx, childPrefix, appendToRecords); err != nil {
+		return records, err
+	}
+	return records, nil
+}
+
 // IterateRecords iterates over all the stored name records and passes them to a callback function.
 func (keeper Keeper) IterateRecords(ctx sdk.Context, prefix []byte, handle Handler) error {
 	// Init a name record iterator
@@ -5,7 +5,10 @@ import (
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
 
 	simapp "github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/x/name/keeper"
@@ -81,3 +84,135 @@ func normalizeName(t *testing.T, ctx sdk.Context, keeper keeper.Keeper) {
 		})
 	}
 }
+
+func TestChildNameIndex(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	owner := sdk.AccAddress([]byte("owner_address_______"))
+	genesis := types.DefaultGenesisState()
+	genesis.Bindings = []types.NameRecord{
+		types.NewNameRecord("io", owner, false),
+		types.NewNameRecord("provenance.io", owner, false),
+		types.NewNameRecord("sso.provenance.io", owner, false),
+		types.NewNameRecord("node.provenance.io", owner, false),
+		types.NewNameRecord("example.io", owner, false),
+	}
+	app.NameKeeper.InitGenesis(ctx, *genesis)
+
+	childNames := func(parent string) []string {
+		records, err := app.NameKeeper.GetChildRecords(ctx, parent)
+		require.NoError(t, err)
+		names := []string{}
+		for _, r := range records {
+			names = append(names, r.Name)
+		}
+		return names
+	}
+	require.ElementsMatch(t, []string{"provenance.io", "example.io"}, childNames("io"))
+	require.ElementsMatch(t, []string{"sso.provenance.io", "node.provenance.io"}, childNames("provenance.io"))
+	require.Empty(t, childNames("sso.provenance.io"))
+
+	res, err := app.NameKeeper.Children(sdk.WrapSDKContext(ctx), &types.QueryChildrenRequest{
+		Name:       "Provenance.IO",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+
+	msgServer := keeper.NewMsgServerImpl(app.NameKeeper)
+	_, err = msgServer.DeleteName(sdk.WrapSDKContext(ctx),
+		types.NewMsgDeleteNameRequest(types.NewNameRecord("sso.provenance.io", owner, false)))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"node.provenance.io"}, childNames("provenance.io"))
+}
+
+func TestBuildChildIndex(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	owner := sdk.AccAddress([]byte("owner_address_______"))
+	genesis := types.DefaultGenesisState()
+	genesis.Bindings = []types.NameRecord{
+		types.NewNameRecord("io", owner, false),
+		types.NewNameRecord("provenance.io", owner, false),
+		types.NewNameRecord("sso.provenance.io", owner, false),
+	}
+	app.NameKeeper.InitGenesis(ctx, *genesis)
+
+	// names bound before the child index existed have no entries in it.
+	index := prefix.NewStore(ctx.KVStore(app.GetKey(types.StoreKey)), types.ChildKeyPrefix)
+	iterator := index.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	require.Len(t, keys, 2)
+	for _, k := range keys {
+		index.Delete(k)
+	}
+	records, err := app.NameKeeper.GetChildRecords(ctx, "io")
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	require.NoError(t, app.NameKeeper.BuildChildIndex(ctx))
+	records, err = app.NameKeeper.GetChildRecords(ctx, "io")
+	require.NoError(t, err)
+	require.Equal(t, types.NameRecords{types.NewNameRecord("provenance.io", owner, false)}, records)
+	records, err = app.NameKeeper.GetChildRecords(ctx, "provenance.io")
+	require.NoError(t, err)
+	require.Equal(t, types.NameRecords{types.NewNameRecord("sso.provenance.io", owner, false)}, records)
+}
+
+func TestModifyAndTransferName(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	rootOwner := sdk.AccAddress([]byte("root_owner__________"))
+	owner := sdk.AccAddress([]byte("owner_address_______"))
+	other := sdk.AccAddress([]byte("other_address_______"))
+	for _, addr := range []sdk.AccAddress{rootOwner, owner, other} {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+	genesis := types.DefaultGenesisState()
+	genesis.Bindings = []types.NameRecord{
+		types.NewNameRecord("io", rootOwner, true),
+		types.NewNameRecord("provenance.io", owner, false),
+		types.NewNameRecord("sso.provenance.io", owner, false),
+	}
+	app.NameKeeper.InitGenesis(ctx, *genesis)
+	msgServer := keeper.NewMsgServerImpl(app.NameKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// only the owner or the owner of a restricted parent may update a name
+	_, err := msgServer.TransferName(goCtx, types.NewMsgTransferNameRequest(other, "provenance.io", other))
+	require.EqualError(t, err, "msg sender cannot modify name: unauthorized")
+	_, err = msgServer.TransferName(goCtx, types.NewMsgTransferNameRequest(owner, "sso.provenance.io", other))
+	require.NoError(t, err)
+	_, err = msgServer.TransferName(goCtx, types.NewMsgTransferNameRequest(owner, "sso.provenance.io", owner))
+	require.EqualError(t, err, "msg sender cannot modify name: unauthorized", "parent is not restricted")
+
+	// the owner of a restricted parent may update a name
+	_, err = msgServer.ModifyName(goCtx, types.NewMsgModifyNameRequest(rootOwner, types.NewNameRecord("Provenance.IO", other, true)))
+	require.NoError(t, err)
+	record, err := app.NameKeeper.GetRecordByName(ctx, "provenance.io")
+	require.NoError(t, err)
+	require.Equal(t, types.NewNameRecord("provenance.io", other, true), *record)
+
+	// address indexes are moved to the new owner
+	records, err := app.NameKeeper.GetRecordsByAddress(ctx, owner)
+	require.NoError(t, err)
+	require.Empty(t, records)
+	records, err = app.NameKeeper.GetRecordsByAddress(ctx, other)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	children, err := app.NameKeeper.GetChildRecords(ctx, "io")
+	require.NoError(t, err)
+	require.Equal(t, types.NameRecords{types.NewNameRecord("provenance.io", other, true)}, children)
+
+	// the new address must be an existing account
+	_, err = msgServer.TransferName(goCtx, types.NewMsgTransferNameRequest(other, "provenance.io", sdk.AccAddress([]byte("no_account__________"))))
+	require.EqualError(t, err, "address does not match an existing account: invalid request")
+}
@@ -126,3 +126,107 @@ func (s msgServer) DeleteName(goCtx context.Context, msg *types.MsgDeleteNameReq
 	)
 	return &types.MsgDeleteNameResponse{}, nil
 }
+
+// ModifyName updates the address and restriction of a bound name
+func (s msgServer) ModifyName(goCtx context.Context, msg *types.MsgModifyNameRequest) (*types.MsgModifyNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Record.Address)
+	if err != nil {
+		ctx.Logger().Error("invalid address", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	previous, err := s.updateName(ctx, msg.Authority, msg.Record.Name, address, msg.Record.Restricted)
+	if err != nil {
+		return nil, err
+	}
+	// Emit event and return
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNameModified,
+			sdk.NewAttribute(types.KeyAttributeName, previous.Name),
+			sdk.NewAttribute(types.KeyAttributeAddress, msg.Record.Address),
+			sdk.NewAttribute(types.KeyAttributePreviousAddress, previous.Address),
+			sdk.NewAttribute(types.KeyAttributeRestricted, fmt.Sprintf("%t", msg.Record.Restricted)),
+		),
+	)
+	return &types.MsgModifyNameResponse{}, nil
+}
+
+// TransferName moves a bound name to a new address
+func (s msgServer) TransferName(goCtx context.Context, msg *types.MsgTransferNameRequest) (*types.MsgTransferNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	address, err := sdk.AccAddressFromBech32(msg.NewAddress)
+	if err != nil {
+		ctx.Logger().Error("invalid address", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Normalize so the existing restriction can be carried over
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	record, err := s.Keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name does not exist")
+	}
+	previous, err := s.updateName(ctx, msg.Authority, name, address, record.Restricted)
+	if err != nil {
+		return nil, err
+	}
+	// Emit event and return
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNameTransferred,
+			sdk.NewAttribute(types.KeyAttributeName, previous.Name),
+			sdk.NewAttribute(types.KeyAttributeAddress, msg.NewAddress),
+			sdk.NewAttribute(types.KeyAttributePreviousAddress, previous.Address),
+		),
+	)
+	return &types.MsgTransferNameResponse{}, nil
+}
+
+// updateName checks that the authority may update the name and rebinds it, returning the record before the update.
+func (s msgServer) updateName(
+	ctx sdk.Context, authority, name string, address sdk.AccAddress, restrict bool,
+) (*types.NameRecord, error) {
+	// Normalize
+	name, err := s.Keeper.Normalize(ctx, name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Ensure the name exists
+	existing, err := s.Keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name does not exist")
+	}
+	// Ensure permission
+	authorityAddr, err := sdk.AccAddressFromBech32(authority)
+	if err != nil {
+		ctx.Logger().Error("invalid authority address", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if !s.Keeper.canModifyName(ctx, *existing, authorityAddr) {
+		ctx.Logger().Error("msg sender cannot modify name", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot modify name")
+	}
+	// Update
+	if err := s.Keeper.updateNameRecord(ctx, name, address, restrict); err != nil {
+		ctx.Logger().Error("error updating name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return existing, nil
+}
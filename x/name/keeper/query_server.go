@@ -73,3 +73,33 @@ func (keeper Keeper) ReverseLookup(c context.Context, request *types.QueryRevers
 
 	return &types.QueryReverseLookupResponse{Name: names, Pagination: pageRes}, nil
 }
+
+// Children gets the name records bound directly under a parent name.
+func (keeper Keeper) Children(c context.Context, request *types.QueryChildrenRequest) (*types.QueryChildrenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	name, err := keeper.Normalize(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	key, err := types.GetChildKeyPrefix(name)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]types.NameRecord, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	childStore := prefix.NewStore(store, key)
+	pageRes, err := query.Paginate(childStore, request.Pagination, func(key []byte, value []byte) error {
+		var record types.NameRecord
+		if err := keeper.cdc.UnmarshalBinaryBare(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChildrenResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -14,6 +14,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(MsgBindNameRequest{}, "provenance/MsgBindNameRequest", nil)
 	cdc.RegisterConcrete(MsgDeleteNameRequest{}, "provenance/MsgDeleteNameRequest", nil)
+	cdc.RegisterConcrete(MsgModifyNameRequest{}, "provenance/MsgModifyNameRequest", nil)
+	cdc.RegisterConcrete(MsgTransferNameRequest{}, "provenance/MsgTransferNameRequest", nil)
 	cdc.RegisterConcrete(CreateRootNameProposal{}, "provenance/CreateRootNameProposal", nil)
 }
 
@@ -23,6 +25,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgBindNameRequest{},
 		&MsgDeleteNameRequest{},
+		&MsgModifyNameRequest{},
+		&MsgTransferNameRequest{},
 	)
 
 	registry.RegisterImplementations(
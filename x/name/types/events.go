@@ -5,9 +5,17 @@ const (
 	EventTypeNameBound string = "name_bound"
 	// EventTypeNameUnbound is the type of event generated when a name is unbound from an address (deleted).
 	EventTypeNameUnbound string = "name_unbound"
+	// EventTypeNameModified is the type of event generated when the address or restriction of a name is updated.
+	EventTypeNameModified string = "name_modified"
+	// EventTypeNameTransferred is the type of event generated when a name is moved to a new address.
+	EventTypeNameTransferred string = "name_transferred"
 
 	// KeyAttributeName is the key for a name.
 	KeyAttributeName string = "name"
 	// KeyAttributeAddress is the key for an address.
 	KeyAttributeAddress string = "address"
+	// KeyAttributePreviousAddress is the key for the address a name was bound to before an update.
+	KeyAttributePreviousAddress string = "previous_address"
+	// KeyAttributeRestricted is the key for the restriction of a name.
+	KeyAttributeRestricted string = "restricted"
 )
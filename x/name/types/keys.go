@@ -27,6 +27,8 @@ var (
 	NameKeyPrefix = []byte{0x01}
 	// AddressKeyPrefix is a prefix added to keys for indexing name records by address.
 	AddressKeyPrefix = []byte{0x02}
+	// ChildKeyPrefix is a prefix added to keys for indexing name records by their parent name.
+	ChildKeyPrefix = []byte{0x03}
 )
 
 // GetNameKeyPrefix converts a name into key format.
@@ -58,3 +60,21 @@ func GetAddressKeyPrefix(address sdk.AccAddress) (key []byte, err error) {
 	}
 	return
 }
+
+// GetChildKeyPrefix returns a store key prefix for the name records directly under a parent name.
+func GetChildKeyPrefix(parent string) (key []byte, err error) {
+	nameKey, err := GetNameKeyPrefix(parent)
+	if err == nil {
+		key = ChildKeyPrefix
+		key = append(key, nameKey[len(NameKeyPrefix):]...)
+	}
+	return
+}
+
+// GetParentName returns the name a name was bound under, or an empty string for root names.
+func GetParentName(name string) string {
+	if i := strings.Index(name, "."); i >= 0 {
+		return name[i+1:]
+	}
+	return ""
+}
@@ -9,12 +9,14 @@ import (
 
 // name message types
 const (
-	TypeMsgBindNameRequest   = "bind_name"
-	TypeMsgDeleteNameRequest = "delete_name"
+	TypeMsgBindNameRequest     = "bind_name"
+	TypeMsgDeleteNameRequest   = "delete_name"
+	TypeMsgModifyNameRequest   = "modify_name"
+	TypeMsgTransferNameRequest = "transfer_name"
 )
 
 // Compile time interface checks.
-var _, _ sdk.Msg = &MsgBindNameRequest{}, &MsgDeleteNameRequest{}
+var _, _, _, _ sdk.Msg = &MsgBindNameRequest{}, &MsgDeleteNameRequest{}, &MsgModifyNameRequest{}, &MsgTransferNameRequest{}
 
 // NewMsgBindNameRequest creates a new bind name request
 func NewMsgBindNameRequest(record, parent NameRecord) *MsgBindNameRequest {
@@ -101,3 +103,88 @@ func (msg MsgDeleteNameRequest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgModifyNameRequest creates a new Modify Name Request
+func NewMsgModifyNameRequest(authority sdk.AccAddress, record NameRecord) *MsgModifyNameRequest {
+	return &MsgModifyNameRequest{
+		Authority: authority.String(),
+		Record:    record,
+	}
+}
+
+// Route implements Msg
+func (msg MsgModifyNameRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgModifyNameRequest) Type() string { return TypeMsgModifyNameRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgModifyNameRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+	if strings.TrimSpace(msg.Record.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Record.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgModifyNameRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the authority.
+func (msg MsgModifyNameRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgTransferNameRequest creates a new Transfer Name Request
+func NewMsgTransferNameRequest(authority sdk.AccAddress, name string, newAddress sdk.AccAddress) *MsgTransferNameRequest {
+	return &MsgTransferNameRequest{
+		Authority:  authority.String(),
+		Name:       name,
+		NewAddress: newAddress.String(),
+	}
+}
+
+// Route implements Msg
+func (msg MsgTransferNameRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgTransferNameRequest) Type() string { return TypeMsgTransferNameRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgTransferNameRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewAddress); err != nil {
+		return fmt.Errorf("invalid new address: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgTransferNameRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the authority.
+func (msg MsgTransferNameRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
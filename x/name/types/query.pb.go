@@ -280,6 +280,88 @@ func (m *QueryReverseLookupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryReverseLookupResponse proto.InternalMessageInfo
 
+// QueryChildrenRequest is the request type for the Query/Children method.
+type QueryChildrenRequest struct {
+	// parent name to find child name records for
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChildrenRequest) Reset()         { *m = QueryChildrenRequest{} }
+func (m *QueryChildrenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChildrenRequest) ProtoMessage()    {}
+func (*QueryChildrenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{6}
+}
+func (m *QueryChildrenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChildrenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChildrenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChildrenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChildrenRequest.Merge(m, src)
+}
+func (m *QueryChildrenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChildrenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChildrenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChildrenRequest proto.InternalMessageInfo
+
+// QueryChildrenResponse is the response type for the Query/Children method.
+type QueryChildrenResponse struct {
+	// the name records bound directly under the parent name
+	Records []NameRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChildrenResponse) Reset()         { *m = QueryChildrenResponse{} }
+func (m *QueryChildrenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChildrenResponse) ProtoMessage()    {}
+func (*QueryChildrenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{7}
+}
+func (m *QueryChildrenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChildrenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChildrenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChildrenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChildrenResponse.Merge(m, src)
+}
+func (m *QueryChildrenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChildrenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChildrenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChildrenResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.name.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.name.v1.QueryParamsResponse")
@@ -287,45 +369,52 @@ func init() {
 	proto.RegisterType((*QueryResolveResponse)(nil), "provenance.name.v1.QueryResolveResponse")
 	proto.RegisterType((*QueryReverseLookupRequest)(nil), "provenance.name.v1.QueryReverseLookupRequest")
 	proto.RegisterType((*QueryReverseLookupResponse)(nil), "provenance.name.v1.QueryReverseLookupResponse")
+	proto.RegisterType((*QueryChildrenRequest)(nil), "provenance.name.v1.QueryChildrenRequest")
+	proto.RegisterType((*QueryChildrenResponse)(nil), "provenance.name.v1.QueryChildrenResponse")
 }
 
 func init() { proto.RegisterFile("provenance/name/v1/query.proto", fileDescriptor_4e9b0d5536fc961a) }
 
 var fileDescriptor_4e9b0d5536fc961a = []byte{
-	// 515 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xb1, 0x6e, 0x13, 0x41,
-	0x10, 0xbd, 0x4d, 0x82, 0x03, 0x8b, 0x68, 0x06, 0x23, 0x99, 0x53, 0x38, 0xa3, 0x53, 0xe4, 0x44,
-	0x11, 0xd9, 0x8d, 0x93, 0x06, 0x51, 0xa6, 0x80, 0x06, 0x09, 0x73, 0x25, 0xdd, 0xda, 0x59, 0x1d,
-	0x27, 0xec, 0x9b, 0xcb, 0xed, 0xf9, 0x44, 0x14, 0xb9, 0x81, 0x82, 0x94, 0x48, 0xb4, 0x14, 0xf9,
-	0x04, 0x3e, 0x23, 0x65, 0x24, 0x1a, 0x2a, 0x84, 0x6c, 0x0a, 0x3e, 0x03, 0xdd, 0xee, 0x5a, 0xb6,
-	0xe5, 0x0d, 0xa6, 0x5b, 0xcf, 0xbc, 0x37, 0xef, 0x8d, 0xdf, 0x1c, 0x0d, 0xb2, 0x1c, 0x4b, 0x99,
-	0x8a, 0xb4, 0x27, 0x79, 0x2a, 0x06, 0x92, 0x97, 0x6d, 0x7e, 0x3a, 0x94, 0xf9, 0x19, 0xcb, 0x72,
-	0x2c, 0x10, 0x60, 0xd6, 0x67, 0x55, 0x9f, 0x95, 0x6d, 0x7f, 0xaf, 0x87, 0x6a, 0x80, 0x8a, 0x77,
-	0x85, 0x92, 0x06, 0xcc, 0xcb, 0x76, 0x57, 0x16, 0xa2, 0xcd, 0x33, 0x11, 0x27, 0xa9, 0x28, 0x12,
-	0x4c, 0x0d, 0xdf, 0xaf, 0xc7, 0x18, 0xa3, 0x7e, 0xf2, 0xea, 0x65, 0xab, 0x5b, 0x31, 0x62, 0xdc,
-	0x97, 0x5c, 0x64, 0x09, 0x17, 0x69, 0x8a, 0x85, 0xa6, 0x28, 0xdb, 0x7d, 0xe4, 0xf0, 0xa4, 0xb5,
-	0x75, 0x3b, 0xac, 0x53, 0x78, 0x5d, 0x89, 0x76, 0x44, 0x2e, 0x06, 0x2a, 0x92, 0xa7, 0x43, 0xa9,
-	0x8a, 0xf0, 0x15, 0xbd, 0xbf, 0x50, 0x55, 0x19, 0xa6, 0x4a, 0xc2, 0x53, 0x5a, 0xcb, 0x74, 0xa5,
-	0x41, 0x1e, 0x93, 0xdd, 0xbb, 0x87, 0x3e, 0x5b, 0x5e, 0x88, 0x19, 0xce, 0xf1, 0xc6, 0xd5, 0xcf,
-	0xa6, 0x17, 0x59, 0x7c, 0x78, 0x64, 0x07, 0x46, 0x52, 0x61, 0xbf, 0x94, 0x56, 0x07, 0x80, 0x6e,
-	0x54, 0x34, 0x3d, 0xee, 0x4e, 0xa4, 0xdf, 0xcf, 0x6e, 0x5f, 0x5c, 0x36, 0xbd, 0x3f, 0x97, 0x4d,
-	0x2f, 0x3c, 0xa0, 0xf5, 0x45, 0x92, 0xb5, 0xd1, 0xa0, 0x9b, 0xe2, 0xe4, 0x24, 0x97, 0x4a, 0x59,
-	0xe2, 0xf4, 0x67, 0xf8, 0x89, 0xd0, 0x87, 0x96, 0x52, 0xca, 0x5c, 0xc9, 0x97, 0x88, 0xef, 0x86,
-	0xd9, 0x54, 0xed, 0x46, 0x1e, 0x3c, 0xa7, 0x74, 0xf6, 0x67, 0x37, 0xd6, 0xf4, 0x72, 0x2d, 0x66,
-	0x92, 0x61, 0x55, 0x32, 0xcc, 0xc4, 0x68, 0x93, 0x61, 0x1d, 0x11, 0x4f, 0x77, 0x88, 0xe6, 0x98,
-	0x73, 0xde, 0x3f, 0x12, 0xea, 0xbb, 0x9c, 0xd8, 0x15, 0x66, 0x8b, 0xaf, 0x4f, 0x17, 0x87, 0x17,
-	0x0e, 0x13, 0x3b, 0x2b, 0x4d, 0x98, 0x81, 0x6e, 0x17, 0x87, 0xdf, 0xd6, 0xe9, 0x2d, 0xed, 0x02,
-	0x46, 0xb4, 0x66, 0x82, 0x81, 0x96, 0x2b, 0xb4, 0xe5, 0x1b, 0xf0, 0x77, 0x56, 0xe2, 0x8c, 0x74,
-	0x18, 0x7e, 0xf8, 0xfe, 0xfb, 0xcb, 0xda, 0x16, 0xf8, 0xdc, 0x71, 0x6a, 0x26, 0x7f, 0xb8, 0x20,
-	0x74, 0xd3, 0xc6, 0x08, 0x37, 0x0f, 0x5e, 0xbc, 0x0e, 0x7f, 0x77, 0x35, 0xd0, 0x5a, 0xd8, 0xd3,
-	0x16, 0xb6, 0x21, 0x74, 0x59, 0xc8, 0x0d, 0x98, 0x9f, 0x57, 0x85, 0x11, 0x7c, 0x25, 0xf4, 0xde,
-	0x42, 0x28, 0xb0, 0xff, 0x0f, 0x9d, 0xe5, 0x33, 0xf2, 0xd9, 0xff, 0xc2, 0xad, 0xb9, 0x27, 0xda,
-	0x5c, 0x0b, 0xb6, 0x5d, 0xe6, 0xfa, 0x1a, 0xcb, 0xcf, 0xed, 0x25, 0x8e, 0x8e, 0x7b, 0x57, 0xe3,
-	0x80, 0x5c, 0x8f, 0x03, 0xf2, 0x6b, 0x1c, 0x90, 0xcf, 0x93, 0xc0, 0xbb, 0x9e, 0x04, 0xde, 0x8f,
-	0x49, 0xe0, 0xd1, 0x07, 0x09, 0x3a, 0x94, 0x3b, 0xe4, 0xcd, 0x41, 0x9c, 0x14, 0x6f, 0x87, 0x5d,
-	0xd6, 0xc3, 0xc1, 0x9c, 0xc4, 0x7e, 0x82, 0xf3, 0x82, 0xef, 0x8d, 0x64, 0x71, 0x96, 0x49, 0xd5,
-	0xad, 0xe9, 0x8f, 0xff, 0xe8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x66, 0xb0, 0x67, 0x48, 0xb1,
-	0x04, 0x00, 0x00,
+	// 601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xb1, 0x6f, 0xd3, 0x4e,
+	0x14, 0xc7, 0x7d, 0xfd, 0xe5, 0x97, 0x94, 0xab, 0x58, 0x8e, 0x54, 0x0a, 0x56, 0x71, 0x90, 0x29,
+	0x69, 0x29, 0xf4, 0xae, 0x49, 0x17, 0xc4, 0xc0, 0x50, 0x24, 0x58, 0x10, 0x04, 0x8f, 0x6c, 0x17,
+	0xe7, 0xe4, 0x5a, 0xc4, 0x3e, 0xd7, 0xe7, 0x58, 0x54, 0x25, 0x0b, 0x0c, 0x74, 0x60, 0x40, 0x62,
+	0x65, 0xe8, 0xcc, 0x3f, 0xc1, 0xda, 0xb1, 0x12, 0x0b, 0x13, 0x42, 0x09, 0x03, 0x7f, 0x06, 0xf2,
+	0xdd, 0x59, 0x49, 0x1a, 0xa7, 0xe9, 0xd2, 0xed, 0x7c, 0xf7, 0xbe, 0xf7, 0xfd, 0xbc, 0x77, 0xef,
+	0x19, 0x5a, 0x51, 0xcc, 0x53, 0x16, 0xd2, 0xd0, 0x65, 0x24, 0xa4, 0x01, 0x23, 0x69, 0x93, 0x1c,
+	0xf4, 0x59, 0x7c, 0x88, 0xa3, 0x98, 0x27, 0x1c, 0xa1, 0xf1, 0x39, 0xce, 0xce, 0x71, 0xda, 0x34,
+	0xb7, 0x5c, 0x2e, 0x02, 0x2e, 0x48, 0x87, 0x0a, 0xa6, 0x82, 0x49, 0xda, 0xec, 0xb0, 0x84, 0x36,
+	0x49, 0x44, 0x3d, 0x3f, 0xa4, 0x89, 0xcf, 0x43, 0xa5, 0x37, 0xab, 0x1e, 0xf7, 0xb8, 0x5c, 0x92,
+	0x6c, 0xa5, 0x77, 0xd7, 0x3c, 0xce, 0xbd, 0x1e, 0x23, 0x34, 0xf2, 0x09, 0x0d, 0x43, 0x9e, 0x48,
+	0x89, 0xd0, 0xa7, 0xb7, 0x0a, 0x98, 0xa4, 0xb7, 0x3c, 0xb6, 0xab, 0x10, 0xbd, 0xca, 0x4c, 0xdb,
+	0x34, 0xa6, 0x81, 0x70, 0xd8, 0x41, 0x9f, 0x89, 0xc4, 0x7e, 0x09, 0x6f, 0x4c, 0xed, 0x8a, 0x88,
+	0x87, 0x82, 0xa1, 0x87, 0xb0, 0x1c, 0xc9, 0x9d, 0x1a, 0xb8, 0x0d, 0x36, 0x57, 0x5a, 0x26, 0x9e,
+	0x4d, 0x08, 0x2b, 0xcd, 0x5e, 0xe9, 0xf4, 0x57, 0xdd, 0x70, 0x74, 0xbc, 0xbd, 0xab, 0x2f, 0x74,
+	0x98, 0xe0, 0xbd, 0x94, 0x69, 0x1f, 0x84, 0x60, 0x29, 0x93, 0xc9, 0xeb, 0xae, 0x39, 0x72, 0xfd,
+	0x68, 0xf9, 0xf8, 0xa4, 0x6e, 0xfc, 0x3d, 0xa9, 0x1b, 0xf6, 0x0e, 0xac, 0x4e, 0x8b, 0x34, 0x46,
+	0x0d, 0x56, 0x68, 0xb7, 0x1b, 0x33, 0x21, 0xb4, 0x30, 0xff, 0xb4, 0x3f, 0x02, 0x78, 0x53, 0x4b,
+	0x52, 0x16, 0x0b, 0xf6, 0x9c, 0xf3, 0x37, 0xfd, 0x28, 0x77, 0x9b, 0xab, 0x43, 0x4f, 0x21, 0x1c,
+	0x17, 0xbb, 0xb6, 0x24, 0x93, 0x6b, 0x60, 0xf5, 0x32, 0x38, 0x7b, 0x19, 0xac, 0x9e, 0x51, 0xbf,
+	0x0c, 0x6e, 0x53, 0x2f, 0xcf, 0xc1, 0x99, 0x50, 0x4e, 0xb0, 0x7f, 0x00, 0xd0, 0x2c, 0x22, 0xd1,
+	0x29, 0x8c, 0x13, 0xff, 0x2f, 0x4f, 0x1c, 0x3d, 0x2b, 0x80, 0xd8, 0x58, 0x08, 0xa1, 0x2e, 0x9c,
+	0x43, 0xf1, 0x4e, 0x57, 0xf0, 0xc9, 0xbe, 0xdf, 0xeb, 0xc6, 0x2c, 0xbc, 0xa0, 0xee, 0x57, 0x50,
+	0x83, 0x6f, 0x00, 0xae, 0x9e, 0xb3, 0xd7, 0xe9, 0x3f, 0x86, 0x95, 0x98, 0xb9, 0x3c, 0xee, 0x0a,
+	0x59, 0x81, 0x95, 0x96, 0x55, 0xd4, 0x49, 0x2f, 0x68, 0xc0, 0x1c, 0x19, 0xa6, 0xbb, 0x29, 0x17,
+	0x5d, 0x41, 0xa9, 0x5a, 0xdf, 0x4b, 0xf0, 0x7f, 0x09, 0x8b, 0x06, 0xb0, 0xac, 0x7a, 0x18, 0x35,
+	0x8a, 0xa8, 0x66, 0xc7, 0xc5, 0xdc, 0x58, 0x18, 0xa7, 0xac, 0x6d, 0xfb, 0xfd, 0x8f, 0x3f, 0x5f,
+	0x96, 0xd6, 0x90, 0x49, 0x0a, 0xa6, 0x52, 0x8d, 0x0a, 0x3a, 0x06, 0xb0, 0xa2, 0x3b, 0x1e, 0xcd,
+	0xbf, 0x78, 0x7a, 0x90, 0xcc, 0xcd, 0xc5, 0x81, 0x1a, 0x61, 0x4b, 0x22, 0xac, 0x23, 0xbb, 0x08,
+	0x21, 0x56, 0xc1, 0xe4, 0x28, 0xdb, 0x18, 0xa0, 0xaf, 0x00, 0x5e, 0x9f, 0xea, 0x5f, 0xb4, 0x7d,
+	0x81, 0xcf, 0xec, 0xc4, 0x99, 0xf8, 0xb2, 0xe1, 0x1a, 0xee, 0x81, 0x84, 0x6b, 0xa0, 0xf5, 0x22,
+	0xb8, 0x9e, 0x8c, 0x25, 0x47, 0x7a, 0x68, 0x07, 0xe8, 0x13, 0x80, 0xcb, 0x79, 0x6b, 0xa1, 0xf9,
+	0x15, 0x38, 0xd7, 0xfc, 0xe6, 0xbd, 0x4b, 0x44, 0x6a, 0x9e, 0xfb, 0x92, 0xe7, 0x2e, 0xba, 0x53,
+	0xc4, 0xe3, 0xea, 0x68, 0x5d, 0xad, 0x3d, 0xf7, 0x74, 0x68, 0x81, 0xb3, 0xa1, 0x05, 0x7e, 0x0f,
+	0x2d, 0xf0, 0x79, 0x64, 0x19, 0x67, 0x23, 0xcb, 0xf8, 0x39, 0xb2, 0x0c, 0xb8, 0xea, 0xf3, 0x02,
+	0xcf, 0x36, 0x78, 0xbd, 0xe3, 0xf9, 0xc9, 0x7e, 0xbf, 0x83, 0x5d, 0x1e, 0x4c, 0x38, 0x6c, 0xfb,
+	0x7c, 0xd2, 0xef, 0xad, 0x72, 0x4c, 0x0e, 0x23, 0x26, 0x3a, 0x65, 0xf9, 0xdb, 0xde, 0xfd, 0x37,
+	0x00, 0xb0, 0xe9, 0x51, 0x87, 0x6b, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -346,6 +435,8 @@ type QueryClient interface {
 	Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error)
 	// ReverseLookup queries for all names bound against a given address
 	ReverseLookup(ctx context.Context, in *QueryReverseLookupRequest, opts ...grpc.CallOption) (*QueryReverseLookupResponse, error)
+	// Children queries for the name records directly under a given parent name
+	Children(ctx context.Context, in *QueryChildrenRequest, opts ...grpc.CallOption) (*QueryChildrenResponse, error)
 }
 
 type queryClient struct {
@@ -383,6 +474,15 @@ func (c *queryClient) ReverseLookup(ctx context.Context, in *QueryReverseLookupR
 	return out, nil
 }
 
+func (c *queryClient) Children(ctx context.Context, in *QueryChildrenRequest, opts ...grpc.CallOption) (*QueryChildrenResponse, error) {
+	out := new(QueryChildrenResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/Children", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the name module.
@@ -391,6 +491,8 @@ type QueryServer interface {
 	Resolve(context.Context, *QueryResolveRequest) (*QueryResolveResponse, error)
 	// ReverseLookup queries for all names bound against a given address
 	ReverseLookup(context.Context, *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error)
+	// Children queries for the name records directly under a given parent name
+	Children(context.Context, *QueryChildrenRequest) (*QueryChildrenResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -406,6 +508,9 @@ func (*UnimplementedQueryServer) Resolve(ctx context.Context, req *QueryResolveR
 func (*UnimplementedQueryServer) ReverseLookup(ctx context.Context, req *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReverseLookup not implemented")
 }
+func (*UnimplementedQueryServer) Children(ctx context.Context, req *QueryChildrenRequest) (*QueryChildrenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Children not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -465,6 +570,24 @@ func _Query_ReverseLookup_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Children_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Children(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/Children",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Children(ctx, req.(*QueryChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.name.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -481,6 +604,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReverseLookup",
 			Handler:    _Query_ReverseLookup_Handler,
 		},
+		{
+			MethodName: "Children",
+			Handler:    _Query_Children_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/name/v1/query.proto",
@@ -688,6 +815,97 @@ func (m *QueryReverseLookupResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryChildrenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChildrenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChildrenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChildrenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChildrenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChildrenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -781,6 +999,42 @@ func (m *QueryReverseLookupResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryChildrenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChildrenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1320,6 +1574,244 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChildrenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChildrenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChildrenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChildrenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, NameRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
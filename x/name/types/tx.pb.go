@@ -185,38 +185,212 @@ func (m *MsgDeleteNameResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteNameResponse proto.InternalMessageInfo
 
+// MsgModifyNameRequest defines an sdk.Msg type that is used to update the address and restriction of an existing
+// address/name binding.  The request must be signed by the current owner of the name, or by the owner of the parent
+// name when the parent is restricted.
+type MsgModifyNameRequest struct {
+	// The address signing the request, the current owner of the name or of its restricted parent.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The name record with the updated address and restriction.
+	Record NameRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+}
+
+func (m *MsgModifyNameRequest) Reset()         { *m = MsgModifyNameRequest{} }
+func (m *MsgModifyNameRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyNameRequest) ProtoMessage()    {}
+func (*MsgModifyNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{4}
+}
+func (m *MsgModifyNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgModifyNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyNameRequest.Merge(m, src)
+}
+func (m *MsgModifyNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgModifyNameRequest proto.InternalMessageInfo
+
+// MsgModifyNameResponse defines the Msg/ModifyName response type.
+type MsgModifyNameResponse struct {
+}
+
+func (m *MsgModifyNameResponse) Reset()         { *m = MsgModifyNameResponse{} }
+func (m *MsgModifyNameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyNameResponse) ProtoMessage()    {}
+func (*MsgModifyNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{5}
+}
+func (m *MsgModifyNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgModifyNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyNameResponse.Merge(m, src)
+}
+func (m *MsgModifyNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgModifyNameResponse proto.InternalMessageInfo
+
+// MsgTransferNameRequest defines an sdk.Msg type that is used to move an existing address/name binding to a new
+// address.  The restriction of the name is unchanged.  The request must be signed by the current owner of the name, or
+// by the owner of the parent name when the parent is restricted.
+type MsgTransferNameRequest struct {
+	// The address signing the request, the current owner of the name or of its restricted parent.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The name being transferred.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The address the name will be bound to.
+	NewAddress string `protobuf:"bytes,3,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty"`
+}
+
+func (m *MsgTransferNameRequest) Reset()         { *m = MsgTransferNameRequest{} }
+func (m *MsgTransferNameRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNameRequest) ProtoMessage()    {}
+func (*MsgTransferNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{6}
+}
+func (m *MsgTransferNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNameRequest.Merge(m, src)
+}
+func (m *MsgTransferNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferNameRequest proto.InternalMessageInfo
+
+// MsgTransferNameResponse defines the Msg/TransferName response type.
+type MsgTransferNameResponse struct {
+}
+
+func (m *MsgTransferNameResponse) Reset()         { *m = MsgTransferNameResponse{} }
+func (m *MsgTransferNameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNameResponse) ProtoMessage()    {}
+func (*MsgTransferNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{7}
+}
+func (m *MsgTransferNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNameResponse.Merge(m, src)
+}
+func (m *MsgTransferNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferNameResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgBindNameRequest)(nil), "provenance.name.v1.MsgBindNameRequest")
 	proto.RegisterType((*MsgBindNameResponse)(nil), "provenance.name.v1.MsgBindNameResponse")
 	proto.RegisterType((*MsgDeleteNameRequest)(nil), "provenance.name.v1.MsgDeleteNameRequest")
 	proto.RegisterType((*MsgDeleteNameResponse)(nil), "provenance.name.v1.MsgDeleteNameResponse")
+	proto.RegisterType((*MsgModifyNameRequest)(nil), "provenance.name.v1.MsgModifyNameRequest")
+	proto.RegisterType((*MsgModifyNameResponse)(nil), "provenance.name.v1.MsgModifyNameResponse")
+	proto.RegisterType((*MsgTransferNameRequest)(nil), "provenance.name.v1.MsgTransferNameRequest")
+	proto.RegisterType((*MsgTransferNameResponse)(nil), "provenance.name.v1.MsgTransferNameResponse")
 }
 
 func init() { proto.RegisterFile("provenance/name/v1/tx.proto", fileDescriptor_eacf6cd967218635) }
 
 var fileDescriptor_eacf6cd967218635 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4b, 0xcc, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x2f,
-	0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x42, 0x48, 0xea, 0x81, 0x24, 0xf5, 0xca,
-	0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xd2, 0xfa, 0x20, 0x16, 0x44, 0xa5, 0x94, 0x2c,
-	0x16, 0x63, 0xc0, 0x3a, 0xc0, 0xd2, 0x4a, 0xb3, 0x18, 0xb9, 0x84, 0x7c, 0x8b, 0xd3, 0x9d, 0x32,
-	0xf3, 0x52, 0xfc, 0x12, 0x73, 0x53, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x84, 0x6c, 0xb8,
-	0xd8, 0x0a, 0x12, 0x8b, 0x52, 0xf3, 0x4a, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf4,
-	0x30, 0x2d, 0xd4, 0x83, 0x68, 0x48, 0xce, 0x2f, 0x4a, 0x71, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21,
-	0x08, 0xaa, 0x07, 0xa4, 0xbb, 0x08, 0x2c, 0x2e, 0xc1, 0x44, 0x8a, 0x6e, 0x88, 0x1e, 0x2b, 0x8e,
-	0x8e, 0x05, 0xf2, 0x0c, 0x2f, 0x16, 0xc8, 0x33, 0x28, 0x89, 0x72, 0x09, 0xa3, 0xb8, 0xad, 0xb8,
-	0x20, 0x3f, 0xaf, 0x38, 0x55, 0x29, 0x8e, 0x4b, 0xc4, 0xb7, 0x38, 0xdd, 0x25, 0x35, 0x27, 0xb5,
-	0x24, 0x15, 0xcd, 0xd1, 0x50, 0x6b, 0x19, 0x29, 0xb2, 0x56, 0x9c, 0x4b, 0x14, 0xcd, 0x7c, 0x88,
-	0xc5, 0x46, 0x47, 0x19, 0xb9, 0x98, 0x7d, 0x8b, 0xd3, 0x85, 0xa2, 0xb9, 0x38, 0x60, 0x8e, 0x12,
-	0x52, 0xc3, 0x66, 0x09, 0x66, 0x88, 0x4a, 0xa9, 0x13, 0x54, 0x07, 0xb1, 0x44, 0x28, 0x91, 0x8b,
-	0x0b, 0x61, 0xb5, 0x90, 0x06, 0x0e, 0x6d, 0x18, 0xbe, 0x97, 0xd2, 0x24, 0x42, 0x25, 0xc4, 0x0a,
-	0xa7, 0xe4, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2,
-	0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0xe0, 0x12, 0xcd, 0xcc, 0xc7,
-	0x62, 0x4c, 0x00, 0x63, 0x94, 0x41, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
-	0x3e, 0x42, 0x81, 0x6e, 0x66, 0x3e, 0x12, 0x4f, 0xbf, 0x02, 0x92, 0xc2, 0x4a, 0x2a, 0x0b, 0x52,
-	0x8b, 0x93, 0xd8, 0xc0, 0x09, 0xcc, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x80, 0x68, 0x9e, 0xb8,
-	0xc8, 0x02, 0x00, 0x00,
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x63, 0x3a, 0x4d, 0xeb, 0x1b, 0x27, 0xb3, 0xb2, 0x12, 0x20, 0x45, 0x3d, 0xc0, 0x06,
+	0x22, 0x61, 0xe3, 0x86, 0xb8, 0x50, 0x71, 0x0d, 0x42, 0x15, 0x27, 0x90, 0x40, 0x5e, 0xf3, 0xe6,
+	0x45, 0xa2, 0x76, 0xb0, 0xdd, 0x6e, 0x95, 0xf8, 0x00, 0x1c, 0xe1, 0xca, 0x69, 0x1f, 0x67, 0xc7,
+	0x1d, 0x39, 0x21, 0xd4, 0x5e, 0xf8, 0x18, 0xa8, 0x76, 0x50, 0xc2, 0x92, 0x8a, 0x20, 0x76, 0x73,
+	0xfc, 0xfe, 0x7f, 0xff, 0xfe, 0xf1, 0x7b, 0x32, 0xdc, 0xcc, 0x94, 0x9c, 0xa2, 0x60, 0x62, 0x84,
+	0x91, 0x60, 0x63, 0x8c, 0xa6, 0x7b, 0x91, 0x39, 0x09, 0x33, 0x25, 0x8d, 0xa4, 0xb4, 0x28, 0x86,
+	0xcb, 0x62, 0x38, 0xdd, 0xf3, 0xb7, 0xb8, 0xe4, 0xd2, 0x96, 0xa3, 0xe5, 0xca, 0x29, 0xfd, 0xdb,
+	0x35, 0xc7, 0x58, 0x87, 0x2d, 0xf7, 0xbf, 0x12, 0xa0, 0xb1, 0xe6, 0x83, 0x54, 0x24, 0x2f, 0xd8,
+	0x18, 0x87, 0xf8, 0x61, 0x82, 0xda, 0xd0, 0xa7, 0xb0, 0x9e, 0x31, 0x85, 0xc2, 0x74, 0xc9, 0x1d,
+	0xb2, 0xb3, 0xb9, 0x1f, 0x84, 0x55, 0x60, 0xe8, 0x0c, 0x23, 0xa9, 0x92, 0xc1, 0xda, 0xd9, 0xf7,
+	0x9e, 0x37, 0xcc, 0x3d, 0x4b, 0xb7, 0xb2, 0xfb, 0xdd, 0x2b, 0xff, 0xe2, 0x76, 0x9e, 0x27, 0x1b,
+	0x9f, 0x4e, 0x7b, 0xde, 0xcf, 0xd3, 0x9e, 0xd7, 0xef, 0xc0, 0xb5, 0x3f, 0xb2, 0xe9, 0x4c, 0x0a,
+	0x8d, 0xfd, 0xb7, 0xb0, 0x15, 0x6b, 0xfe, 0x1c, 0xdf, 0xa3, 0xc1, 0x0b, 0xa1, 0x73, 0x2c, 0xf9,
+	0x2f, 0xec, 0x36, 0x74, 0x2e, 0x9c, 0x9f, 0x83, 0x3f, 0x5a, 0x70, 0x2c, 0x93, 0xf4, 0x70, 0x56,
+	0x06, 0xdf, 0x82, 0x36, 0x9b, 0x98, 0x23, 0xa9, 0x52, 0x33, 0xb3, 0xec, 0xf6, 0xb0, 0xd8, 0xb8,
+	0xb4, 0xdb, 0x70, 0xb1, 0xca, 0xf4, 0x3c, 0xd6, 0x04, 0xae, 0xc7, 0x9a, 0xbf, 0x52, 0x4c, 0xe8,
+	0x43, 0x54, 0xcd, 0x83, 0x51, 0x58, 0x5b, 0xe2, 0x6d, 0xac, 0xf6, 0xd0, 0xae, 0x69, 0x0f, 0x36,
+	0x05, 0x1e, 0xbf, 0x63, 0x49, 0xa2, 0x50, 0xeb, 0x6e, 0xcb, 0x96, 0x40, 0xe0, 0xf1, 0x33, 0xb7,
+	0x53, 0xca, 0x73, 0x03, 0xb6, 0x2b, 0x58, 0x97, 0x68, 0xff, 0x4b, 0x0b, 0x5a, 0xb1, 0xe6, 0xf4,
+	0x0d, 0x6c, 0xfc, 0xee, 0x1e, 0xbd, 0x5b, 0xf7, 0xdb, 0xd5, 0xd1, 0xf3, 0xef, 0xfd, 0x55, 0xe7,
+	0x20, 0x94, 0x01, 0x14, 0x3d, 0xa2, 0x3b, 0x2b, 0x6c, 0x95, 0x31, 0xf1, 0x77, 0x1b, 0x28, 0x0b,
+	0x44, 0x71, 0xdf, 0x2b, 0x11, 0x95, 0x81, 0xf0, 0x77, 0x1b, 0x28, 0x73, 0x04, 0x87, 0xab, 0xe5,
+	0x2b, 0xa4, 0xf7, 0x57, 0x58, 0x6b, 0xda, 0xeb, 0x3f, 0x68, 0xa4, 0x75, 0xa0, 0xc1, 0xe8, 0x6c,
+	0x1e, 0x90, 0xf3, 0x79, 0x40, 0x7e, 0xcc, 0x03, 0xf2, 0x79, 0x11, 0x78, 0xe7, 0x8b, 0xc0, 0xfb,
+	0xb6, 0x08, 0x3c, 0xe8, 0xa4, 0xb2, 0xe6, 0xa0, 0x97, 0xe4, 0xf5, 0x23, 0x9e, 0x9a, 0xa3, 0xc9,
+	0x41, 0x38, 0x92, 0xe3, 0xa8, 0x10, 0x3c, 0x4c, 0x65, 0xe9, 0x2b, 0x3a, 0x71, 0xcf, 0x8a, 0x99,
+	0x65, 0xa8, 0x0f, 0xd6, 0xed, 0xab, 0xf2, 0xf8, 0xd7, 0x00, 0xff, 0x81, 0x07, 0x27, 0xbd, 0x04,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -235,6 +409,10 @@ type MsgClient interface {
 	BindName(ctx context.Context, in *MsgBindNameRequest, opts ...grpc.CallOption) (*MsgBindNameResponse, error)
 	// DeleteName defines a method to verify a particular invariance.
 	DeleteName(ctx context.Context, in *MsgDeleteNameRequest, opts ...grpc.CallOption) (*MsgDeleteNameResponse, error)
+	// ModifyName updates the address and restriction of an existing name binding.
+	ModifyName(ctx context.Context, in *MsgModifyNameRequest, opts ...grpc.CallOption) (*MsgModifyNameResponse, error)
+	// TransferName moves an existing name binding to a new address.
+	TransferName(ctx context.Context, in *MsgTransferNameRequest, opts ...grpc.CallOption) (*MsgTransferNameResponse, error)
 }
 
 type msgClient struct {
@@ -263,12 +441,34 @@ func (c *msgClient) DeleteName(ctx context.Context, in *MsgDeleteNameRequest, op
 	return out, nil
 }
 
+func (c *msgClient) ModifyName(ctx context.Context, in *MsgModifyNameRequest, opts ...grpc.CallOption) (*MsgModifyNameResponse, error) {
+	out := new(MsgModifyNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/ModifyName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferName(ctx context.Context, in *MsgTransferNameRequest, opts ...grpc.CallOption) (*MsgTransferNameResponse, error) {
+	out := new(MsgTransferNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/TransferName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// BindName binds a name to an address under a root name.
 	BindName(context.Context, *MsgBindNameRequest) (*MsgBindNameResponse, error)
 	// DeleteName defines a method to verify a particular invariance.
 	DeleteName(context.Context, *MsgDeleteNameRequest) (*MsgDeleteNameResponse, error)
+	// ModifyName updates the address and restriction of an existing name binding.
+	ModifyName(context.Context, *MsgModifyNameRequest) (*MsgModifyNameResponse, error)
+	// TransferName moves an existing name binding to a new address.
+	TransferName(context.Context, *MsgTransferNameRequest) (*MsgTransferNameResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -281,6 +481,12 @@ func (*UnimplementedMsgServer) BindName(ctx context.Context, req *MsgBindNameReq
 func (*UnimplementedMsgServer) DeleteName(ctx context.Context, req *MsgDeleteNameRequest) (*MsgDeleteNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteName not implemented")
 }
+func (*UnimplementedMsgServer) ModifyName(ctx context.Context, req *MsgModifyNameRequest) (*MsgModifyNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyName not implemented")
+}
+func (*UnimplementedMsgServer) TransferName(ctx context.Context, req *MsgTransferNameRequest) (*MsgTransferNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferName not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -322,6 +528,42 @@ func _Msg_DeleteName_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ModifyName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/ModifyName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyName(ctx, req.(*MsgModifyNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/TransferName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferName(ctx, req.(*MsgTransferNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.name.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -334,6 +576,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteName",
 			Handler:    _Msg_DeleteName_Handler,
 		},
+		{
+			MethodName: "ModifyName",
+			Handler:    _Msg_ModifyName_Handler,
+		},
+		{
+			MethodName: "TransferName",
+			Handler:    _Msg_TransferName_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/name/v1/tx.proto",
@@ -461,6 +711,136 @@ func (m *MsgDeleteNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgModifyNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAddress) > 0 {
+		i -= len(m.NewAddress)
+		copy(dAtA[i:], m.NewAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -514,25 +894,79 @@ func (m *MsgDeleteNameResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgModifyNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgModifyNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
+
+func (m *MsgTransferNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgBindNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
@@ -819,6 +1253,367 @@ func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgModifyNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModifyNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
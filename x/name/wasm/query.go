@@ -19,6 +19,8 @@ type NameQueryParams struct {
 	Resolve *ResolveQueryParams `json:"resolve,omitempty"`
 	// Lookup all names an address is bound to.
 	Lookup *LookupQueryParams `json:"lookup,omitempty"`
+	// List all names bound directly under a name.
+	Children *ChildrenQueryParams `json:"children,omitempty"`
 }
 
 // ResolveQueryParams are the inputs for a resolve name query.
@@ -33,6 +35,12 @@ type LookupQueryParams struct {
 	Address string `json:"address"`
 }
 
+// ChildrenQueryParams are the inputs for a child names query.
+type ChildrenQueryParams struct {
+	// Find all names bound directly under this name.
+	Name string `json:"name"`
+}
+
 // Querier returns a smart contract querier for the name module.
 func Querier(keeper keeper.Keeper) provwasm.Querier {
 	return func(ctx sdk.Context, query json.RawMessage, version string) ([]byte, error) {
@@ -51,6 +59,8 @@ func Querier(keeper keeper.Keeper) provwasm.Querier {
 			return params.Resolve.Run(ctx, keeper)
 		case params.Lookup != nil:
 			return params.Lookup.Run(ctx, keeper)
+		case params.Children != nil:
+			return params.Children.Run(ctx, keeper)
 		default:
 			return nil, fmt.Errorf("wasm: invalid name query: %s", string(query))
 		}
@@ -79,6 +89,19 @@ func (params *LookupQueryParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]b
 	return createResponse(records)
 }
 
+// Run lists all names bound directly under a given name.
+func (params *ChildrenQueryParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	name, err := keeper.Normalize(ctx, params.Name)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid name: %w", err)
+	}
+	records, err := keeper.GetChildRecords(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: children query failed: %w", err)
+	}
+	return createResponse(records)
+}
+
 // A helper function for converting name module record types into local query response types.
 func createResponse(records types.NameRecords) ([]byte, error) {
 	rep := &QueryResNames{}